@@ -0,0 +1,237 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package arc implements a cache with the adaptive replacement eviction policy.
+//
+// The cache holds at most a fixed number of elements, split between elements that were used once recently and
+// elements that were used at least twice. It also remembers the keys, but not the values, of as many recently evicted
+// elements. When an evicted key is put again, the cache grows the part that it was evicted from at the expense of the
+// other one, so that it adapts between recency and frequency to the workload without any tuning.
+//
+// All four lists are linked hash maps ordered from the least recently to the most recently used element, so that all
+// operations take constant time.
+//
+// Structure is not thread safe.
+//
+// Reference: https://en.wikipedia.org/wiki/Adaptive_replacement_cache
+package arc
+
+import (
+	"fmt"
+	"github.com/emirpasic/gods/caches"
+	"github.com/emirpasic/gods/maps/linkedhashmap"
+	"strings"
+)
+
+// Assert Cache implementation
+var _ caches.Cache = (*Cache)(nil)
+
+// Cache holds the elements and the keys of recently evicted elements in four lists.
+type Cache struct {
+	t1       *linkedhashmap.Map // elements used once recently
+	t2       *linkedhashmap.Map // elements used at least twice recently
+	b1       *linkedhashmap.Map // keys recently evicted from t1
+	b2       *linkedhashmap.Map // keys recently evicted from t2
+	target   int                // target size of t1
+	capacity int
+	onEvict  caches.EvictCallback
+	stats    caches.Stats
+}
+
+// New instantiates a cache that holds at most capacity elements.
+func New(capacity int) *Cache {
+	return NewWith(capacity, nil)
+}
+
+// NewWith instantiates a cache that holds at most capacity elements and calls onEvict with every evicted element.
+// The callback is not called for elements removed by Remove or Clear.
+func NewWith(capacity int, onEvict caches.EvictCallback) *Cache {
+	if capacity < 1 {
+		panic("Invalid capacity, should be at least 1")
+	}
+	return &Cache{
+		t1:       linkedhashmap.New(),
+		t2:       linkedhashmap.New(),
+		b1:       linkedhashmap.New(),
+		b2:       linkedhashmap.New(),
+		capacity: capacity,
+		onEvict:  onEvict,
+	}
+}
+
+// Put inserts key-value pair into the cache and marks it as the most recently used.
+// If the cache is full, an element is evicted from the part that exceeds its target size.
+func (c *Cache) Put(key interface{}, value interface{}) {
+	if _, found := c.t1.Get(key); found {
+		c.t1.Remove(key)
+		c.t2.Put(key, value)
+		return
+	}
+	if _, found := c.t2.Get(key); found {
+		c.t2.Put(key, value)
+		c.t2.MoveToBack(key)
+		return
+	}
+	if _, found := c.b1.Get(key); found {
+		c.target = min(c.capacity, c.target+max(c.b2.Size()/c.b1.Size(), 1))
+		c.b1.Remove(key)
+		c.replace(false)
+		c.t2.Put(key, value)
+		return
+	}
+	if _, found := c.b2.Get(key); found {
+		c.target = max(0, c.target-max(c.b1.Size()/c.b2.Size(), 1))
+		c.b2.Remove(key)
+		c.replace(true)
+		c.t2.Put(key, value)
+		return
+	}
+	if c.t1.Size()+c.b1.Size() == c.capacity {
+		if c.t1.Size() < c.capacity {
+			c.b1.PollFirst()
+			c.replace(false)
+		} else {
+			c.evict(c.t1.PollFirst())
+		}
+	} else if total := c.t1.Size() + c.t2.Size() + c.b1.Size() + c.b2.Size(); total >= c.capacity {
+		if total == 2*c.capacity {
+			c.b2.PollFirst()
+		}
+		c.replace(false)
+	}
+	c.t1.Put(key, value)
+}
+
+// Get searches the element in the cache by key, marks it as the most recently used and returns its value
+// or nil if key is not found in cache.
+// Second return parameter is true if key was found, otherwise false.
+// Every call is counted as a hit or a miss.
+func (c *Cache) Get(key interface{}) (value interface{}, found bool) {
+	if value, found = c.t1.Get(key); found {
+		c.stats.Hits++
+		c.t1.Remove(key)
+		c.t2.Put(key, value)
+		return value, true
+	}
+	if value, found = c.t2.Get(key); found {
+		c.stats.Hits++
+		c.t2.MoveToBack(key)
+		return value, true
+	}
+	c.stats.Misses++
+	return nil, false
+}
+
+// Peek searches the element in the cache by key and returns its value or nil if key is not found in cache.
+// Second return parameter is true if key was found, otherwise false.
+// Unlike Get, it neither marks the element as used nor counts as a hit or a miss.
+func (c *Cache) Peek(key interface{}) (value interface{}, found bool) {
+	if value, found = c.t1.Get(key); found {
+		return value, true
+	}
+	return c.t2.Get(key)
+}
+
+// Remove removes the element from the cache by key, as well as any memory of its earlier eviction.
+func (c *Cache) Remove(key interface{}) {
+	c.t1.Remove(key)
+	c.t2.Remove(key)
+	c.b1.Remove(key)
+	c.b2.Remove(key)
+}
+
+// Empty returns true if cache does not contain any elements
+func (c *Cache) Empty() bool {
+	return c.Size() == 0
+}
+
+// Size returns number of elements in the cache.
+func (c *Cache) Size() int {
+	return c.t1.Size() + c.t2.Size()
+}
+
+// Capacity returns the maximum number of elements that the cache can hold.
+func (c *Cache) Capacity() int {
+	return c.capacity
+}
+
+// Keys returns the keys of the elements used once, followed by the keys of the elements used at least twice, each
+// from the least recently to the most recently used.
+func (c *Cache) Keys() []interface{} {
+	return append(c.t1.Keys(), c.t2.Keys()...)
+}
+
+// Values returns the values of the elements used once, followed by the values of the elements used at least twice,
+// each from the least recently to the most recently used.
+func (c *Cache) Values() []interface{} {
+	return append(c.t1.Values(), c.t2.Values()...)
+}
+
+// Clear removes all elements from the cache and forgets all evicted keys.
+// Statistics are kept, see ResetStats.
+func (c *Cache) Clear() {
+	c.t1.Clear()
+	c.t2.Clear()
+	c.b1.Clear()
+	c.b2.Clear()
+	c.target = 0
+}
+
+// Stats returns the hit, miss and eviction counters of the cache.
+func (c *Cache) Stats() caches.Stats {
+	return c.stats
+}
+
+// ResetStats sets all counters of the cache to zero.
+func (c *Cache) ResetStats() {
+	c.stats = caches.Stats{}
+}
+
+// String returns a string representation of container
+func (c *Cache) String() string {
+	str := "ARCCache\nmap["
+	it := c.Iterator()
+	for it.Next() {
+		str += fmt.Sprintf("%v:%v ", it.Key(), it.Value())
+	}
+	return strings.TrimRight(str, " ") + "]"
+}
+
+// replace makes room for one element if the cache is full, by evicting the least recently used element of t1 if t1
+// exceeds its target size, or of t2 otherwise, and remembering its key
+func (c *Cache) replace(inB2 bool) {
+	if c.t1.Size()+c.t2.Size() < c.capacity {
+		return
+	}
+	if c.t1.Size() > 0 && (c.t2.Empty() || c.t1.Size() > c.target || (inB2 && c.t1.Size() == c.target)) {
+		key, value := c.t1.PollFirst()
+		c.b1.Put(key, nil)
+		c.evict(key, value)
+	} else {
+		key, value := c.t2.PollFirst()
+		c.b2.Put(key, nil)
+		c.evict(key, value)
+	}
+}
+
+func (c *Cache) evict(key interface{}, value interface{}) {
+	c.stats.Evictions++
+	if c.onEvict != nil {
+		c.onEvict(key, value)
+	}
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func max(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
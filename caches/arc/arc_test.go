@@ -0,0 +1,446 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package arc
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/emirpasic/gods/caches"
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+func TestCachePut(t *testing.T) {
+	c := New(3)
+	c.Put("a", 1)
+	c.Put("b", 2)
+	c.Put("c", 3)
+	c.Put("a", 10) // overwrite, a is used twice
+
+	if actualValue, expectedValue := fmt.Sprintf("%v", c.Keys()), "[b c a]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", c.Values()), "[2 3 10]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := c.Size(), 3; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := c.Capacity(), 3; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	c.Put("d", 4) // evicts b, the least recently used of the elements used once
+	if actualValue, expectedValue := fmt.Sprintf("%v", c.Keys()), "[c d a]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, actualFound := c.Peek("b"); actualValue != nil || actualFound {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+}
+
+func TestCacheAdaptation(t *testing.T) {
+	evicted := []interface{}{}
+	c := NewWith(2, func(key interface{}, value interface{}) {
+		evicted = append(evicted, key)
+	})
+	c.Put("a", 1)
+	c.Put("b", 2)
+	c.Get("a")
+	c.Put("c", 3) // evicts b, the elements used once exceed their target size of 0
+	if actualValue, expectedValue := fmt.Sprintf("%v", c.Keys()), "[c a]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	c.Put("b", 2) // b was evicted too early, the target size grows to 1 and a is evicted instead
+	if actualValue, expectedValue := c.target, 1; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", c.Keys()), "[c b]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	c.Put("a", 1) // a was evicted too early, the target size shrinks to 0 and c is evicted instead
+	if actualValue, expectedValue := c.target, 0; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", c.Keys()), "[b a]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", evicted), "[b a c]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestCacheScanResistance(t *testing.T) {
+	c := New(4)
+	c.Put("a", 1)
+	c.Put("b", 2)
+	c.Get("a")
+	c.Get("b")
+
+	// a scan of elements used once does not evict the elements used twice
+	for i := 0; i < 100; i++ {
+		c.Put(i, i)
+	}
+	for _, key := range []interface{}{"a", "b"} {
+		if _, actualFound := c.Peek(key); !actualFound {
+			t.Errorf("Got %v expected %v", actualFound, true)
+		}
+	}
+	if actualValue, expectedValue := c.Size(), 4; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestCacheGetAndPeek(t *testing.T) {
+	c := New(3)
+	c.Put("a", 1)
+	c.Put("b", 2)
+	c.Put("c", 3)
+
+	if actualValue, actualFound := c.Get("a"); actualValue != 1 || !actualFound {
+		t.Errorf("Got %v expected %v", actualValue, 1)
+	}
+	if actualValue, actualFound := c.Peek("b"); actualValue != 2 || !actualFound {
+		t.Errorf("Got %v expected %v", actualValue, 2)
+	}
+	if actualValue, actualFound := c.Get("x"); actualValue != nil || actualFound {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+	if actualValue, actualFound := c.Peek("x"); actualValue != nil || actualFound {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+
+	// Get marks a as used twice, Peek does not mark b
+	if actualValue, expectedValue := fmt.Sprintf("%v", c.Keys()), "[b c a]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	c.Put("d", 4)
+	if actualValue, expectedValue := fmt.Sprintf("%v", c.Keys()), "[c d a]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	// a ghost key is a miss
+	if actualValue, actualFound := c.Get("b"); actualValue != nil || actualFound {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+
+	// nil values are cached as well
+	c.Put("n", nil)
+	if actualValue, actualFound := c.Get("n"); actualValue != nil || !actualFound {
+		t.Errorf("Got %v,%v expected %v,%v", actualValue, actualFound, nil, true)
+	}
+}
+
+func TestCacheRemoveAndClear(t *testing.T) {
+	evicted := []interface{}{}
+	c := NewWith(2, func(key interface{}, value interface{}) {
+		evicted = append(evicted, key)
+	})
+	c.Put("a", 1)
+	c.Put("b", 2)
+	c.Remove("a")
+	c.Remove("x")
+	if actualValue, expectedValue := fmt.Sprintf("%v", c.Keys()), "[b]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	c.Put("c", 3) // room left by a, nothing is evicted
+	c.Clear()
+	if actualValue, expectedValue := c.Empty(), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := len(evicted), 0; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	// removing a key forgets its eviction
+	c.Put("a", 1)
+	c.Put("b", 2)
+	c.Get("b")
+	c.Put("c", 3) // evicts a
+	if actualValue, expectedValue := c.b1.Size(), 1; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	c.Remove("a")
+	if actualValue, expectedValue := c.b1.Size(), 0; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestCacheEvictCallbackAndStats(t *testing.T) {
+	evicted := []string{}
+	c := NewWith(2, func(key interface{}, value interface{}) {
+		evicted = append(evicted, fmt.Sprintf("%v:%v", key, value))
+	})
+	c.Put("a", 1)
+	c.Put("b", 2)
+	c.Get("a")
+	c.Put("c", 3) // evicts b
+	c.Get("b")
+	c.Put("d", 4) // evicts c
+	c.Get("a")
+	c.Get("d")
+
+	if actualValue, expectedValue := fmt.Sprintf("%v", evicted), "[b:2 c:3]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := c.Stats(), (caches.Stats{Hits: 3, Misses: 1, Evictions: 2}); actualValue != expectedValue {
+		t.Errorf("Got %+v expected %+v", actualValue, expectedValue)
+	}
+	c.ResetStats()
+	if actualValue, expectedValue := c.Stats(), (caches.Stats{}); actualValue != expectedValue {
+		t.Errorf("Got %+v expected %+v", actualValue, expectedValue)
+	}
+}
+
+func TestCacheInvalidCapacity(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("Should panic on a capacity smaller than 1")
+		}
+	}()
+	New(0)
+}
+
+func TestCacheRandomOperations(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	c := New(50)
+	for i := 0; i < 10000; i++ {
+		key := r.Intn(200)
+		switch r.Intn(8) {
+		case 0:
+			c.Remove(key)
+		case 1, 2, 3:
+			c.Get(key)
+		default:
+			c.Put(key, i)
+			if value, found := c.Peek(key); value != i || !found {
+				t.Fatalf("Got %v,%v expected %v,%v", value, found, i, true)
+			}
+		}
+		assertLists(t, c)
+	}
+}
+
+// assertLists checks the invariants of the adaptive replacement cache
+func assertLists(t *testing.T, c *Cache) {
+	t1, t2, b1, b2 := c.t1.Size(), c.t2.Size(), c.b1.Size(), c.b2.Size()
+	if t1+t2 > c.capacity {
+		t.Fatalf("Size %v exceeds capacity %v", t1+t2, c.capacity)
+	}
+	if t1+b1 > c.capacity {
+		t.Fatalf("Recency lists hold %v keys, more than capacity %v", t1+b1, c.capacity)
+	}
+	if t1+t2+b1+b2 > 2*c.capacity {
+		t.Fatalf("Lists hold %v keys, more than twice the capacity %v", t1+t2+b1+b2, c.capacity)
+	}
+	if c.target < 0 || c.target > c.capacity {
+		t.Fatalf("Target %v out of range", c.target)
+	}
+	seen := make(map[interface{}]bool)
+	for _, keys := range [][]interface{}{c.t1.Keys(), c.t2.Keys(), c.b1.Keys(), c.b2.Keys()} {
+		for _, key := range keys {
+			if seen[key] {
+				t.Fatalf("Key %v is in more than one list", key)
+			}
+			seen[key] = true
+		}
+	}
+}
+
+func TestCacheIterator(t *testing.T) {
+	c := New(3)
+	it := c.Iterator()
+	for it.Next() {
+		t.Errorf("Shouldn't iterate on empty cache")
+	}
+	for it.Prev() {
+		t.Errorf("Shouldn't iterate on empty cache")
+	}
+
+	c.Put("a", 1)
+	c.Put("b", 2)
+	c.Put("c", 3)
+	c.Get("a")
+
+	it = c.Iterator()
+	keys := []interface{}{}
+	for it.Next() {
+		keys = append(keys, it.Key())
+		if value, _ := c.Peek(it.Key()); value != it.Value() {
+			t.Errorf("Got %v expected %v", it.Value(), value)
+		}
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", keys), "[b c a]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	keys = []interface{}{}
+	for it.Prev() {
+		keys = append(keys, it.Key())
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", keys), "[a c b]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	if actualValue, expectedValue := it.Last(), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := it.Key(), "a"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := it.First(), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := it.Key(), "b"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	it.Begin()
+	if actualValue, expectedValue := it.NextTo(func(key interface{}, value interface{}) bool { return value == 1 }), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := it.PrevTo(func(key interface{}, value interface{}) bool { return value == 2 }), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := it.Key(), "b"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	// only elements used twice
+	c.Remove("b")
+	c.Remove("c")
+	it = c.Iterator()
+	if actualValue, expectedValue := it.Last(), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := it.Prev(), false; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := it.Next(), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := it.Key(), "a"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	// iterating is not a use
+	if actualValue, expectedValue := c.Stats().Hits, uint64(1); actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestCacheSerialization(t *testing.T) {
+	c := New(3)
+	c.Put("a", 1.0)
+	c.Put("b", 2.0)
+	c.Put("c", 3.0)
+	c.Get("a")
+
+	serialized, err := c.ToJSON()
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := string(serialized), `{"b":2,"c":3,"a":1}`; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	deserialized := New(3)
+	err = deserialized.FromJSON(serialized)
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", deserialized.Keys()), "[b c a]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	smaller := New(2)
+	err = smaller.FromJSON(serialized)
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", smaller.Keys()), "[c a]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	_, err = json.Marshal([]interface{}{"a", "b", "c", c})
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+
+	err = json.Unmarshal([]byte(`{"a":1,"b":2}`), &c)
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+
+	err = c.FromJSON([]byte(`[1,2]`))
+	if err == nil {
+		t.Errorf("Expected an error")
+	}
+}
+
+func TestCacheString(t *testing.T) {
+	c := New(1)
+	c.Put("a", 1)
+	if !strings.HasPrefix(c.String(), "ARCCache") {
+		t.Errorf("String should start with container name")
+	}
+}
+
+func benchmarkGet(b *testing.B, c *Cache, size int) {
+	for i := 0; i < b.N; i++ {
+		for n := 0; n < size; n++ {
+			c.Get(n)
+		}
+	}
+}
+
+func benchmarkPut(b *testing.B, c *Cache, size int) {
+	for i := 0; i < b.N; i++ {
+		for n := 0; n < size; n++ {
+			c.Put(n, struct{}{})
+		}
+	}
+}
+
+func BenchmarkARCCacheGet100(b *testing.B) {
+	b.StopTimer()
+	size := 100
+	c := New(size)
+	for n := 0; n < size; n++ {
+		c.Put(n, struct{}{})
+	}
+	b.StartTimer()
+	benchmarkGet(b, c, size)
+}
+
+func BenchmarkARCCacheGet10000(b *testing.B) {
+	b.StopTimer()
+	size := 10000
+	c := New(size)
+	for n := 0; n < size; n++ {
+		c.Put(n, struct{}{})
+	}
+	b.StartTimer()
+	benchmarkGet(b, c, size)
+}
+
+func BenchmarkARCCachePutWithEvictions100(b *testing.B) {
+	b.StopTimer()
+	size := 100
+	c := New(size / 2)
+	b.StartTimer()
+	benchmarkPut(b, c, size)
+}
+
+func BenchmarkARCCachePutWithEvictions10000(b *testing.B) {
+	b.StopTimer()
+	size := 10000
+	c := New(size / 2)
+	b.StartTimer()
+	benchmarkPut(b, c, size)
+}
@@ -0,0 +1,52 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package arc
+
+import (
+	"github.com/emirpasic/gods/containers"
+	"github.com/emirpasic/gods/maps/linkedhashmap"
+	"github.com/emirpasic/gods/utils"
+)
+
+// Assert Serialization implementation
+var _ containers.JSONSerializer = (*Cache)(nil)
+var _ containers.JSONDeserializer = (*Cache)(nil)
+
+// ToJSON outputs the JSON representation of the cache, i.e. an object whose members are ordered like the elements
+// of the iterator. Evicted keys are not part of the representation.
+func (c *Cache) ToJSON() ([]byte, error) {
+	elements := linkedhashmap.New()
+	it := c.Iterator()
+	for it.Next() {
+		elements.Put(utils.ToString(it.Key()), it.Value())
+	}
+	return elements.ToJSON()
+}
+
+// FromJSON populates the cache from the input JSON representation, using its elements in the order of the members.
+// All elements count as used once. If there are more members than the capacity of the cache, the first ones are
+// evicted.
+func (c *Cache) FromJSON(data []byte) error {
+	elements := linkedhashmap.New()
+	if err := elements.FromJSON(data); err != nil {
+		return err
+	}
+	c.Clear()
+	it := elements.Iterator()
+	for it.Next() {
+		c.Put(it.Key(), it.Value())
+	}
+	return nil
+}
+
+// UnmarshalJSON @implements json.Unmarshaler
+func (c *Cache) UnmarshalJSON(bytes []byte) error {
+	return c.FromJSON(bytes)
+}
+
+// MarshalJSON @implements json.Marshaler
+func (c *Cache) MarshalJSON() ([]byte, error) {
+	return c.ToJSON()
+}
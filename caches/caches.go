@@ -0,0 +1,55 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package caches provides an abstract Cache interface.
+//
+// A cache is a map that holds at most a fixed number of elements, its capacity. When an element is added to a full
+// cache, another element is evicted according to the eviction policy of the cache, which tries to keep the elements
+// that are most likely to be requested again.
+//
+// All caches count hits, misses and evictions in the same way and report evicted elements to a callback of the same
+// type, so that policies can be swapped for each other and compared on the same workloads.
+//
+// Reference: https://en.wikipedia.org/wiki/Cache_replacement_policies
+package caches
+
+import "github.com/emirpasic/gods/maps"
+
+// Cache interface that all caches implement
+type Cache interface {
+	Peek(key interface{}) (value interface{}, found bool)
+	Capacity() int
+	Stats() Stats
+	ResetStats()
+
+	maps.Map
+	// Put(key interface{}, value interface{})
+	// Get(key interface{}) (value interface{}, found bool)
+	// Remove(key interface{})
+	// Keys() []interface{}
+	// Empty() bool
+	// Size() int
+	// Clear()
+	// Values() []interface{}
+	// String() string
+}
+
+// EvictCallback is called with every element that a cache evicts to make room for another one.
+// It is not called for elements removed by Remove or Clear.
+type EvictCallback func(key interface{}, value interface{})
+
+// Stats holds the counters of a cache.
+type Stats struct {
+	Hits      uint64 // number of Get calls that found the key
+	Misses    uint64 // number of Get calls that did not find the key
+	Evictions uint64 // number of elements evicted to make room for others
+}
+
+// HitRatio returns the fraction of Get calls that found the key, or 0 if there were none.
+func (stats Stats) HitRatio() float64 {
+	if stats.Hits+stats.Misses == 0 {
+		return 0
+	}
+	return float64(stats.Hits) / float64(stats.Hits+stats.Misses)
+}
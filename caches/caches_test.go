@@ -0,0 +1,225 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package caches_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/emirpasic/gods/caches"
+	"github.com/emirpasic/gods/caches/arc"
+	"github.com/emirpasic/gods/caches/lfu"
+	"github.com/emirpasic/gods/caches/lru"
+	"github.com/emirpasic/gods/containers"
+	"math/rand"
+	"testing"
+)
+
+// implementations returns a constructor for every cache, so that all policies are run through the same scenarios
+func implementations() map[string]func(capacity int, onEvict caches.EvictCallback) caches.Cache {
+	return map[string]func(capacity int, onEvict caches.EvictCallback) caches.Cache{
+		"LRUCache": func(capacity int, onEvict caches.EvictCallback) caches.Cache { return lru.NewWith(capacity, onEvict) },
+		"LFUCache": func(capacity int, onEvict caches.EvictCallback) caches.Cache { return lfu.NewWith(capacity, onEvict) },
+		"ARCCache": func(capacity int, onEvict caches.EvictCallback) caches.Cache { return arc.NewWith(capacity, onEvict) },
+	}
+}
+
+func TestCacheEvictions(t *testing.T) {
+	for name, newCache := range implementations() {
+		evicted := make(map[interface{}]interface{})
+		c := newCache(10, func(key interface{}, value interface{}) {
+			evicted[key] = value
+		})
+		for i := 0; i < 100; i++ {
+			c.Put(i, i*i)
+			if actualValue, actualFound := c.Peek(i); actualValue != i*i || !actualFound {
+				t.Errorf("[%s] Got %v,%v expected %v,%v", name, actualValue, actualFound, i*i, true)
+			}
+		}
+		if actualValue, expectedValue := c.Size(), 10; actualValue != expectedValue {
+			t.Errorf("[%s] Got %v expected %v", name, actualValue, expectedValue)
+		}
+		if actualValue, expectedValue := len(evicted), 90; actualValue != expectedValue {
+			t.Errorf("[%s] Got %v expected %v", name, actualValue, expectedValue)
+		}
+		if actualValue, expectedValue := c.Stats().Evictions, uint64(90); actualValue != expectedValue {
+			t.Errorf("[%s] Got %v expected %v", name, actualValue, expectedValue)
+		}
+		for key, value := range evicted {
+			if _, found := c.Peek(key); found {
+				t.Errorf("[%s] Evicted key %v is still cached", name, key)
+			}
+			if actualValue, expectedValue := value, key.(int)*key.(int); actualValue != expectedValue {
+				t.Errorf("[%s] Got %v expected %v", name, actualValue, expectedValue)
+			}
+		}
+
+		// overwriting cached elements evicts nothing
+		for _, key := range c.Keys() {
+			c.Put(key, nil)
+		}
+		if actualValue, expectedValue := len(evicted), 90; actualValue != expectedValue {
+			t.Errorf("[%s] Got %v expected %v", name, actualValue, expectedValue)
+		}
+		if actualValue, expectedValue := fmt.Sprintf("%v", c.Values()), fmt.Sprintf("%v", make([]interface{}, 10)); actualValue != expectedValue {
+			t.Errorf("[%s] Got %v expected %v", name, actualValue, expectedValue)
+		}
+
+		// removed and cleared elements are not evicted
+		c.Remove(c.Keys()[0])
+		c.Clear()
+		if actualValue, expectedValue := len(evicted), 90; actualValue != expectedValue {
+			t.Errorf("[%s] Got %v expected %v", name, actualValue, expectedValue)
+		}
+		if actualValue, expectedValue := c.Empty(), true; actualValue != expectedValue {
+			t.Errorf("[%s] Got %v expected %v", name, actualValue, expectedValue)
+		}
+	}
+}
+
+func TestCacheStats(t *testing.T) {
+	for name, newCache := range implementations() {
+		c := newCache(2, nil)
+		c.Put("a", 1)
+		c.Get("a")
+		c.Get("b")
+		c.Peek("a")
+		c.Peek("b")
+		if actualValue, expectedValue := c.Stats(), (caches.Stats{Hits: 1, Misses: 1}); actualValue != expectedValue {
+			t.Errorf("[%s] Got %+v expected %+v", name, actualValue, expectedValue)
+		}
+		if actualValue, expectedValue := c.Stats().HitRatio(), 0.5; actualValue != expectedValue {
+			t.Errorf("[%s] Got %v expected %v", name, actualValue, expectedValue)
+		}
+		c.Clear()
+		if actualValue, expectedValue := c.Stats().Hits, uint64(1); actualValue != expectedValue {
+			t.Errorf("[%s] Got %v expected %v", name, actualValue, expectedValue)
+		}
+		c.ResetStats()
+		if actualValue, expectedValue := c.Stats().HitRatio(), 0.0; actualValue != expectedValue {
+			t.Errorf("[%s] Got %v expected %v", name, actualValue, expectedValue)
+		}
+	}
+}
+
+func TestCacheRandomOperations(t *testing.T) {
+	for name, newCache := range implementations() {
+		r := rand.New(rand.NewSource(1))
+		evictions := 0
+		c := newCache(20, func(key interface{}, value interface{}) {
+			evictions++
+		})
+		expected := make(map[interface{}]interface{})
+		for i := 0; i < 10000; i++ {
+			key := r.Intn(50)
+			switch r.Intn(4) {
+			case 0:
+				c.Remove(key)
+				delete(expected, key)
+			case 1:
+				value, found := c.Get(key)
+				if expectedValue, expectedFound := expected[key]; found && (!expectedFound || value != expectedValue) {
+					t.Fatalf("[%s] Got %v,%v expected %v,%v", name, value, found, expectedValue, expectedFound)
+				}
+			default:
+				c.Put(key, i)
+				expected[key] = i
+			}
+			if c.Size() > c.Capacity() {
+				t.Fatalf("[%s] Size %v exceeds capacity %v", name, c.Size(), c.Capacity())
+			}
+			if actualValue, expectedValue := len(c.Keys()), c.Size(); actualValue != expectedValue {
+				t.Fatalf("[%s] Got %v expected %v", name, actualValue, expectedValue)
+			}
+		}
+		if actualValue, expectedValue := c.Stats().Evictions, uint64(evictions); actualValue != expectedValue {
+			t.Errorf("[%s] Got %v expected %v", name, actualValue, expectedValue)
+		}
+	}
+}
+
+func TestCacheSerialization(t *testing.T) {
+	for name, newCache := range implementations() {
+		c := newCache(3, nil)
+		c.Put(1, "a")
+		c.Put(2, "b")
+
+		serialized, err := c.(containers.JSONSerializer).ToJSON()
+		if err != nil {
+			t.Errorf("[%s] Got error %v", name, err)
+		}
+		if !json.Valid(serialized) {
+			t.Errorf("[%s] Got invalid JSON %s", name, serialized)
+		}
+
+		deserialized := newCache(3, nil)
+		err = deserialized.(containers.JSONDeserializer).FromJSON(serialized)
+		if err != nil {
+			t.Errorf("[%s] Got error %v", name, err)
+		}
+		if actualValue, actualFound := deserialized.Peek("1"); actualValue != "a" || !actualFound {
+			t.Errorf("[%s] Got %v,%v expected %v,%v", name, actualValue, actualFound, "a", true)
+		}
+	}
+}
+
+// zipfTrace returns requests whose popularity follows a power law, as commonly seen in real workloads
+func zipfTrace(size int, keys uint64) []int {
+	r := rand.New(rand.NewSource(1))
+	zipf := rand.NewZipf(r, 1.1, 1, keys-1)
+	trace := make([]int, size)
+	for i := range trace {
+		trace[i] = int(zipf.Uint64())
+	}
+	return trace
+}
+
+// scanTrace returns requests to a small set of popular keys interrupted by scans of keys that are requested once
+func scanTrace(size int, keys int) []int {
+	r := rand.New(rand.NewSource(1))
+	trace := make([]int, size)
+	scanned := keys
+	for i := range trace {
+		if i%1000 < 200 {
+			trace[i] = scanned
+			scanned++
+		} else {
+			trace[i] = r.Intn(keys)
+		}
+	}
+	return trace
+}
+
+// benchmarkTrace replays the trace on a new cache, putting every missed key, and reports the hit ratio
+func benchmarkTrace(b *testing.B, newCache func(capacity int, onEvict caches.EvictCallback) caches.Cache, capacity int, trace []int) {
+	var stats caches.Stats
+	for i := 0; i < b.N; i++ {
+		c := newCache(capacity, nil)
+		for _, key := range trace {
+			if _, found := c.Get(key); !found {
+				c.Put(key, struct{}{})
+			}
+		}
+		stats = c.Stats()
+	}
+	b.ReportMetric(stats.HitRatio(), "hit-ratio")
+}
+
+func BenchmarkCacheZipfTrace(b *testing.B) {
+	trace := zipfTrace(100000, 10000)
+	for _, name := range []string{"LRUCache", "LFUCache", "ARCCache"} {
+		b.Run(name, func(b *testing.B) {
+			benchmarkTrace(b, implementations()[name], 500, trace)
+		})
+	}
+}
+
+func BenchmarkCacheScanTrace(b *testing.B) {
+	trace := scanTrace(100000, 400)
+	for _, name := range []string{"LRUCache", "LFUCache", "ARCCache"} {
+		b.Run(name, func(b *testing.B) {
+			benchmarkTrace(b, implementations()[name], 300, trace)
+		})
+	}
+}
@@ -0,0 +1,276 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package lfu implements a cache with the least frequently used eviction policy.
+//
+// The cache holds at most a fixed number of elements. When an element is added to a full cache, the element that was
+// least frequently used, i.e. read by Get or written by Put the least number of times, is evicted to make room for it.
+// Among elements used equally often, the least recently used one is evicted.
+//
+// Elements are kept in a list of frequency buckets ordered by frequency, each bucket holding its elements in order of
+// recency, so that all operations take constant time.
+//
+// Structure is not thread safe.
+//
+// Reference: https://en.wikipedia.org/wiki/Least_frequently_used
+package lfu
+
+import (
+	"fmt"
+	"github.com/emirpasic/gods/caches"
+	"strings"
+)
+
+// Assert Cache implementation
+var _ caches.Cache = (*Cache)(nil)
+
+// Cache holds the elements in frequency buckets.
+type Cache struct {
+	table    map[interface{}]*entry
+	head     *bucket // least frequently used
+	tail     *bucket // most frequently used
+	capacity int
+	onEvict  caches.EvictCallback
+	stats    caches.Stats
+}
+
+type entry struct {
+	key    interface{}
+	value  interface{}
+	bucket *bucket
+	prev   *entry
+	next   *entry
+}
+
+// bucket holds all elements used the same number of times, from the least to the most recently used
+type bucket struct {
+	frequency int
+	head      *entry
+	tail      *entry
+	prev      *bucket
+	next      *bucket
+}
+
+// New instantiates a cache that holds at most capacity elements.
+func New(capacity int) *Cache {
+	return NewWith(capacity, nil)
+}
+
+// NewWith instantiates a cache that holds at most capacity elements and calls onEvict with every evicted element.
+// The callback is not called for elements removed by Remove or Clear.
+func NewWith(capacity int, onEvict caches.EvictCallback) *Cache {
+	if capacity < 1 {
+		panic("Invalid capacity, should be at least 1")
+	}
+	return &Cache{table: make(map[interface{}]*entry), capacity: capacity, onEvict: onEvict}
+}
+
+// Put inserts key-value pair into the cache and counts it as a use of the element.
+// If the cache is full, the least frequently used element is evicted.
+func (c *Cache) Put(key interface{}, value interface{}) {
+	if e, found := c.table[key]; found {
+		e.value = value
+		c.touch(e)
+		return
+	}
+	if len(c.table) == c.capacity {
+		evicted := c.head.head
+		c.unlink(evicted)
+		delete(c.table, evicted.key)
+		c.stats.Evictions++
+		if c.onEvict != nil {
+			c.onEvict(evicted.key, evicted.value)
+		}
+	}
+	if c.head == nil || c.head.frequency != 1 {
+		c.linkBucketAfter(&bucket{frequency: 1}, nil)
+	}
+	e := &entry{key: key, value: value}
+	c.link(e, c.head)
+	c.table[key] = e
+}
+
+// Get searches the element in the cache by key, counts it as a use of the element and returns its value
+// or nil if key is not found in cache.
+// Second return parameter is true if key was found, otherwise false.
+// Every call is counted as a hit or a miss.
+func (c *Cache) Get(key interface{}) (value interface{}, found bool) {
+	e, found := c.table[key]
+	if !found {
+		c.stats.Misses++
+		return nil, false
+	}
+	c.stats.Hits++
+	c.touch(e)
+	return e.value, true
+}
+
+// Peek searches the element in the cache by key and returns its value or nil if key is not found in cache.
+// Second return parameter is true if key was found, otherwise false.
+// Unlike Get, it neither counts as a use of the element nor as a hit or a miss.
+func (c *Cache) Peek(key interface{}) (value interface{}, found bool) {
+	if e, found := c.table[key]; found {
+		return e.value, true
+	}
+	return nil, false
+}
+
+// Frequency returns the number of times the element was used since it was added to the cache, or 0 if key is not
+// found in cache.
+func (c *Cache) Frequency(key interface{}) int {
+	if e, found := c.table[key]; found {
+		return e.bucket.frequency
+	}
+	return 0
+}
+
+// Remove removes the element from the cache by key.
+func (c *Cache) Remove(key interface{}) {
+	if e, found := c.table[key]; found {
+		c.unlink(e)
+		delete(c.table, key)
+	}
+}
+
+// Empty returns true if cache does not contain any elements
+func (c *Cache) Empty() bool {
+	return c.Size() == 0
+}
+
+// Size returns number of elements in the cache.
+func (c *Cache) Size() int {
+	return len(c.table)
+}
+
+// Capacity returns the maximum number of elements that the cache can hold.
+func (c *Cache) Capacity() int {
+	return c.capacity
+}
+
+// Keys returns all keys from the least to the most frequently used, i.e. in order of eviction.
+func (c *Cache) Keys() []interface{} {
+	keys := make([]interface{}, c.Size())
+	count := 0
+	it := c.Iterator()
+	for it.Next() {
+		keys[count] = it.Key()
+		count++
+	}
+	return keys
+}
+
+// Values returns all values from the least to the most frequently used, i.e. in order of eviction.
+func (c *Cache) Values() []interface{} {
+	values := make([]interface{}, c.Size())
+	count := 0
+	it := c.Iterator()
+	for it.Next() {
+		values[count] = it.Value()
+		count++
+	}
+	return values
+}
+
+// Clear removes all elements from the cache.
+// Statistics are kept, see ResetStats.
+func (c *Cache) Clear() {
+	c.table = make(map[interface{}]*entry)
+	c.head = nil
+	c.tail = nil
+}
+
+// Stats returns the hit, miss and eviction counters of the cache.
+func (c *Cache) Stats() caches.Stats {
+	return c.stats
+}
+
+// ResetStats sets all counters of the cache to zero.
+func (c *Cache) ResetStats() {
+	c.stats = caches.Stats{}
+}
+
+// String returns a string representation of container
+func (c *Cache) String() string {
+	str := "LFUCache\nmap["
+	it := c.Iterator()
+	for it.Next() {
+		str += fmt.Sprintf("%v:%v ", it.Key(), it.Value())
+	}
+	return strings.TrimRight(str, " ") + "]"
+}
+
+// touch moves the entry to the bucket with the next higher frequency, creating it if necessary
+func (c *Cache) touch(e *entry) {
+	current := e.bucket
+	next := current.next
+	if next == nil || next.frequency != current.frequency+1 {
+		next = &bucket{frequency: current.frequency + 1}
+		c.linkBucketAfter(next, current)
+	}
+	c.unlink(e)
+	c.link(e, next)
+}
+
+// link appends the entry to the bucket as its most recently used entry
+func (c *Cache) link(e *entry, b *bucket) {
+	e.bucket = b
+	e.prev = b.tail
+	e.next = nil
+	if b.tail == nil {
+		b.head = e
+	} else {
+		b.tail.next = e
+	}
+	b.tail = e
+}
+
+// unlink detaches the entry from its bucket and removes the bucket if it becomes empty
+func (c *Cache) unlink(e *entry) {
+	b := e.bucket
+	if e.prev == nil {
+		b.head = e.next
+	} else {
+		e.prev.next = e.next
+	}
+	if e.next == nil {
+		b.tail = e.prev
+	} else {
+		e.next.prev = e.prev
+	}
+	e.bucket, e.prev, e.next = nil, nil, nil
+	if b.head == nil {
+		c.unlinkBucket(b)
+	}
+}
+
+// linkBucketAfter inserts the bucket after the given one, or in front of all buckets if prev is nil
+func (c *Cache) linkBucketAfter(b *bucket, prev *bucket) {
+	b.prev = prev
+	if prev == nil {
+		b.next = c.head
+		c.head = b
+	} else {
+		b.next = prev.next
+		prev.next = b
+	}
+	if b.next == nil {
+		c.tail = b
+	} else {
+		b.next.prev = b
+	}
+}
+
+func (c *Cache) unlinkBucket(b *bucket) {
+	if b.prev == nil {
+		c.head = b.next
+	} else {
+		b.prev.next = b.next
+	}
+	if b.next == nil {
+		c.tail = b.prev
+	} else {
+		b.next.prev = b.prev
+	}
+	b.prev, b.next = nil, nil
+}
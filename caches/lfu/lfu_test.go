@@ -0,0 +1,417 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lfu
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/emirpasic/gods/caches"
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+func TestCachePut(t *testing.T) {
+	c := New(3)
+	c.Put("a", 1)
+	c.Put("b", 2)
+	c.Put("c", 3)
+	c.Put("a", 10) // overwrite, a is used twice
+
+	if actualValue, expectedValue := fmt.Sprintf("%v", c.Keys()), "[b c a]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", c.Values()), "[2 3 10]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := c.Size(), 3; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := c.Capacity(), 3; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	c.Put("d", 4) // evicts b, the least recently used of the least frequently used
+	if actualValue, expectedValue := fmt.Sprintf("%v", c.Keys()), "[c d a]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	c.Put("e", 5) // evicts c
+	if actualValue, expectedValue := fmt.Sprintf("%v", c.Keys()), "[d e a]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, actualFound := c.Peek("b"); actualValue != nil || actualFound {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+}
+
+func TestCacheFrequency(t *testing.T) {
+	c := New(3)
+	c.Put("a", 1)
+	c.Put("b", 2)
+	c.Put("c", 3)
+	c.Get("a")
+	c.Get("a")
+	c.Get("b")
+	c.Peek("c")
+
+	tests := [][]interface{}{
+		{"a", 3},
+		{"b", 2},
+		{"c", 1},
+		{"x", 0},
+	}
+	for _, test := range tests {
+		if actualValue, expectedValue := c.Frequency(test[0]), test[1]; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", c.Keys()), "[c b a]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	// frequently used elements survive a scan of new elements
+	for i := 0; i < 10; i++ {
+		c.Put(i, i)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", c.Keys()), "[9 b a]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	// removing the only element of a frequency drops its bucket
+	c.Remove("b")
+	c.Get(9)
+	c.Get(9)
+	if actualValue, expectedValue := fmt.Sprintf("%v", c.Keys()), "[a 9]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := c.Frequency(9), 3; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestCacheGetAndPeek(t *testing.T) {
+	c := New(3)
+	c.Put("a", 1)
+	c.Put("b", 2)
+	c.Put("c", 3)
+
+	if actualValue, actualFound := c.Get("a"); actualValue != 1 || !actualFound {
+		t.Errorf("Got %v expected %v", actualValue, 1)
+	}
+	if actualValue, actualFound := c.Peek("b"); actualValue != 2 || !actualFound {
+		t.Errorf("Got %v expected %v", actualValue, 2)
+	}
+	if actualValue, actualFound := c.Get("x"); actualValue != nil || actualFound {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+	if actualValue, actualFound := c.Peek("x"); actualValue != nil || actualFound {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+
+	// Get counts a use of a, Peek does not count a use of b
+	c.Put("d", 4)
+	if actualValue, expectedValue := fmt.Sprintf("%v", c.Keys()), "[c d a]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	// nil values are cached as well
+	c.Put("n", nil)
+	if actualValue, actualFound := c.Get("n"); actualValue != nil || !actualFound {
+		t.Errorf("Got %v,%v expected %v,%v", actualValue, actualFound, nil, true)
+	}
+}
+
+func TestCacheRemoveAndClear(t *testing.T) {
+	evicted := []interface{}{}
+	c := NewWith(2, func(key interface{}, value interface{}) {
+		evicted = append(evicted, key)
+	})
+	c.Put("a", 1)
+	c.Put("b", 2)
+	c.Remove("a")
+	c.Remove("x")
+	if actualValue, expectedValue := fmt.Sprintf("%v", c.Keys()), "[b]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	c.Put("c", 3) // room left by a, nothing is evicted
+	c.Clear()
+	if actualValue, expectedValue := c.Empty(), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := len(evicted), 0; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	c.Put("d", 4)
+	if actualValue, expectedValue := fmt.Sprintf("%v", c.Keys()), "[d]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestCacheEvictCallbackAndStats(t *testing.T) {
+	evicted := []string{}
+	c := NewWith(2, func(key interface{}, value interface{}) {
+		evicted = append(evicted, fmt.Sprintf("%v:%v", key, value))
+	})
+	c.Put("a", 1)
+	c.Put("b", 2)
+	c.Get("a")
+	c.Put("c", 3) // evicts b
+	c.Get("b")
+	c.Put("d", 4) // evicts c
+	c.Get("a")
+	c.Get("d")
+
+	if actualValue, expectedValue := fmt.Sprintf("%v", evicted), "[b:2 c:3]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := c.Stats(), (caches.Stats{Hits: 3, Misses: 1, Evictions: 2}); actualValue != expectedValue {
+		t.Errorf("Got %+v expected %+v", actualValue, expectedValue)
+	}
+	c.ResetStats()
+	if actualValue, expectedValue := c.Stats(), (caches.Stats{}); actualValue != expectedValue {
+		t.Errorf("Got %+v expected %+v", actualValue, expectedValue)
+	}
+}
+
+func TestCacheInvalidCapacity(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("Should panic on a capacity smaller than 1")
+		}
+	}()
+	New(0)
+}
+
+func TestCacheRandomOperations(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	c := New(50)
+	for i := 0; i < 10000; i++ {
+		key := r.Intn(100)
+		switch r.Intn(4) {
+		case 0:
+			c.Remove(key)
+		case 1:
+			c.Get(key)
+		default:
+			c.Put(key, i)
+		}
+		assertBuckets(t, c)
+	}
+}
+
+// assertBuckets checks that buckets are non-empty, linked both ways and ordered by strictly increasing frequency
+func assertBuckets(t *testing.T, c *Cache) {
+	count := 0
+	var prev *bucket
+	for b := c.head; b != nil; b = b.next {
+		if b.prev != prev {
+			t.Fatalf("Bucket %v is not linked to its predecessor", b.frequency)
+		}
+		if prev != nil && prev.frequency >= b.frequency {
+			t.Fatalf("Got frequency %v after %v", b.frequency, prev.frequency)
+		}
+		if b.head == nil {
+			t.Fatalf("Bucket %v is empty", b.frequency)
+		}
+		for e := b.head; e != nil; e = e.next {
+			if e.bucket != b || c.table[e.key] != e {
+				t.Fatalf("Entry %v is not linked to its bucket", e.key)
+			}
+			count++
+		}
+		prev = b
+	}
+	if c.tail != prev {
+		t.Fatalf("Last bucket is not the tail")
+	}
+	if actualValue, expectedValue := count, c.Size(); actualValue != expectedValue {
+		t.Fatalf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if c.Size() > c.Capacity() {
+		t.Fatalf("Size %v exceeds capacity %v", c.Size(), c.Capacity())
+	}
+}
+
+func TestCacheIterator(t *testing.T) {
+	c := New(3)
+	it := c.Iterator()
+	for it.Next() {
+		t.Errorf("Shouldn't iterate on empty cache")
+	}
+	for it.Prev() {
+		t.Errorf("Shouldn't iterate on empty cache")
+	}
+
+	c.Put("a", 1)
+	c.Put("b", 2)
+	c.Put("c", 3)
+	c.Get("a")
+	c.Get("a")
+	c.Get("c")
+
+	it = c.Iterator()
+	keys := []interface{}{}
+	for it.Next() {
+		keys = append(keys, it.Key())
+		if value, _ := c.Peek(it.Key()); value != it.Value() {
+			t.Errorf("Got %v expected %v", it.Value(), value)
+		}
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", keys), "[b c a]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	keys = []interface{}{}
+	for it.Prev() {
+		keys = append(keys, it.Key())
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", keys), "[a c b]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	if actualValue, expectedValue := it.Last(), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := it.Key(), "a"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := it.First(), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := it.Key(), "b"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	it.Begin()
+	if actualValue, expectedValue := it.NextTo(func(key interface{}, value interface{}) bool { return value == 3 }), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := it.Key(), "c"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	it.End()
+	if actualValue, expectedValue := it.PrevTo(func(key interface{}, value interface{}) bool { return value == 4 }), false; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	// iterating is not a use
+	if actualValue, expectedValue := c.Frequency("a"), 3; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestCacheSerialization(t *testing.T) {
+	c := New(3)
+	c.Put("a", 1.0)
+	c.Put("b", 2.0)
+	c.Put("c", 3.0)
+	c.Get("a")
+
+	serialized, err := c.ToJSON()
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := string(serialized), `{"b":2,"c":3,"a":1}`; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	deserialized := New(3)
+	err = deserialized.FromJSON(serialized)
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", deserialized.Keys()), "[b c a]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := deserialized.Frequency("a"), 1; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	smaller := New(2)
+	err = smaller.FromJSON(serialized)
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", smaller.Keys()), "[c a]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	_, err = json.Marshal([]interface{}{"a", "b", "c", c})
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+
+	err = json.Unmarshal([]byte(`{"a":1,"b":2}`), &c)
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+
+	err = c.FromJSON([]byte(`[1,2]`))
+	if err == nil {
+		t.Errorf("Expected an error")
+	}
+}
+
+func TestCacheString(t *testing.T) {
+	c := New(1)
+	c.Put("a", 1)
+	if !strings.HasPrefix(c.String(), "LFUCache") {
+		t.Errorf("String should start with container name")
+	}
+}
+
+func benchmarkGet(b *testing.B, c *Cache, size int) {
+	for i := 0; i < b.N; i++ {
+		for n := 0; n < size; n++ {
+			c.Get(n)
+		}
+	}
+}
+
+func benchmarkPut(b *testing.B, c *Cache, size int) {
+	for i := 0; i < b.N; i++ {
+		for n := 0; n < size; n++ {
+			c.Put(n, struct{}{})
+		}
+	}
+}
+
+func BenchmarkLFUCacheGet100(b *testing.B) {
+	b.StopTimer()
+	size := 100
+	c := New(size)
+	for n := 0; n < size; n++ {
+		c.Put(n, struct{}{})
+	}
+	b.StartTimer()
+	benchmarkGet(b, c, size)
+}
+
+func BenchmarkLFUCacheGet10000(b *testing.B) {
+	b.StopTimer()
+	size := 10000
+	c := New(size)
+	for n := 0; n < size; n++ {
+		c.Put(n, struct{}{})
+	}
+	b.StartTimer()
+	benchmarkGet(b, c, size)
+}
+
+func BenchmarkLFUCachePutWithEvictions100(b *testing.B) {
+	b.StopTimer()
+	size := 100
+	c := New(size / 2)
+	b.StartTimer()
+	benchmarkPut(b, c, size)
+}
+
+func BenchmarkLFUCachePutWithEvictions10000(b *testing.B) {
+	b.StopTimer()
+	size := 10000
+	c := New(size / 2)
+	b.StartTimer()
+	benchmarkPut(b, c, size)
+}
@@ -0,0 +1,144 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package lru implements a cache with the least recently used eviction policy.
+//
+// The cache holds at most a fixed number of elements. When an element is added to a full cache, the element that was
+// least recently used, i.e. read by Get or written by Put, is evicted to make room for it.
+//
+// It is backed by a linked hash map, whose ordering runs from the least recently to the most recently used element,
+// so that all operations take constant time.
+//
+// Structure is not thread safe.
+//
+// Reference: https://en.wikipedia.org/wiki/Cache_replacement_policies#Least_recently_used_(LRU)
+package lru
+
+import (
+	"fmt"
+	"github.com/emirpasic/gods/caches"
+	"github.com/emirpasic/gods/maps/linkedhashmap"
+	"strings"
+)
+
+// Assert Cache implementation
+var _ caches.Cache = (*Cache)(nil)
+
+// Cache holds the elements in a linked hash map ordered by recency of use.
+type Cache struct {
+	m        *linkedhashmap.Map
+	capacity int
+	onEvict  caches.EvictCallback
+	stats    caches.Stats
+}
+
+// New instantiates a cache that holds at most capacity elements.
+func New(capacity int) *Cache {
+	return NewWith(capacity, nil)
+}
+
+// NewWith instantiates a cache that holds at most capacity elements and calls onEvict with every evicted element.
+// The callback is not called for elements removed by Remove or Clear.
+func NewWith(capacity int, onEvict caches.EvictCallback) *Cache {
+	if capacity < 1 {
+		panic("Invalid capacity, should be at least 1")
+	}
+	return &Cache{m: linkedhashmap.New(), capacity: capacity, onEvict: onEvict}
+}
+
+// Put inserts key-value pair into the cache and marks it as the most recently used.
+// If the cache is full, the least recently used element is evicted.
+func (c *Cache) Put(key interface{}, value interface{}) {
+	if _, found := c.m.Get(key); found {
+		c.m.Put(key, value)
+		c.m.MoveToBack(key)
+		return
+	}
+	if c.m.Size() == c.capacity {
+		evictedKey, evictedValue := c.m.PollFirst()
+		c.stats.Evictions++
+		if c.onEvict != nil {
+			c.onEvict(evictedKey, evictedValue)
+		}
+	}
+	c.m.Put(key, value)
+}
+
+// Get searches the element in the cache by key, marks it as the most recently used and returns its value
+// or nil if key is not found in cache.
+// Second return parameter is true if key was found, otherwise false.
+// Every call is counted as a hit or a miss.
+func (c *Cache) Get(key interface{}) (value interface{}, found bool) {
+	value, found = c.m.Get(key)
+	if !found {
+		c.stats.Misses++
+		return nil, false
+	}
+	c.stats.Hits++
+	c.m.MoveToBack(key)
+	return value, true
+}
+
+// Peek searches the element in the cache by key and returns its value or nil if key is not found in cache.
+// Second return parameter is true if key was found, otherwise false.
+// Unlike Get, it neither marks the element as used nor counts as a hit or a miss.
+func (c *Cache) Peek(key interface{}) (value interface{}, found bool) {
+	return c.m.Get(key)
+}
+
+// Remove removes the element from the cache by key.
+func (c *Cache) Remove(key interface{}) {
+	c.m.Remove(key)
+}
+
+// Empty returns true if cache does not contain any elements
+func (c *Cache) Empty() bool {
+	return c.m.Empty()
+}
+
+// Size returns number of elements in the cache.
+func (c *Cache) Size() int {
+	return c.m.Size()
+}
+
+// Capacity returns the maximum number of elements that the cache can hold.
+func (c *Cache) Capacity() int {
+	return c.capacity
+}
+
+// Keys returns all keys from the least recently to the most recently used.
+func (c *Cache) Keys() []interface{} {
+	return c.m.Keys()
+}
+
+// Values returns all values from the least recently to the most recently used.
+func (c *Cache) Values() []interface{} {
+	return c.m.Values()
+}
+
+// Clear removes all elements from the cache.
+// Statistics are kept, see ResetStats.
+func (c *Cache) Clear() {
+	c.m.Clear()
+}
+
+// Stats returns the hit, miss and eviction counters of the cache.
+func (c *Cache) Stats() caches.Stats {
+	return c.stats
+}
+
+// ResetStats sets all counters of the cache to zero.
+func (c *Cache) ResetStats() {
+	c.stats = caches.Stats{}
+}
+
+// String returns a string representation of container
+func (c *Cache) String() string {
+	str := "LRUCache\nmap["
+	it := c.Iterator()
+	for it.Next() {
+		str += fmt.Sprintf("%v:%v ", it.Key(), it.Value())
+	}
+	return strings.TrimRight(str, " ") + "]"
+}
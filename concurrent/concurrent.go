@@ -0,0 +1,124 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package concurrent implements wrappers that make lists, maps, sets, stacks, queues and trees safe for concurrent
+// use.
+//
+// Every wrapper guards the wrapped container with a read-write lock. Methods that only read the container take the
+// read lock, so that they run in parallel with each other, and methods that modify it take the write lock. Compound
+// operations, e.g. adding an element only if it is missing, run atomically as a function passed to Update, or to View
+// if they only read.
+//
+// Iterators iterate over a snapshot of the container taken when the iterator is created, so that the container can be
+// read and modified while iterating without holding any lock.
+//
+// Some containers modify themselves on reads, e.g. caches mark the elements that are read as used and TTL maps remove
+// the expired elements that are read. Such maps have to be wrapped by NewExclusiveMap, whose reads take the write lock.
+//
+// The wrapped container must not be used directly once it is wrapped.
+package concurrent
+
+import (
+	"fmt"
+	"github.com/emirpasic/gods/containers"
+	"sync"
+)
+
+// guarded holds a container together with the lock that guards it, and implements the methods common to all
+// containers.
+type guarded struct {
+	mutex     sync.RWMutex
+	container containers.Container
+	exclusive bool // whether reads take the write lock, for containers that modify themselves on reads
+}
+
+func (g *guarded) rlock() {
+	if g.exclusive {
+		g.mutex.Lock()
+	} else {
+		g.mutex.RLock()
+	}
+}
+
+func (g *guarded) runlock() {
+	if g.exclusive {
+		g.mutex.Unlock()
+	} else {
+		g.mutex.RUnlock()
+	}
+}
+
+// Empty returns true if the container does not contain any elements.
+func (g *guarded) Empty() bool {
+	g.rlock()
+	defer g.runlock()
+	return g.container.Empty()
+}
+
+// Size returns the number of elements in the container.
+func (g *guarded) Size() int {
+	g.rlock()
+	defer g.runlock()
+	return g.container.Size()
+}
+
+// Clear removes all elements from the container.
+func (g *guarded) Clear() {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+	g.container.Clear()
+}
+
+// Values returns all elements in the order of the wrapped container.
+func (g *guarded) Values() []interface{} {
+	g.rlock()
+	defer g.runlock()
+	return g.container.Values()
+}
+
+// String returns a string representation of the wrapped container.
+func (g *guarded) String() string {
+	g.rlock()
+	defer g.runlock()
+	return g.container.String()
+}
+
+// Iterator returns a stateful iterator over a snapshot of the elements, whose values can be fetched by an index.
+func (g *guarded) Iterator() Iterator {
+	return Iterator{values: g.Values(), index: -1}
+}
+
+// ToJSON outputs the JSON representation of the wrapped container.
+// Returns an error if the wrapped container does not support JSON serialization.
+func (g *guarded) ToJSON() ([]byte, error) {
+	g.rlock()
+	defer g.runlock()
+	serializer, ok := g.container.(containers.JSONSerializer)
+	if !ok {
+		return nil, fmt.Errorf("%T does not support JSON serialization", g.container)
+	}
+	return serializer.ToJSON()
+}
+
+// FromJSON populates the wrapped container from the input JSON representation.
+// Returns an error if the wrapped container does not support JSON deserialization.
+func (g *guarded) FromJSON(data []byte) error {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+	deserializer, ok := g.container.(containers.JSONDeserializer)
+	if !ok {
+		return fmt.Errorf("%T does not support JSON deserialization", g.container)
+	}
+	return deserializer.FromJSON(data)
+}
+
+// UnmarshalJSON @implements json.Unmarshaler
+func (g *guarded) UnmarshalJSON(bytes []byte) error {
+	return g.FromJSON(bytes)
+}
+
+// MarshalJSON @implements json.Marshaler
+func (g *guarded) MarshalJSON() ([]byte, error) {
+	return g.ToJSON()
+}
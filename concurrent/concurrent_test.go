@@ -0,0 +1,490 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package concurrent
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/emirpasic/gods/caches"
+	"github.com/emirpasic/gods/caches/lru"
+	"github.com/emirpasic/gods/lists"
+	"github.com/emirpasic/gods/lists/arraylist"
+	"github.com/emirpasic/gods/maps"
+	"github.com/emirpasic/gods/maps/hashmap"
+	"github.com/emirpasic/gods/maps/treemap"
+	"github.com/emirpasic/gods/queues/linkedlistqueue"
+	"github.com/emirpasic/gods/sets/hashset"
+	"github.com/emirpasic/gods/sets/treeset"
+	"github.com/emirpasic/gods/stacks/arraystack"
+	"github.com/emirpasic/gods/trees"
+	"github.com/emirpasic/gods/trees/redblacktree"
+	"github.com/emirpasic/gods/utils"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// parallel runs f concurrently in as many goroutines as there are workers and waits for all of them
+func parallel(workers int, f func(worker int)) {
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func(worker int) {
+			defer wg.Done()
+			f(worker)
+		}(w)
+	}
+	wg.Wait()
+}
+
+func TestListConcurrentUse(t *testing.T) {
+	list := NewList(arraylist.New())
+	parallel(8, func(worker int) {
+		for n := 0; n < 100; n++ {
+			list.Add(n)
+			list.Contains(n)
+			list.Get(0)
+			list.Values()
+		}
+	})
+	if actualValue, expectedValue := list.Size(), 800; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	list.Sort(utils.IntComparator)
+	if actualValue, _ := list.Get(799); actualValue != 99 {
+		t.Errorf("Got %v expected %v", actualValue, 99)
+	}
+	list.Clear()
+	list.Add("a", "c")
+	list.Insert(1, "b")
+	list.Swap(0, 2)
+	list.Set(1, "x")
+	list.Remove(0)
+	if actualValue, expectedValue := fmt.Sprintf("%v", list.Values()), "[x a]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := list.Empty(), false; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestListUpdate(t *testing.T) {
+	list := NewList(arraylist.New())
+	parallel(8, func(worker int) {
+		for n := 0; n < 100; n++ {
+			list.Update(func(list lists.List) {
+				if !list.Contains(n) {
+					list.Add(n)
+				}
+			})
+		}
+	})
+	if actualValue, expectedValue := list.Size(), 100; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	list.View(func(list lists.List) {
+		if actualValue, expectedValue := list.Size(), 100; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	})
+}
+
+func TestMapConcurrentUse(t *testing.T) {
+	m := NewMap(hashmap.New())
+	parallel(8, func(worker int) {
+		for n := 0; n < 100; n++ {
+			m.Put(worker*100+n, n)
+			m.Get(n)
+			m.Keys()
+			if n%2 == 0 {
+				m.Remove(worker*100 + n)
+			}
+		}
+	})
+	if actualValue, expectedValue := m.Size(), 400; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	counter := NewMap(hashmap.New())
+	parallel(8, func(worker int) {
+		for n := 0; n < 100; n++ {
+			counter.Update(func(m maps.Map) {
+				count, _ := m.Get("count")
+				if count == nil {
+					count = 0
+				}
+				m.Put("count", count.(int)+1)
+			})
+		}
+	})
+	if actualValue, _ := counter.Get("count"); actualValue != 800 {
+		t.Errorf("Got %v expected %v", actualValue, 800)
+	}
+}
+
+func TestExclusiveMapConcurrentUse(t *testing.T) {
+	cache := NewExclusiveMap(lru.New(10))
+	parallel(8, func(worker int) {
+		for n := 0; n < 100; n++ {
+			cache.Put(n%20, n)
+			cache.Get(n % 10) // marks the element as used
+			cache.Keys()
+		}
+	})
+	if actualValue, expectedValue := cache.Size(), 10; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	cache.View(func(m maps.Map) {
+		m.Get(0)
+	})
+}
+
+func TestSetConcurrentUse(t *testing.T) {
+	set := NewSet(hashset.New())
+	another := NewSet(treeset.NewWithIntComparator())
+	parallel(8, func(worker int) {
+		for n := 0; n < 100; n++ {
+			set.Add(n)
+			another.Add(n * 2)
+			set.Union(another)
+			another.Intersection(set)
+			if worker == 0 {
+				set.Remove(n)
+			}
+		}
+	})
+	if actualValue, expectedValue := another.Size(), 100; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestSetOperations(t *testing.T) {
+	set := NewSet(treeset.NewWithIntComparator(1, 2, 3))
+	another := NewSet(hashset.New(2, 3, 4))
+
+	tests := [][]interface{}{
+		{set.Union(another), "[1 2 3 4]"},
+		{set.Intersection(another), "[2 3]"},
+		{set.Difference(another), "[1]"},
+		{set.SymmetricDifference(another), "[1 4]"},
+		{set.Union(hashset.New(5)), "[1 2 3 5]"},
+		{set.Union(set), "[1 2 3]"},
+	}
+	for _, test := range tests {
+		result, ok := test[0].(*Set)
+		if !ok {
+			t.Errorf("Got %T expected %T", test[0], result)
+			continue
+		}
+		if actualValue := fmt.Sprintf("%v", result.Values()); actualValue != test[1] {
+			t.Errorf("Got %v expected %v", actualValue, test[1])
+		}
+	}
+
+	if actualValue, expectedValue := set.IsSubsetOf(NewSet(hashset.New(1, 2, 3, 4))), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := set.IsSupersetOf(another), false; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := set.IsDisjoint(NewSet(hashset.New(4, 5))), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := set.Equals(NewSet(hashset.New(3, 2, 1))), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := set.Equals(set), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := set.Contains(1, 3), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestStackAndQueueConcurrentUse(t *testing.T) {
+	stack := NewStack(arraystack.New())
+	queue := NewQueue(linkedlistqueue.New())
+	parallel(8, func(worker int) {
+		for n := 0; n < 100; n++ {
+			stack.Push(n)
+			queue.Enqueue(n)
+			stack.Peek()
+			queue.Peek()
+		}
+	})
+	popped := NewMap(hashmap.New())
+	parallel(8, func(worker int) {
+		for {
+			value, ok := stack.Pop()
+			if !ok {
+				return
+			}
+			if _, ok := queue.Dequeue(); !ok {
+				t.Errorf("Got %v expected %v", ok, true)
+			}
+			popped.Update(func(m maps.Map) {
+				count, _ := m.Get(value)
+				if count == nil {
+					count = 0
+				}
+				m.Put(value, count.(int)+1)
+			})
+		}
+	})
+	if actualValue, expectedValue := popped.Size(), 100; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, _ := popped.Get(42); actualValue != 8 {
+		t.Errorf("Got %v expected %v", actualValue, 8)
+	}
+	if stack.Empty() != true || queue.Empty() != true {
+		t.Errorf("Got %v,%v expected %v,%v", stack.Empty(), queue.Empty(), true, true)
+	}
+}
+
+func TestTreeUpdateAndView(t *testing.T) {
+	tree := NewTree(redblacktree.NewWithIntComparator())
+	parallel(8, func(worker int) {
+		for n := 0; n < 100; n++ {
+			tree.Update(func(tree trees.Tree) {
+				tree.(*redblacktree.Tree).Put(worker*100+n, n)
+			})
+			tree.View(func(tree trees.Tree) {
+				tree.(*redblacktree.Tree).Get(n)
+			})
+			tree.Size()
+		}
+	})
+	if actualValue, expectedValue := tree.Size(), 800; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	tree.View(func(tree trees.Tree) {
+		if actualValue, expectedValue := tree.(*redblacktree.Tree).Left().Key, 0; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	})
+}
+
+func TestIteratorSnapshot(t *testing.T) {
+	list := NewList(arraylist.New("a", "b", "c"))
+	it := list.Iterator()
+	list.Clear() // does not affect the iterator
+
+	values := []interface{}{}
+	for it.Next() {
+		values = append(values, fmt.Sprintf("%v:%v", it.Index(), it.Value()))
+		list.Add(it.Value()) // does not deadlock
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", values), "[0:a 1:b 2:c]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	values = []interface{}{}
+	for it.Prev() {
+		values = append(values, it.Value())
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", values), "[c b a]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := it.Last(), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := it.PrevTo(func(index int, value interface{}) bool { return value == "a" }), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := it.NextTo(func(index int, value interface{}) bool { return value == "c" }), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := it.Index(), 2; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := it.First(), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := list.Size(), 3; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	empty := NewStack(arraystack.New()).Iterator()
+	for empty.Next() {
+		t.Errorf("Shouldn't iterate on empty stack")
+	}
+}
+
+func TestMapIteratorSnapshot(t *testing.T) {
+	m := NewMap(treemap.NewWithIntComparator())
+	m.Put(2, "b")
+	m.Put(1, "a")
+	m.Put(3, "c")
+	it := m.Iterator()
+	m.Put(4, "d") // does not affect the iterator
+
+	items := []string{}
+	for it.Next() {
+		items = append(items, fmt.Sprintf("%v:%v", it.Key(), it.Value()))
+		m.Remove(it.Key()) // does not deadlock
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", items), "[1:a 2:b 3:c]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	items = []string{}
+	for it.Prev() {
+		items = append(items, fmt.Sprintf("%v", it.Key()))
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", items), "[3 2 1]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	it.End()
+	if actualValue, expectedValue := it.PrevTo(func(key interface{}, value interface{}) bool { return value == "a" }), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := it.NextTo(func(key interface{}, value interface{}) bool { return key == 3 }), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := it.Last(), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := it.First(), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", m.Keys()), "[4]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestMapIteratorOverCache(t *testing.T) {
+	cache := lru.New(3)
+	m := NewExclusiveMap(cache)
+	m.Put("a", 1)
+	m.Put("b", 2)
+	m.Put("c", 3)
+	m.Get("a") // marks the element as used
+
+	items := []string{}
+	for it := m.Iterator(); it.Next(); {
+		items = append(items, fmt.Sprintf("%v:%v", it.Key(), it.Value()))
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", items), "[b:2 c:3 a:1]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	// iterating neither counts as hits nor changes the order of use
+	if actualValue, expectedValue := cache.Stats(), (caches.Stats{Hits: 1}); actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", m.Keys()), "[b c a]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	m.Put("d", 4) // evicts the least recently used element
+	if actualValue, expectedValue := fmt.Sprintf("%v", m.Keys()), "[c a d]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestSerialization(t *testing.T) {
+	m := NewMap(treemap.NewWithStringComparator())
+	m.Put("b", 2.0)
+	m.Put("a", 1.0)
+	serialized, err := json.Marshal(m)
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := string(serialized), `{"a":1,"b":2}`; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	deserialized := NewMap(hashmap.New())
+	err = json.Unmarshal(serialized, deserialized)
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, _ := deserialized.Get("b"); actualValue != 2.0 {
+		t.Errorf("Got %v expected %v", actualValue, 2.0)
+	}
+
+	list := NewList(arraylist.New())
+	err = list.FromJSON([]byte(`["a","b"]`))
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	serialized, err = list.ToJSON()
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := string(serialized), `["a","b"]`; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	plain := NewMap(struct{ maps.Map }{hashmap.New()}) // hides the serialization methods of the hash map
+	if _, err = plain.ToJSON(); err == nil {
+		t.Errorf("Expected an error")
+	}
+	if err = plain.FromJSON([]byte(`{}`)); err == nil {
+		t.Errorf("Expected an error")
+	}
+}
+
+func TestString(t *testing.T) {
+	list := NewList(arraylist.New(1))
+	if !strings.HasPrefix(list.String(), "ArrayList") {
+		t.Errorf("String should start with the name of the wrapped container")
+	}
+	m := NewMap(hashmap.New())
+	if !strings.HasPrefix(m.String(), "HashMap") {
+		t.Errorf("String should start with the name of the wrapped container")
+	}
+}
+
+func benchmarkGet(b *testing.B, m maps.Map, size int) {
+	for i := 0; i < b.N; i++ {
+		for n := 0; n < size; n++ {
+			m.Get(n)
+		}
+	}
+}
+
+func benchmarkPut(b *testing.B, m maps.Map, size int) {
+	for i := 0; i < b.N; i++ {
+		for n := 0; n < size; n++ {
+			m.Put(n, struct{}{})
+		}
+	}
+}
+
+func BenchmarkMapGet100(b *testing.B) {
+	b.StopTimer()
+	size := 100
+	m := NewMap(hashmap.New())
+	for n := 0; n < size; n++ {
+		m.Put(n, struct{}{})
+	}
+	b.StartTimer()
+	benchmarkGet(b, m, size)
+}
+
+func BenchmarkMapGet10000(b *testing.B) {
+	b.StopTimer()
+	size := 10000
+	m := NewMap(hashmap.New())
+	for n := 0; n < size; n++ {
+		m.Put(n, struct{}{})
+	}
+	b.StartTimer()
+	benchmarkGet(b, m, size)
+}
+
+func BenchmarkMapPut100(b *testing.B) {
+	b.StopTimer()
+	size := 100
+	m := NewMap(hashmap.New())
+	b.StartTimer()
+	benchmarkPut(b, m, size)
+}
+
+func BenchmarkMapPut10000(b *testing.B) {
+	b.StopTimer()
+	size := 10000
+	m := NewMap(hashmap.New())
+	b.StartTimer()
+	benchmarkPut(b, m, size)
+}
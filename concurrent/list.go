@@ -0,0 +1,100 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package concurrent
+
+import (
+	"github.com/emirpasic/gods/containers"
+	"github.com/emirpasic/gods/lists"
+	"github.com/emirpasic/gods/utils"
+)
+
+// Assert List implementation
+var _ lists.List = (*List)(nil)
+var _ containers.JSONSerializer = (*List)(nil)
+var _ containers.JSONDeserializer = (*List)(nil)
+
+// List wraps a list to make it safe for concurrent use.
+type List struct {
+	guarded
+	list lists.List
+}
+
+// NewList instantiates a wrapper of the list that is safe for concurrent use.
+func NewList(list lists.List) *List {
+	return &List{guarded: guarded{container: list}, list: list}
+}
+
+// Get returns the element at index.
+// Second return parameter is true if index is within bounds of the list, otherwise false.
+func (list *List) Get(index int) (interface{}, bool) {
+	list.rlock()
+	defer list.runlock()
+	return list.list.Get(index)
+}
+
+// Remove removes the element at the given index from the list.
+func (list *List) Remove(index int) {
+	list.mutex.Lock()
+	defer list.mutex.Unlock()
+	list.list.Remove(index)
+}
+
+// Add appends values (one or more) at the end of the list.
+func (list *List) Add(values ...interface{}) {
+	list.mutex.Lock()
+	defer list.mutex.Unlock()
+	list.list.Add(values...)
+}
+
+// Contains checks if values (one or more) are present in the list.
+func (list *List) Contains(values ...interface{}) bool {
+	list.rlock()
+	defer list.runlock()
+	return list.list.Contains(values...)
+}
+
+// Sort sorts the values of the list using the provided comparator.
+func (list *List) Sort(comparator utils.Comparator) {
+	list.mutex.Lock()
+	defer list.mutex.Unlock()
+	list.list.Sort(comparator)
+}
+
+// Swap swaps the values at the given indexes.
+func (list *List) Swap(index1, index2 int) {
+	list.mutex.Lock()
+	defer list.mutex.Unlock()
+	list.list.Swap(index1, index2)
+}
+
+// Insert inserts values at the given index, shifting the following values to the right.
+func (list *List) Insert(index int, values ...interface{}) {
+	list.mutex.Lock()
+	defer list.mutex.Unlock()
+	list.list.Insert(index, values...)
+}
+
+// Set sets the value at the given index.
+func (list *List) Set(index int, value interface{}) {
+	list.mutex.Lock()
+	defer list.mutex.Unlock()
+	list.list.Set(index, value)
+}
+
+// Update calls f with the wrapped list while holding the write lock, so that f can read and modify the list
+// atomically. The list must not be used outside of f, and f must not call any method of the wrapper.
+func (list *List) Update(f func(list lists.List)) {
+	list.mutex.Lock()
+	defer list.mutex.Unlock()
+	f(list.list)
+}
+
+// View calls f with the wrapped list while holding the read lock, so that f can read the list atomically.
+// The list must neither be modified by f nor used outside of it, and f must not call any method of the wrapper.
+func (list *List) View(f func(list lists.List)) {
+	list.rlock()
+	defer list.runlock()
+	f(list.list)
+}
@@ -0,0 +1,96 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package concurrent
+
+import (
+	"github.com/emirpasic/gods/caches"
+	"github.com/emirpasic/gods/containers"
+	"github.com/emirpasic/gods/maps"
+)
+
+// Assert Map implementation
+var _ maps.Map = (*Map)(nil)
+var _ containers.JSONSerializer = (*Map)(nil)
+var _ containers.JSONDeserializer = (*Map)(nil)
+
+// Map wraps a map to make it safe for concurrent use.
+type Map struct {
+	guarded
+	m maps.Map
+}
+
+// NewMap instantiates a wrapper of the map that is safe for concurrent use.
+func NewMap(m maps.Map) *Map {
+	return &Map{guarded: guarded{container: m}, m: m}
+}
+
+// NewExclusiveMap instantiates a wrapper of the map that is safe for concurrent use, even if the map modifies itself
+// on reads like caches and TTL maps do. All methods take the write lock, including View.
+func NewExclusiveMap(m maps.Map) *Map {
+	return &Map{guarded: guarded{container: m, exclusive: true}, m: m}
+}
+
+// Put inserts key-value pair into the map.
+func (m *Map) Put(key interface{}, value interface{}) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.m.Put(key, value)
+}
+
+// Get searches the element in the map by key and returns its value or nil if key is not found in map.
+// Second return parameter is true if key was found, otherwise false.
+func (m *Map) Get(key interface{}) (value interface{}, found bool) {
+	m.rlock()
+	defer m.runlock()
+	return m.m.Get(key)
+}
+
+// Remove removes the element from the map by key.
+func (m *Map) Remove(key interface{}) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.m.Remove(key)
+}
+
+// Keys returns all keys in the order of the wrapped map.
+func (m *Map) Keys() []interface{} {
+	m.rlock()
+	defer m.runlock()
+	return m.m.Keys()
+}
+
+// Update calls f with the wrapped map while holding the write lock, so that f can read and modify the map
+// atomically. The map must not be used outside of f, and f must not call any method of the wrapper.
+func (m *Map) Update(f func(m maps.Map)) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	f(m.m)
+}
+
+// View calls f with the wrapped map while holding the read lock, so that f can read the map atomically.
+// The map must neither be modified by f nor used outside of it, and f must not call any method of the wrapper.
+func (m *Map) View(f func(m maps.Map)) {
+	m.rlock()
+	defer m.runlock()
+	f(m.m)
+}
+
+// Iterator returns a stateful iterator over a snapshot of the elements, whose elements are key/value pairs in the
+// order of the wrapped map.
+// Values of caches are peeked at, so that taking the snapshot neither counts as a hit nor marks the elements as used.
+func (m *Map) Iterator() MapIterator {
+	m.rlock()
+	defer m.runlock()
+	get := m.m.Get
+	if cache, ok := m.m.(caches.Cache); ok {
+		get = cache.Peek
+	}
+	keys := m.m.Keys()
+	values := make([]interface{}, len(keys))
+	for i, key := range keys {
+		values[i], _ = get(key)
+	}
+	return MapIterator{keys: keys, values: values, index: -1}
+}
@@ -0,0 +1,65 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package concurrent
+
+import (
+	"github.com/emirpasic/gods/containers"
+	"github.com/emirpasic/gods/queues"
+)
+
+// Assert Queue implementation
+var _ queues.Queue = (*Queue)(nil)
+var _ containers.JSONSerializer = (*Queue)(nil)
+var _ containers.JSONDeserializer = (*Queue)(nil)
+
+// Queue wraps a queue to make it safe for concurrent use.
+type Queue struct {
+	guarded
+	queue queues.Queue
+}
+
+// NewQueue instantiates a wrapper of the queue that is safe for concurrent use.
+func NewQueue(queue queues.Queue) *Queue {
+	return &Queue{guarded: guarded{container: queue}, queue: queue}
+}
+
+// Enqueue adds a value to the end of the queue.
+func (queue *Queue) Enqueue(value interface{}) {
+	queue.mutex.Lock()
+	defer queue.mutex.Unlock()
+	queue.queue.Enqueue(value)
+}
+
+// Dequeue removes first element of the queue and returns it, or nil if queue is empty.
+// Second return parameter is true, unless the queue was empty and there was nothing to dequeue.
+func (queue *Queue) Dequeue() (value interface{}, ok bool) {
+	queue.mutex.Lock()
+	defer queue.mutex.Unlock()
+	return queue.queue.Dequeue()
+}
+
+// Peek returns first element of the queue without removing it, or nil if queue is empty.
+// Second return parameter is true, unless the queue was empty and there was nothing to peek.
+func (queue *Queue) Peek() (value interface{}, ok bool) {
+	queue.rlock()
+	defer queue.runlock()
+	return queue.queue.Peek()
+}
+
+// Update calls f with the wrapped queue while holding the write lock, so that f can read and modify the queue
+// atomically. The queue must not be used outside of f, and f must not call any method of the wrapper.
+func (queue *Queue) Update(f func(queue queues.Queue)) {
+	queue.mutex.Lock()
+	defer queue.mutex.Unlock()
+	f(queue.queue)
+}
+
+// View calls f with the wrapped queue while holding the read lock, so that f can read the queue atomically.
+// The queue must neither be modified by f nor used outside of it, and f must not call any method of the wrapper.
+func (queue *Queue) View(f func(queue queues.Queue)) {
+	queue.rlock()
+	defer queue.runlock()
+	f(queue.queue)
+}
@@ -0,0 +1,138 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package concurrent
+
+import (
+	"github.com/emirpasic/gods/containers"
+	"github.com/emirpasic/gods/sets"
+	"github.com/emirpasic/gods/sets/hashset"
+)
+
+// Assert Set implementation
+var _ sets.Set = (*Set)(nil)
+var _ containers.JSONSerializer = (*Set)(nil)
+var _ containers.JSONDeserializer = (*Set)(nil)
+
+// Set wraps a set to make it safe for concurrent use.
+type Set struct {
+	guarded
+	set sets.Set
+}
+
+// NewSet instantiates a wrapper of the set that is safe for concurrent use.
+func NewSet(set sets.Set) *Set {
+	return &Set{guarded: guarded{container: set}, set: set}
+}
+
+// Add adds the elements (one or more) to the set.
+func (set *Set) Add(elements ...interface{}) {
+	set.mutex.Lock()
+	defer set.mutex.Unlock()
+	set.set.Add(elements...)
+}
+
+// Remove removes the elements (one or more) from the set.
+func (set *Set) Remove(elements ...interface{}) {
+	set.mutex.Lock()
+	defer set.mutex.Unlock()
+	set.set.Remove(elements...)
+}
+
+// Contains check if elements (one or more) are present in the set.
+// All elements have to be present in the set for the method to return true.
+// Returns true if no arguments are passed at all, i.e. set is always superset of empty set.
+func (set *Set) Contains(elements ...interface{}) bool {
+	set.rlock()
+	defer set.runlock()
+	return set.set.Contains(elements...)
+}
+
+// Union returns a wrapper of the union of the wrapped set with another set.
+func (set *Set) Union(another sets.Set) sets.Set {
+	another = unwrap(another)
+	set.rlock()
+	defer set.runlock()
+	return NewSet(set.set.Union(another))
+}
+
+// Intersection returns a wrapper of the intersection of the wrapped set with another set.
+func (set *Set) Intersection(another sets.Set) sets.Set {
+	another = unwrap(another)
+	set.rlock()
+	defer set.runlock()
+	return NewSet(set.set.Intersection(another))
+}
+
+// Difference returns a wrapper of the difference of the wrapped set and another set.
+func (set *Set) Difference(another sets.Set) sets.Set {
+	another = unwrap(another)
+	set.rlock()
+	defer set.runlock()
+	return NewSet(set.set.Difference(another))
+}
+
+// SymmetricDifference returns a wrapper of the symmetric difference of the wrapped set and another set.
+func (set *Set) SymmetricDifference(another sets.Set) sets.Set {
+	another = unwrap(another)
+	set.rlock()
+	defer set.runlock()
+	return NewSet(set.set.SymmetricDifference(another))
+}
+
+// IsSubsetOf returns true if all elements of the set are in another set.
+func (set *Set) IsSubsetOf(another sets.Set) bool {
+	another = unwrap(another)
+	set.rlock()
+	defer set.runlock()
+	return set.set.IsSubsetOf(another)
+}
+
+// IsSupersetOf returns true if all elements of another set are in the set.
+func (set *Set) IsSupersetOf(another sets.Set) bool {
+	another = unwrap(another)
+	set.rlock()
+	defer set.runlock()
+	return set.set.IsSupersetOf(another)
+}
+
+// IsDisjoint returns true if the set has no elements in common with another set.
+func (set *Set) IsDisjoint(another sets.Set) bool {
+	another = unwrap(another)
+	set.rlock()
+	defer set.runlock()
+	return set.set.IsDisjoint(another)
+}
+
+// Equals returns true if the set and another set contain the same elements.
+func (set *Set) Equals(another sets.Set) bool {
+	another = unwrap(another)
+	set.rlock()
+	defer set.runlock()
+	return set.set.Equals(another)
+}
+
+// Update calls f with the wrapped set while holding the write lock, so that f can read and modify the set
+// atomically. The set must not be used outside of f, and f must not call any method of the wrapper.
+func (set *Set) Update(f func(set sets.Set)) {
+	set.mutex.Lock()
+	defer set.mutex.Unlock()
+	f(set.set)
+}
+
+// View calls f with the wrapped set while holding the read lock, so that f can read the set atomically.
+// The set must neither be modified by f nor used outside of it, and f must not call any method of the wrapper.
+func (set *Set) View(f func(set sets.Set)) {
+	set.rlock()
+	defer set.runlock()
+	f(set.set)
+}
+
+// unwrap replaces a wrapper by a snapshot of its elements, so that operations on two sets never hold both locks
+func unwrap(set sets.Set) sets.Set {
+	if wrapper, ok := set.(*Set); ok {
+		return hashset.New(wrapper.Values()...)
+	}
+	return set
+}
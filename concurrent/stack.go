@@ -0,0 +1,65 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package concurrent
+
+import (
+	"github.com/emirpasic/gods/containers"
+	"github.com/emirpasic/gods/stacks"
+)
+
+// Assert Stack implementation
+var _ stacks.Stack = (*Stack)(nil)
+var _ containers.JSONSerializer = (*Stack)(nil)
+var _ containers.JSONDeserializer = (*Stack)(nil)
+
+// Stack wraps a stack to make it safe for concurrent use.
+type Stack struct {
+	guarded
+	stack stacks.Stack
+}
+
+// NewStack instantiates a wrapper of the stack that is safe for concurrent use.
+func NewStack(stack stacks.Stack) *Stack {
+	return &Stack{guarded: guarded{container: stack}, stack: stack}
+}
+
+// Push adds a value onto the top of the stack.
+func (stack *Stack) Push(value interface{}) {
+	stack.mutex.Lock()
+	defer stack.mutex.Unlock()
+	stack.stack.Push(value)
+}
+
+// Pop removes top element on stack and returns it, or nil if stack is empty.
+// Second return parameter is true, unless the stack was empty and there was nothing to pop.
+func (stack *Stack) Pop() (value interface{}, ok bool) {
+	stack.mutex.Lock()
+	defer stack.mutex.Unlock()
+	return stack.stack.Pop()
+}
+
+// Peek returns top element on the stack without removing it, or nil if stack is empty.
+// Second return parameter is true, unless the stack was empty and there was nothing to peek.
+func (stack *Stack) Peek() (value interface{}, ok bool) {
+	stack.rlock()
+	defer stack.runlock()
+	return stack.stack.Peek()
+}
+
+// Update calls f with the wrapped stack while holding the write lock, so that f can read and modify the stack
+// atomically. The stack must not be used outside of f, and f must not call any method of the wrapper.
+func (stack *Stack) Update(f func(stack stacks.Stack)) {
+	stack.mutex.Lock()
+	defer stack.mutex.Unlock()
+	f(stack.stack)
+}
+
+// View calls f with the wrapped stack while holding the read lock, so that f can read the stack atomically.
+// The stack must neither be modified by f nor used outside of it, and f must not call any method of the wrapper.
+func (stack *Stack) View(f func(stack stacks.Stack)) {
+	stack.rlock()
+	defer stack.runlock()
+	f(stack.stack)
+}
@@ -0,0 +1,44 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package concurrent
+
+import (
+	"github.com/emirpasic/gods/containers"
+	"github.com/emirpasic/gods/trees"
+)
+
+// Assert Tree implementation
+var _ trees.Tree = (*Tree)(nil)
+var _ containers.JSONSerializer = (*Tree)(nil)
+var _ containers.JSONDeserializer = (*Tree)(nil)
+
+// Tree wraps a tree to make it safe for concurrent use.
+// Trees have no common methods besides those of all containers, so all other operations go through Update and View,
+// e.g. tree.Update(func(t trees.Tree) { t.(*redblacktree.Tree).Put(1, "a") }).
+type Tree struct {
+	guarded
+	tree trees.Tree
+}
+
+// NewTree instantiates a wrapper of the tree that is safe for concurrent use.
+func NewTree(tree trees.Tree) *Tree {
+	return &Tree{guarded: guarded{container: tree}, tree: tree}
+}
+
+// Update calls f with the wrapped tree while holding the write lock, so that f can read and modify the tree
+// atomically. The tree must not be used outside of f, and f must not call any method of the wrapper.
+func (tree *Tree) Update(f func(tree trees.Tree)) {
+	tree.mutex.Lock()
+	defer tree.mutex.Unlock()
+	f(tree.tree)
+}
+
+// View calls f with the wrapped tree while holding the read lock, so that f can read the tree atomically.
+// The tree must neither be modified by f nor used outside of it, and f must not call any method of the wrapper.
+func (tree *Tree) View(f func(tree trees.Tree)) {
+	tree.rlock()
+	defer tree.runlock()
+	f(tree.tree)
+}
@@ -11,10 +11,12 @@ type EnumerableWithIndex interface {
 
 	// Map invokes the given function once for each element and returns a
 	// container containing the values returned by the given function.
-	// Map(func(index int, value interface{}) interface{}) Container
+	// The returned container is of the same kind as the receiver and can be enumerated further.
+	Map(func(index int, value interface{}) interface{}) EnumerableContainerWithIndex
 
 	// Select returns a new container containing all elements for which the given function returns a true value.
-	// Select(func(index int, value interface{}) bool) Container
+	// The returned container is of the same kind as the receiver and can be enumerated further.
+	Select(func(index int, value interface{}) bool) EnumerableContainerWithIndex
 
 	// Any passes each element of the container to the given function and
 	// returns true if the function ever returns true for any element.
@@ -37,10 +39,12 @@ type EnumerableWithKey interface {
 
 	// Map invokes the given function once for each element and returns a container
 	// containing the values returned by the given function as key/value pairs.
-	// Map(func(key interface{}, value interface{}) (interface{}, interface{})) Container
+	// The returned container is of the same kind as the receiver and can be enumerated further.
+	Map(func(key interface{}, value interface{}) (interface{}, interface{})) EnumerableContainerWithKey
 
 	// Select returns a new container containing all elements for which the given function returns a true value.
-	// Select(func(key interface{}, value interface{}) bool) Container
+	// The returned container is of the same kind as the receiver and can be enumerated further.
+	Select(func(key interface{}, value interface{}) bool) EnumerableContainerWithKey
 
 	// Any passes each element of the container to the given function and
 	// returns true if the function ever returns true for any element.
@@ -55,3 +59,17 @@ type EnumerableWithKey interface {
 	// matches the criteria.
 	Find(func(key interface{}, value interface{}) bool) (interface{}, interface{})
 }
+
+// EnumerableContainerWithIndex is a container whose values can be enumerated by an index.
+// Map and Select return it, so that calls can be chained without knowing the concrete container.
+type EnumerableContainerWithIndex interface {
+	Container
+	EnumerableWithIndex
+}
+
+// EnumerableContainerWithKey is a container whose key/value pairs can be enumerated.
+// Map and Select return it, so that calls can be chained without knowing the concrete container.
+type EnumerableContainerWithKey interface {
+	Container
+	EnumerableWithKey
+}
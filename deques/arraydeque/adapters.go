@@ -0,0 +1,133 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package arraydeque
+
+import (
+	"fmt"
+	"github.com/emirpasic/gods/queues"
+	"github.com/emirpasic/gods/stacks"
+	"strings"
+)
+
+// Assert Queue and Stack implementations
+var _ queues.Queue = (*Queue)(nil)
+var _ stacks.Stack = (*Stack)(nil)
+
+// Queue adapts a deque to the Queue interface, i.e. values are enqueued at the back and dequeued from the front.
+type Queue struct {
+	deque *Deque
+}
+
+// Stack adapts a deque to the Stack interface, i.e. values are pushed to and popped from the back.
+type Stack struct {
+	deque *Deque
+}
+
+// AsQueue returns a first-in-first-out queue backed by the deque.
+// Changes made through the queue are visible in the deque and vice versa.
+func (deque *Deque) AsQueue() *Queue {
+	return &Queue{deque: deque}
+}
+
+// AsStack returns a last-in-first-out stack backed by the deque.
+// Changes made through the stack are visible in the deque and vice versa.
+func (deque *Deque) AsStack() *Stack {
+	return &Stack{deque: deque}
+}
+
+// Enqueue adds a value to the end of the queue
+func (queue *Queue) Enqueue(value interface{}) {
+	queue.deque.PushBack(value)
+}
+
+// Dequeue removes first element of the queue and returns it, or nil if queue is empty.
+// Second return parameter is true, unless the queue was empty and there was nothing to dequeue.
+func (queue *Queue) Dequeue() (value interface{}, ok bool) {
+	return queue.deque.PopFront()
+}
+
+// Peek returns first element of the queue without removing it, or nil if queue is empty.
+// Second return parameter is true, unless the queue was empty and there was nothing to peek.
+func (queue *Queue) Peek() (value interface{}, ok bool) {
+	return queue.deque.PeekFront()
+}
+
+// Empty returns true if queue does not contain any elements.
+func (queue *Queue) Empty() bool {
+	return queue.deque.Empty()
+}
+
+// Size returns number of elements within the queue.
+func (queue *Queue) Size() int {
+	return queue.deque.Size()
+}
+
+// Clear removes all elements from the queue.
+func (queue *Queue) Clear() {
+	queue.deque.Clear()
+}
+
+// Values returns all elements in the queue (FIFO order).
+func (queue *Queue) Values() []interface{} {
+	return queue.deque.Values()
+}
+
+// String returns a string representation of container
+func (queue *Queue) String() string {
+	return queue.deque.String()
+}
+
+// Push adds a value onto the top of the stack
+func (stack *Stack) Push(value interface{}) {
+	stack.deque.PushBack(value)
+}
+
+// Pop removes top element on stack and returns it, or nil if stack is empty.
+// Second return parameter is true, unless the stack was empty and there was nothing to pop.
+func (stack *Stack) Pop() (value interface{}, ok bool) {
+	return stack.deque.PopBack()
+}
+
+// Peek returns top element on the stack without removing it, or nil if stack is empty.
+// Second return parameter is true, unless the stack was empty and there was nothing to peek.
+func (stack *Stack) Peek() (value interface{}, ok bool) {
+	return stack.deque.PeekBack()
+}
+
+// Empty returns true if stack does not contain any elements.
+func (stack *Stack) Empty() bool {
+	return stack.deque.Empty()
+}
+
+// Size returns number of elements within the stack.
+func (stack *Stack) Size() int {
+	return stack.deque.Size()
+}
+
+// Clear removes all elements from the stack.
+func (stack *Stack) Clear() {
+	stack.deque.Clear()
+}
+
+// Values returns all elements in the stack (LIFO order).
+func (stack *Stack) Values() []interface{} {
+	size := stack.deque.Size()
+	values := make([]interface{}, size, size)
+	for i := 0; i < size; i++ {
+		values[i], _ = stack.deque.Get(size - 1 - i) // in reverse (LIFO)
+	}
+	return values
+}
+
+// String returns a string representation of container, listing the elements in LIFO order like Values.
+func (stack *Stack) String() string {
+	str := "ArrayDeque\n"
+	values := []string{}
+	for _, value := range stack.Values() {
+		values = append(values, fmt.Sprintf("%v", value))
+	}
+	str += strings.Join(values, ", ")
+	return str
+}
@@ -0,0 +1,180 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package arraydeque implements a double-ended queue backed by a growable ring buffer.
+//
+// Elements can be pushed to and popped from both ends in amortized O(1) time, and accessed by index in O(1) time.
+// The buffer grows when it is full and shrinks when it is mostly empty, similarly to the array list.
+//
+// Structure is not thread safe.
+//
+// Reference: https://en.wikipedia.org/wiki/Double-ended_queue
+package arraydeque
+
+import (
+	"fmt"
+	"github.com/emirpasic/gods/deques"
+	"strings"
+)
+
+// Assert Deque implementation
+var _ deques.Deque = (*Deque)(nil)
+
+// Deque holds the elements in a ring buffer, i.e. the element at index i is stored at (start+i) modulo capacity.
+type Deque struct {
+	values []interface{}
+	start  int
+	size   int
+}
+
+const (
+	growthFactor = float32(2.0)  // growth by 100%
+	shrinkFactor = float32(0.25) // shrink when size is 25% of capacity (0 means never shrink)
+)
+
+// New instantiates a new empty deque
+func New() *Deque {
+	return &Deque{}
+}
+
+// PushFront adds a value to the front of the deque
+func (deque *Deque) PushFront(value interface{}) {
+	deque.growBy(1)
+	deque.start = deque.position(-1)
+	deque.values[deque.start] = value
+	deque.size++
+}
+
+// PushBack adds a value to the back of the deque
+func (deque *Deque) PushBack(value interface{}) {
+	deque.growBy(1)
+	deque.values[deque.position(deque.size)] = value
+	deque.size++
+}
+
+// PopFront removes the first element of the deque and returns it, or nil if deque is empty.
+// Second return parameter is true, unless the deque was empty and there was nothing to pop.
+func (deque *Deque) PopFront() (value interface{}, ok bool) {
+	if deque.size == 0 {
+		return nil, false
+	}
+	value = deque.values[deque.start]
+	deque.values[deque.start] = nil
+	deque.start = deque.position(1)
+	deque.size--
+	deque.shrink()
+	return value, true
+}
+
+// PopBack removes the last element of the deque and returns it, or nil if deque is empty.
+// Second return parameter is true, unless the deque was empty and there was nothing to pop.
+func (deque *Deque) PopBack() (value interface{}, ok bool) {
+	if deque.size == 0 {
+		return nil, false
+	}
+	index := deque.position(deque.size - 1)
+	value = deque.values[index]
+	deque.values[index] = nil
+	deque.size--
+	deque.shrink()
+	return value, true
+}
+
+// PeekFront returns the first element of the deque without removing it, or nil if deque is empty.
+// Second return parameter is true, unless the deque was empty and there was nothing to peek.
+func (deque *Deque) PeekFront() (value interface{}, ok bool) {
+	return deque.Get(0)
+}
+
+// PeekBack returns the last element of the deque without removing it, or nil if deque is empty.
+// Second return parameter is true, unless the deque was empty and there was nothing to peek.
+func (deque *Deque) PeekBack() (value interface{}, ok bool) {
+	return deque.Get(deque.size - 1)
+}
+
+// Get returns the element at index, counting from the front of the deque.
+// Second return parameter is true if index is within bounds of the deque and deque is not empty, otherwise false.
+func (deque *Deque) Get(index int) (value interface{}, ok bool) {
+	if !deque.withinRange(index) {
+		return nil, false
+	}
+	return deque.values[deque.position(index)], true
+}
+
+// Empty returns true if deque does not contain any elements.
+func (deque *Deque) Empty() bool {
+	return deque.size == 0
+}
+
+// Size returns number of elements within the deque.
+func (deque *Deque) Size() int {
+	return deque.size
+}
+
+// Clear removes all elements from the deque.
+func (deque *Deque) Clear() {
+	deque.values = []interface{}{}
+	deque.start = 0
+	deque.size = 0
+}
+
+// Values returns all elements in the deque (from front to back).
+func (deque *Deque) Values() []interface{} {
+	values := make([]interface{}, deque.size, deque.size)
+	n := copy(values, deque.values[deque.start:])
+	copy(values[n:], deque.values[:deque.size-n])
+	return values
+}
+
+// String returns a string representation of container
+func (deque *Deque) String() string {
+	str := "ArrayDeque\n"
+	values := []string{}
+	for _, value := range deque.Values() {
+		values = append(values, fmt.Sprintf("%v", value))
+	}
+	str += strings.Join(values, ", ")
+	return str
+}
+
+// Check that the index is within bounds of the deque
+func (deque *Deque) withinRange(index int) bool {
+	return index >= 0 && index < deque.size
+}
+
+// position returns the position in the buffer of the element at the given index, which may be one before the front.
+func (deque *Deque) position(index int) int {
+	capacity := len(deque.values)
+	return ((deque.start+index)%capacity + capacity) % capacity
+}
+
+// resize moves the elements to a new buffer of the given capacity, starting at its beginning
+func (deque *Deque) resize(cap int) {
+	newValues := make([]interface{}, cap, cap)
+	copy(newValues, deque.Values())
+	deque.values = newValues
+	deque.start = 0
+}
+
+// Expand the buffer if necessary, i.e. capacity will be exceeded if we add n elements
+func (deque *Deque) growBy(n int) {
+	// When capacity is reached, grow by a factor of growthFactor and add number of elements
+	currentCapacity := len(deque.values)
+	if deque.size+n > currentCapacity {
+		newCapacity := int(growthFactor * float32(currentCapacity+n))
+		deque.resize(newCapacity)
+	}
+}
+
+// Shrink the buffer if necessary, i.e. when size is shrinkFactor percent of current capacity
+func (deque *Deque) shrink() {
+	if shrinkFactor == 0.0 {
+		return
+	}
+	// Shrink when size is at shrinkFactor * capacity
+	currentCapacity := len(deque.values)
+	if deque.size <= int(float32(currentCapacity)*shrinkFactor) {
+		deque.resize(deque.size)
+	}
+}
@@ -0,0 +1,640 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package arraydeque
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+func TestDequePushBack(t *testing.T) {
+	deque := New()
+	if actualValue := deque.Empty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	deque.PushBack(1)
+	deque.PushBack(2)
+	deque.PushBack(3)
+
+	if actualValue, expectedValue := fmt.Sprintf("%v", deque.Values()), "[1 2 3]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue := deque.Empty(); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	if actualValue := deque.Size(); actualValue != 3 {
+		t.Errorf("Got %v expected %v", actualValue, 3)
+	}
+	if actualValue, ok := deque.PeekFront(); actualValue != 1 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 1)
+	}
+	if actualValue, ok := deque.PeekBack(); actualValue != 3 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 3)
+	}
+}
+
+func TestDequePushFront(t *testing.T) {
+	deque := New()
+	deque.PushFront(1)
+	deque.PushFront(2)
+	deque.PushBack(3)
+	deque.PushFront(4)
+
+	if actualValue, expectedValue := fmt.Sprintf("%v", deque.Values()), "[4 2 1 3]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue := deque.Size(); actualValue != 4 {
+		t.Errorf("Got %v expected %v", actualValue, 4)
+	}
+	if actualValue, ok := deque.PeekFront(); actualValue != 4 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 4)
+	}
+	if actualValue, ok := deque.PeekBack(); actualValue != 3 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 3)
+	}
+}
+
+func TestDequePeek(t *testing.T) {
+	deque := New()
+	if actualValue, ok := deque.PeekFront(); actualValue != nil || ok {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+	if actualValue, ok := deque.PeekBack(); actualValue != nil || ok {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+	deque.PushBack(1)
+	if actualValue, ok := deque.PeekFront(); actualValue != 1 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 1)
+	}
+	if actualValue, ok := deque.PeekBack(); actualValue != 1 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 1)
+	}
+}
+
+func TestDequePop(t *testing.T) {
+	deque := New()
+	deque.PushBack(1)
+	deque.PushBack(2)
+	deque.PushBack(3)
+	deque.PushFront(0)
+
+	if actualValue, ok := deque.PopFront(); actualValue != 0 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 0)
+	}
+	if actualValue, ok := deque.PopBack(); actualValue != 3 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 3)
+	}
+	if actualValue, ok := deque.PopBack(); actualValue != 2 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 2)
+	}
+	if actualValue, ok := deque.PopFront(); actualValue != 1 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 1)
+	}
+	if actualValue, ok := deque.PopFront(); actualValue != nil || ok {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+	if actualValue, ok := deque.PopBack(); actualValue != nil || ok {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+	if actualValue := deque.Empty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := deque.Values(); len(actualValue) != 0 {
+		t.Errorf("Got %v expected %v", actualValue, "[]")
+	}
+}
+
+func TestDequeGet(t *testing.T) {
+	deque := New()
+	if actualValue, ok := deque.Get(0); actualValue != nil || ok {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+	deque.PushBack("b")
+	deque.PushBack("c")
+	deque.PushFront("a")
+	for index, expectedValue := range []string{"a", "b", "c"} {
+		if actualValue, ok := deque.Get(index); actualValue != expectedValue || !ok {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+	if actualValue, ok := deque.Get(-1); actualValue != nil || ok {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+	if actualValue, ok := deque.Get(3); actualValue != nil || ok {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+}
+
+func TestDequeClear(t *testing.T) {
+	deque := New()
+	deque.PushBack(1)
+	deque.PushFront(0)
+	deque.Clear()
+	if actualValue := deque.Empty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := deque.Size(); actualValue != 0 {
+		t.Errorf("Got %v expected %v", actualValue, 0)
+	}
+	deque.PushFront(1)
+	if actualValue, expectedValue := fmt.Sprintf("%v", deque.Values()), "[1]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestDequeWrapAroundAndResize(t *testing.T) {
+	deque := New()
+	expected := []interface{}{}
+	random := rand.New(rand.NewSource(1))
+
+	for i := 0; i < 10000; i++ {
+		switch random.Intn(5) {
+		case 0:
+			deque.PushFront(i)
+			expected = append([]interface{}{i}, expected...)
+		case 1:
+			deque.PushBack(i)
+			expected = append(expected, i)
+		case 2:
+			value, ok := deque.PopFront()
+			if len(expected) == 0 {
+				if ok {
+					t.Fatalf("Got %v expected %v", value, nil)
+				}
+				continue
+			}
+			if value != expected[0] || !ok {
+				t.Fatalf("Got %v expected %v", value, expected[0])
+			}
+			expected = expected[1:]
+		case 3:
+			value, ok := deque.PopBack()
+			if len(expected) == 0 {
+				if ok {
+					t.Fatalf("Got %v expected %v", value, nil)
+				}
+				continue
+			}
+			if value != expected[len(expected)-1] || !ok {
+				t.Fatalf("Got %v expected %v", value, expected[len(expected)-1])
+			}
+			expected = expected[:len(expected)-1]
+		case 4:
+			if len(expected) > 0 {
+				index := random.Intn(len(expected))
+				if value, ok := deque.Get(index); value != expected[index] || !ok {
+					t.Fatalf("Got %v expected %v", value, expected[index])
+				}
+			}
+		}
+		if actualValue, expectedValue := deque.Size(), len(expected); actualValue != expectedValue {
+			t.Fatalf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", deque.Values()), fmt.Sprintf("%v", expected); actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestDequeAsQueue(t *testing.T) {
+	deque := New()
+	queue := deque.AsQueue()
+	queue.Enqueue(1)
+	queue.Enqueue(2)
+	queue.Enqueue(3)
+	deque.PushFront(0)
+
+	if actualValue, expectedValue := fmt.Sprintf("%v", queue.Values()), "[0 1 2 3]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, ok := queue.Peek(); actualValue != 0 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 0)
+	}
+	for _, expectedValue := range []int{0, 1, 2, 3} {
+		if actualValue, ok := queue.Dequeue(); actualValue != expectedValue || !ok {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+	if actualValue, ok := queue.Dequeue(); actualValue != nil || ok {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+	if actualValue := queue.Empty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	queue.Enqueue(1)
+	queue.Clear()
+	if actualValue := deque.Size(); actualValue != 0 {
+		t.Errorf("Got %v expected %v", actualValue, 0)
+	}
+	if !strings.HasPrefix(queue.String(), "ArrayDeque") {
+		t.Errorf("String should start with container name")
+	}
+}
+
+func TestDequeAsStack(t *testing.T) {
+	deque := New()
+	stack := deque.AsStack()
+	stack.Push(1)
+	stack.Push(2)
+	stack.Push(3)
+
+	if actualValue, expectedValue := fmt.Sprintf("%v", stack.Values()), "[3 2 1]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", deque.Values()), "[1 2 3]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, ok := stack.Peek(); actualValue != 3 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 3)
+	}
+	for _, expectedValue := range []int{3, 2, 1} {
+		if actualValue, ok := stack.Pop(); actualValue != expectedValue || !ok {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+	if actualValue, ok := stack.Pop(); actualValue != nil || ok {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+	if actualValue := stack.Empty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := stack.Size(); actualValue != 0 {
+		t.Errorf("Got %v expected %v", actualValue, 0)
+	}
+	stack.Push(1)
+	stack.Clear()
+	if actualValue := deque.Empty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if !strings.HasPrefix(stack.String(), "ArrayDeque") {
+		t.Errorf("String should start with container name")
+	}
+	stack.Push(1)
+	stack.Push(2)
+	stack.Push(3)
+	if actualValue, expectedValue := stack.String(), "ArrayDeque\n3, 2, 1"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestDequeEnumerable(t *testing.T) {
+	deque := New()
+	deque.PushBack("b")
+	deque.PushBack("c")
+	deque.PushFront("a")
+
+	count := 0
+	deque.Each(func(index int, value interface{}) {
+		if actualValue, expectedValue := index, count; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		count++
+	})
+	if actualValue, expectedValue := count, 3; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	mapped := deque.Map(func(index int, value interface{}) interface{} {
+		return strings.ToUpper(value.(string))
+	})
+	if actualValue, expectedValue := fmt.Sprintf("%v", mapped.Values()), "[A B C]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if _, ok := mapped.(*Deque); !ok {
+		t.Errorf("Got %T expected %v", mapped, "*Deque")
+	}
+
+	selected := deque.Select(func(index int, value interface{}) bool {
+		return value.(string) >= "b"
+	})
+	if actualValue, expectedValue := fmt.Sprintf("%v", selected.Values()), "[b c]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	if actualValue := deque.Any(func(index int, value interface{}) bool { return value.(string) == "c" }); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := deque.All(func(index int, value interface{}) bool { return value.(string) >= "b" }); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	if index, value := deque.Find(func(index int, value interface{}) bool { return value.(string) == "c" }); index != 2 || value != "c" {
+		t.Errorf("Got %v at %v expected %v at %v", value, index, "c", 2)
+	}
+	if index, value := deque.Find(func(index int, value interface{}) bool { return value.(string) == "x" }); index != -1 || value != nil {
+		t.Errorf("Got %v at %v expected %v at %v", value, index, nil, -1)
+	}
+}
+
+func TestDequeIteratorOnEmpty(t *testing.T) {
+	deque := New()
+	it := deque.Iterator()
+	for it.Next() {
+		t.Errorf("Shouldn't iterate on empty deque")
+	}
+	for it.Prev() {
+		t.Errorf("Shouldn't iterate on empty deque")
+	}
+}
+
+func TestDequeIteratorNext(t *testing.T) {
+	deque := New()
+	deque.PushBack("b")
+	deque.PushBack("c")
+	deque.PushFront("a")
+
+	it := deque.Iterator()
+	count := 0
+	for it.Next() {
+		count++
+		index := it.Index()
+		value := it.Value()
+		switch index {
+		case 0:
+			if actualValue, expectedValue := value, "a"; actualValue != expectedValue {
+				t.Errorf("Got %v expected %v", actualValue, expectedValue)
+			}
+		case 1:
+			if actualValue, expectedValue := value, "b"; actualValue != expectedValue {
+				t.Errorf("Got %v expected %v", actualValue, expectedValue)
+			}
+		case 2:
+			if actualValue, expectedValue := value, "c"; actualValue != expectedValue {
+				t.Errorf("Got %v expected %v", actualValue, expectedValue)
+			}
+		default:
+			t.Errorf("Too many")
+		}
+		if actualValue, expectedValue := index, count-1; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+	if actualValue, expectedValue := count, 3; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestDequeIteratorPrev(t *testing.T) {
+	deque := New()
+	deque.PushBack("b")
+	deque.PushBack("c")
+	deque.PushFront("a")
+
+	it := deque.Iterator()
+	for it.Next() {
+	}
+	count := 0
+	for it.Prev() {
+		count++
+		index := it.Index()
+		value := it.Value()
+		switch index {
+		case 0:
+			if actualValue, expectedValue := value, "a"; actualValue != expectedValue {
+				t.Errorf("Got %v expected %v", actualValue, expectedValue)
+			}
+		case 1:
+			if actualValue, expectedValue := value, "b"; actualValue != expectedValue {
+				t.Errorf("Got %v expected %v", actualValue, expectedValue)
+			}
+		case 2:
+			if actualValue, expectedValue := value, "c"; actualValue != expectedValue {
+				t.Errorf("Got %v expected %v", actualValue, expectedValue)
+			}
+		default:
+			t.Errorf("Too many")
+		}
+		if actualValue, expectedValue := index, 3-count; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+	if actualValue, expectedValue := count, 3; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestDequeIteratorFirstAndLast(t *testing.T) {
+	deque := New()
+	it := deque.Iterator()
+	if actualValue, expectedValue := it.First(), false; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := it.Last(), false; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	deque.PushBack("b")
+	deque.PushBack("c")
+	deque.PushFront("a")
+	if actualValue, expectedValue := it.First(), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if index, value := it.Index(), it.Value(); index != 0 || value != "a" {
+		t.Errorf("Got %v,%v expected %v,%v", index, value, 0, "a")
+	}
+	if actualValue, expectedValue := it.Last(), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if index, value := it.Index(), it.Value(); index != 2 || value != "c" {
+		t.Errorf("Got %v,%v expected %v,%v", index, value, 2, "c")
+	}
+}
+
+func TestDequeIteratorNextToAndPrevTo(t *testing.T) {
+	// Sample seek function, i.e. string ending with "b"
+	seek := func(index int, value interface{}) bool {
+		return strings.HasSuffix(value.(string), "b")
+	}
+
+	deque := New()
+	deque.PushBack("xx")
+	deque.PushBack("bb")
+	deque.PushBack("cc")
+	deque.PushFront("ab")
+
+	it := deque.Iterator()
+	if !it.NextTo(seek) {
+		t.Errorf("Should find an element")
+	}
+	if index, value := it.Index(), it.Value(); index != 0 || value != "ab" {
+		t.Errorf("Got %v,%v expected %v,%v", index, value, 0, "ab")
+	}
+	if !it.NextTo(seek) {
+		t.Errorf("Should find an element")
+	}
+	if index, value := it.Index(), it.Value(); index != 2 || value != "bb" {
+		t.Errorf("Got %v,%v expected %v,%v", index, value, 2, "bb")
+	}
+	if it.NextTo(seek) {
+		t.Errorf("Should not find an element")
+	}
+
+	it.End()
+	if !it.PrevTo(seek) {
+		t.Errorf("Should find an element")
+	}
+	if index, value := it.Index(), it.Value(); index != 2 || value != "bb" {
+		t.Errorf("Got %v,%v expected %v,%v", index, value, 2, "bb")
+	}
+}
+
+func TestDequeSerialization(t *testing.T) {
+	deque := New()
+	deque.PushBack("b")
+	deque.PushBack("c")
+	deque.PushFront("a")
+
+	var err error
+	assert := func() {
+		if actualValue, expectedValue := fmt.Sprintf("%s%s%s", deque.Values()...), "abc"; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		if actualValue, expectedValue := deque.Size(), 3; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		if err != nil {
+			t.Errorf("Got error %v", err)
+		}
+	}
+
+	assert()
+
+	bytes, err := deque.ToJSON()
+	assert()
+
+	err = deque.FromJSON(bytes)
+	assert()
+
+	deque.PushFront("x")
+	deque.PopFront()
+	assert()
+
+	bytes, err = json.Marshal([]interface{}{"a", "b", "c", deque})
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+
+	err = json.Unmarshal([]byte(`[1,2,3]`), &deque)
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+}
+
+func TestDequeString(t *testing.T) {
+	c := New()
+	c.PushBack(1)
+	if !strings.HasPrefix(c.String(), "ArrayDeque") {
+		t.Errorf("String should start with container name")
+	}
+}
+
+func benchmarkPushBack(b *testing.B, deque *Deque, size int) {
+	for i := 0; i < b.N; i++ {
+		for n := 0; n < size; n++ {
+			deque.PushBack(n)
+		}
+	}
+}
+
+func benchmarkPushFront(b *testing.B, deque *Deque, size int) {
+	for i := 0; i < b.N; i++ {
+		for n := 0; n < size; n++ {
+			deque.PushFront(n)
+		}
+	}
+}
+
+func benchmarkPopFront(b *testing.B, deque *Deque, size int) {
+	for i := 0; i < b.N; i++ {
+		for n := 0; n < size; n++ {
+			deque.PopFront()
+		}
+	}
+}
+
+func benchmarkPopBack(b *testing.B, deque *Deque, size int) {
+	for i := 0; i < b.N; i++ {
+		for n := 0; n < size; n++ {
+			deque.PopBack()
+		}
+	}
+}
+
+func BenchmarkArrayDequePushBack100(b *testing.B) {
+	b.StopTimer()
+	size := 100
+	deque := New()
+	b.StartTimer()
+	benchmarkPushBack(b, deque, size)
+}
+
+func BenchmarkArrayDequePushBack10000(b *testing.B) {
+	b.StopTimer()
+	size := 10000
+	deque := New()
+	b.StartTimer()
+	benchmarkPushBack(b, deque, size)
+}
+
+func BenchmarkArrayDequePushFront100(b *testing.B) {
+	b.StopTimer()
+	size := 100
+	deque := New()
+	b.StartTimer()
+	benchmarkPushFront(b, deque, size)
+}
+
+func BenchmarkArrayDequePushFront10000(b *testing.B) {
+	b.StopTimer()
+	size := 10000
+	deque := New()
+	b.StartTimer()
+	benchmarkPushFront(b, deque, size)
+}
+
+func BenchmarkArrayDequePopFront100(b *testing.B) {
+	b.StopTimer()
+	size := 100
+	deque := New()
+	for n := 0; n < size; n++ {
+		deque.PushBack(n)
+	}
+	b.StartTimer()
+	benchmarkPopFront(b, deque, size)
+}
+
+func BenchmarkArrayDequePopFront10000(b *testing.B) {
+	b.StopTimer()
+	size := 10000
+	deque := New()
+	for n := 0; n < size; n++ {
+		deque.PushBack(n)
+	}
+	b.StartTimer()
+	benchmarkPopFront(b, deque, size)
+}
+
+func BenchmarkArrayDequePopBack100(b *testing.B) {
+	b.StopTimer()
+	size := 100
+	deque := New()
+	for n := 0; n < size; n++ {
+		deque.PushBack(n)
+	}
+	b.StartTimer()
+	benchmarkPopBack(b, deque, size)
+}
+
+func BenchmarkArrayDequePopBack10000(b *testing.B) {
+	b.StopTimer()
+	size := 10000
+	deque := New()
+	for n := 0; n < size; n++ {
+		deque.PushBack(n)
+	}
+	b.StartTimer()
+	benchmarkPopBack(b, deque, size)
+}
@@ -0,0 +1,79 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package arraydeque
+
+import "github.com/emirpasic/gods/containers"
+
+// Assert Enumerable implementation
+var _ containers.EnumerableWithIndex = (*Deque)(nil)
+
+// Each calls the given function once for each element, passing that element's index and value.
+func (deque *Deque) Each(f func(index int, value interface{})) {
+	iterator := deque.Iterator()
+	for iterator.Next() {
+		f(iterator.Index(), iterator.Value())
+	}
+}
+
+// Map invokes the given function once for each element and returns a
+// container containing the values returned by the given function.
+// Elements are visited from front to back and the returned deque holds the results in that same order.
+func (deque *Deque) Map(f func(index int, value interface{}) interface{}) containers.EnumerableContainerWithIndex {
+	newDeque := New()
+	iterator := deque.Iterator()
+	for iterator.Next() {
+		newDeque.PushBack(f(iterator.Index(), iterator.Value()))
+	}
+	return newDeque
+}
+
+// Select returns a new container containing all elements for which the given function returns a true value.
+func (deque *Deque) Select(f func(index int, value interface{}) bool) containers.EnumerableContainerWithIndex {
+	newDeque := New()
+	iterator := deque.Iterator()
+	for iterator.Next() {
+		if f(iterator.Index(), iterator.Value()) {
+			newDeque.PushBack(iterator.Value())
+		}
+	}
+	return newDeque
+}
+
+// Any passes each element of the container to the given function and
+// returns true if the function ever returns true for any element.
+func (deque *Deque) Any(f func(index int, value interface{}) bool) bool {
+	iterator := deque.Iterator()
+	for iterator.Next() {
+		if f(iterator.Index(), iterator.Value()) {
+			return true
+		}
+	}
+	return false
+}
+
+// All passes each element of the container to the given function and
+// returns true if the function returns true for all elements.
+func (deque *Deque) All(f func(index int, value interface{}) bool) bool {
+	iterator := deque.Iterator()
+	for iterator.Next() {
+		if !f(iterator.Index(), iterator.Value()) {
+			return false
+		}
+	}
+	return true
+}
+
+// Find passes each element of the container to the given function and returns
+// the first (index,value) for which the function is true or -1,nil otherwise
+// if no element matches the criteria.
+func (deque *Deque) Find(f func(index int, value interface{}) bool) (int, interface{}) {
+	iterator := deque.Iterator()
+	for iterator.Next() {
+		if f(iterator.Index(), iterator.Value()) {
+			return iterator.Index(), iterator.Value()
+		}
+	}
+	return -1, nil
+}
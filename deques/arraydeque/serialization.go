@@ -0,0 +1,41 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package arraydeque
+
+import (
+	"encoding/json"
+	"github.com/emirpasic/gods/containers"
+)
+
+// Assert Serialization implementation
+var _ containers.JSONSerializer = (*Deque)(nil)
+var _ containers.JSONDeserializer = (*Deque)(nil)
+
+// ToJSON outputs the JSON representation of the deque's elements (from front to back).
+func (deque *Deque) ToJSON() ([]byte, error) {
+	return json.Marshal(deque.Values())
+}
+
+// FromJSON populates the deque from the input JSON representation (from front to back).
+func (deque *Deque) FromJSON(data []byte) error {
+	var values []interface{}
+	err := json.Unmarshal(data, &values)
+	if err == nil {
+		deque.values = values
+		deque.start = 0
+		deque.size = len(values)
+	}
+	return err
+}
+
+// UnmarshalJSON @implements json.Unmarshaler
+func (deque *Deque) UnmarshalJSON(bytes []byte) error {
+	return deque.FromJSON(bytes)
+}
+
+// MarshalJSON @implements json.Marshaler
+func (deque *Deque) MarshalJSON() ([]byte, error) {
+	return deque.ToJSON()
+}
@@ -0,0 +1,31 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package deques provides an abstract Deque interface.
+//
+// In computer science, a double-ended queue (deque) is an abstract data type that generalizes a queue, for which elements can be added to or removed from either the front (head) or back (tail).
+// It can therefore serve both as a first-in-first-out queue and as a last-in-first-out stack.
+//
+// Reference: https://en.wikipedia.org/wiki/Double-ended_queue
+package deques
+
+import "github.com/emirpasic/gods/containers"
+
+// Deque interface that all deques implement
+type Deque interface {
+	PushFront(value interface{})
+	PushBack(value interface{})
+	PopFront() (value interface{}, ok bool)
+	PopBack() (value interface{}, ok bool)
+	PeekFront() (value interface{}, ok bool)
+	PeekBack() (value interface{}, ok bool)
+	Get(index int) (value interface{}, ok bool)
+
+	containers.Container
+	// Empty() bool
+	// Size() int
+	// Clear()
+	// Values() []interface{}
+	// String() string
+}
@@ -0,0 +1,31 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+	"github.com/emirpasic/gods/caches/arc"
+)
+
+// ARCCacheExample to demonstrate basic usage of ARCCache
+func main() {
+	c := arc.NewWith(2, func(key interface{}, value interface{}) {
+		fmt.Println("evicted", key) // b, a
+	})
+	c.Put("a", 1)      // a->1
+	c.Put("b", 2)      // a->1, b->2 (used once)
+	_, _ = c.Get("a")  // 1, true (b->2 used once, a->1 used twice)
+	_, _ = c.Peek("b") // 2, true (does not count as use)
+	c.Put("c", 3)      // c->3, a->1 (b is evicted, but its key is remembered)
+	_, _ = c.Get("b")  // nil, false
+	c.Put("b", 2)      // c->3, b->2 (b was evicted too early, so a is evicted instead of c)
+	_ = c.Keys()       // []interface {}{"c", "b"}
+	_ = c.Stats()      // {Hits:1 Misses:1 Evictions:2}
+	c.Remove("b")      // c->3
+	c.Clear()          // empty
+	c.Empty()          // true
+	c.Size()           // 0
+	c.Capacity()       // 2
+}
@@ -0,0 +1,33 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import "github.com/emirpasic/gods/deques/arraydeque"
+
+// ArrayDequeExample to demonstrate basic usage of ArrayDeque
+func main() {
+	deque := arraydeque.New() // empty
+	deque.PushBack(2)         // 2
+	deque.PushBack(3)         // 2, 3
+	deque.PushFront(1)        // 1, 2, 3
+	_ = deque.Values()        // 1, 2, 3
+	_, _ = deque.Get(1)       // 2, true
+	_, _ = deque.PeekFront()  // 1, true
+	_, _ = deque.PeekBack()   // 3, true
+	_, _ = deque.PopFront()   // 1, true
+	_, _ = deque.PopBack()    // 3, true
+	_, _ = deque.PopBack()    // 2, true
+	_, _ = deque.PopBack()    // nil, false (nothing to pop)
+	deque.Clear()             // empty
+	deque.Empty()             // true
+	deque.Size()              // 0
+
+	queue := deque.AsQueue() // queues.Queue, enqueues at the back and dequeues from the front
+	queue.Enqueue(1)         // 1
+	stack := deque.AsStack() // stacks.Stack, pushes to and pops from the back
+	stack.Push(2)            // 1, 2
+	_, _ = queue.Dequeue()   // 1, true
+	_, _ = stack.Pop()       // 2, true
+}
@@ -0,0 +1,52 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"context"
+	"github.com/emirpasic/gods/lists/arraylist"
+	bq "github.com/emirpasic/gods/queues/blockingqueue"
+	"time"
+)
+
+// BlockingQueueExample to demonstrate basic usage of BlockingQueue
+func main() {
+	ctx := context.Background()
+	queue := bq.New(2)              // empty (capacity is 2)
+	_ = queue.Put(ctx, 1)           // 1, nil
+	_ = queue.Put(ctx, 2)           // 1, 2, nil
+	_ = queue.Offer(3, 0)           // false (full, does not wait)
+	_ = queue.Offer(3, time.Second) // false (still full after waiting a second)
+	_, _ = queue.Take(ctx)          // 1, nil
+	_, _ = queue.Poll(0)            // 2, true
+	_, _ = queue.Poll(0)            // nil, false (empty, does not wait)
+
+	timeout, cancel := context.WithTimeout(ctx, time.Millisecond)
+	_, _ = queue.Take(timeout) // nil, context.DeadlineExceeded
+	cancel()
+
+	go func() {
+		for i := 1; i <= 4; i++ {
+			_ = queue.Put(ctx, i) // waits while the queue is full
+		}
+		queue.Close() // no more elements, wakes up waiting consumers
+	}()
+	for {
+		value, err := queue.Take(ctx) // 1, 2, 3, 4 in order, waits while the queue is empty
+		if err == bq.ErrClosed {
+			break // closed and drained
+		}
+		_ = value
+	}
+
+	list := arraylist.New()
+	queue = bq.New(3)
+	queue.Enqueue(1)        // 1
+	queue.Enqueue(2)        // 1, 2
+	_ = queue.DrainTo(list) // 2 (queue is empty, list is 1, 2)
+	_, _ = queue.Dequeue()  // nil, false (nothing to dequeue)
+	queue.Empty()           // true
+	_ = queue.Size()        // 0
+}
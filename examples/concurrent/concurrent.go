@@ -0,0 +1,53 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"github.com/emirpasic/gods/caches/lru"
+	"github.com/emirpasic/gods/concurrent"
+	"github.com/emirpasic/gods/maps"
+	"github.com/emirpasic/gods/maps/treemap"
+	"github.com/emirpasic/gods/sets/hashset"
+	"sync"
+)
+
+// ConcurrentExample to demonstrate basic usage of the concurrent wrappers
+func main() {
+	m := concurrent.NewMap(treemap.NewWithIntComparator()) // empty (keys are of type int)
+	var wg sync.WaitGroup
+	for worker := 0; worker < 4; worker++ {
+		wg.Add(1)
+		go func(worker int) {
+			defer wg.Done()
+			m.Put(worker, "x") // safe from any goroutine
+			_, _ = m.Get(worker)
+		}(worker)
+	}
+	wg.Wait()
+	_ = m.Keys() // []interface {}{0, 1, 2, 3} (in order)
+
+	m.Update(func(m maps.Map) { // atomic compound operation
+		if _, found := m.Get(4); !found {
+			m.Put(4, "y")
+		}
+	})
+	m.View(func(m maps.Map) { // atomic compound read
+		_, _ = m.Get(3)
+		_, _ = m.Get(4)
+	})
+
+	it := m.Iterator() // iterates over a snapshot
+	for it.Next() {
+		m.Remove(it.Key()) // does not affect the iteration
+	}
+	m.Empty() // true
+
+	set := concurrent.NewSet(hashset.New(1, 2, 3))
+	_ = set.Intersection(hashset.New(2, 3, 4)) // 2, 3 (random order), also safe for concurrent use
+
+	cache := concurrent.NewExclusiveMap(lru.New(2)) // caches modify themselves on Get
+	cache.Put("a", 1)
+	_, _ = cache.Get("a") // 1, true
+}
@@ -0,0 +1,46 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"github.com/emirpasic/gods/maps/concurrenthashmap"
+	"sync"
+)
+
+// ConcurrentHashMapExample to demonstrate basic usage of ConcurrentHashMap
+func main() {
+	m := concurrenthashmap.New() // empty
+	var wg sync.WaitGroup
+	for worker := 0; worker < 4; worker++ {
+		wg.Add(1)
+		go func(worker int) {
+			defer wg.Done()
+			m.Put(worker, "x") // safe from any goroutine
+			m.Compute("count", func(value interface{}, found bool) (interface{}, bool) {
+				if !found {
+					return 1, true
+				}
+				return value.(int) + 1, true // atomic increment
+			})
+		}(worker)
+	}
+	wg.Wait()
+	_, _ = m.Get("count") // 4, true
+
+	_, _ = m.PutIfAbsent(1, "y")      // x, true
+	_, _ = m.PutIfAbsent(4, "y")      // y, false
+	_ = m.CompareAndSwap(4, "y", "z") // true
+	_ = m.CompareAndSwap(4, "y", "w") // false
+	_ = m.ComputeIfAbsent(5, func(key interface{}) interface{} {
+		return key.(int) * 10
+	}) // 50
+	_ = m.Keys() // []interface {}{0, 1, 2, 3, 4, 5, "count"} (random order)
+
+	it := m.Iterator() // weakly consistent
+	for it.Next() {
+		m.Remove(it.Key()) // does not affect the iteration
+	}
+	m.Empty() // true
+}
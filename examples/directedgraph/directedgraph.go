@@ -0,0 +1,41 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"github.com/emirpasic/gods/graphs"
+	"github.com/emirpasic/gods/graphs/directedgraph"
+)
+
+// DirectedGraphExample to demonstrate basic usage of DirectedGraph
+func main() {
+	graph := directedgraph.NewWeighted() // empty
+	graph.AddWeightedEdge("a", "b", 4)   // a->b
+	graph.AddWeightedEdge("a", "c", 1)   // a->b, a->c
+	graph.AddWeightedEdge("c", "b", 2)   // a->b, a->c, c->b
+	graph.AddWeightedEdge("b", "d", 5)   // a->b, a->c, c->b, b->d
+	graph.AddVertex("e")                 // a, b, c, d, e (in insertion order)
+	_ = graph.Neighbors("a")             // []interface {}{"b", "c"} (in insertion order)
+	_ = graph.Predecessors("b")          // []interface {}{"a", "c"} (in insertion order)
+	_, _ = graph.Weight("c", "b")        // 2, true
+	_ = graph.ContainsEdge("b", "a")     // false
+	_ = graph.OutDegree("a")             // 2
+	_ = graph.EdgeCount()                // 4
+	_ = graph.Size()                     // 5 (vertices)
+
+	_, _ = graphs.TopologicalSort(graph) // []interface {}{"a", "e", "c", "b", "d"}, true
+	paths := graphs.Dijkstra(graph, "a") // shortest paths from a
+	_, _ = paths.Distance("d")           // 8, true
+	_, _ = paths.PathTo("d")             // []interface {}{"a", "c", "b", "d"}, true
+
+	graph.AddEdge("d", "a")                       // a->b, a->c, c->b, b->d, d->a
+	_ = graphs.FindCycle(graph)                   // []interface {}{"a", "b", "d"}
+	_ = graphs.StronglyConnectedComponents(graph) // [][]interface {}{{"a", "b", "c", "d"}, {"e"}}
+	_ = graphs.ToDOT(graph)                       // digraph {...}
+	graph.RemoveEdge("d", "a")                    // a->b, a->c, c->b, b->d
+	graph.RemoveVertex("b")                       // a->c, d, e
+	graph.Clear()                                 // empty
+	graph.Empty()                                 // true
+}
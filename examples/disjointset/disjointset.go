@@ -0,0 +1,28 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import "github.com/emirpasic/gods/sets/disjointset"
+
+// DisjointSetExample to demonstrate basic usage of DisjointSet
+func main() {
+	set := disjointset.New() // empty
+	set.MakeSet(1, 2, 3, 4)  // {1}, {2}, {3}, {4}
+	set.Union(1, 2)          // {1, 2}, {3}, {4} (returns true)
+	set.Union(3, 4)          // {1, 2}, {3, 4} (returns true)
+	set.Union(2, 1)          // {1, 2}, {3, 4} (returns false, already in the same set)
+	set.Union(4, 5)          // {1, 2}, {3, 4, 5} (5 is added)
+	_, _ = set.Find(5)       // 3, true (representative of the set)
+	_, _ = set.Find(6)       // nil, false
+	_ = set.Connected(3, 5)  // true
+	_ = set.Connected(1, 5)  // false
+	_ = set.SetSize(4)       // 3
+	_ = set.SetCount()       // 2
+	_ = set.Sets()           // [][]interface {}{{1, 2}, {3, 4, 5}}
+	_ = set.Values()         // []interface {}{1, 2, 3, 4, 5} (in insertion-order)
+	set.Size()               // 5
+	set.Clear()              // empty
+	set.Empty()              // true
+}
@@ -6,10 +6,11 @@ package main
 
 import (
 	"fmt"
+	"github.com/emirpasic/gods/containers"
 	"github.com/emirpasic/gods/sets/treeset"
 )
 
-func printSet(txt string, set *treeset.Set) {
+func printSet(txt string, set containers.EnumerableWithIndex) {
 	fmt.Print(txt, "[ ")
 	set.Each(func(index int, value interface{}) {
 		fmt.Print(value, " ")
@@ -6,10 +6,11 @@ package main
 
 import (
 	"fmt"
+	"github.com/emirpasic/gods/containers"
 	"github.com/emirpasic/gods/maps/treemap"
 )
 
-func printMap(txt string, m *treemap.Map) {
+func printMap(txt string, m containers.EnumerableWithKey) {
 	fmt.Print(txt, " { ")
 	m.Each(func(key interface{}, value interface{}) {
 		fmt.Print(key, ":", value, " ")
@@ -0,0 +1,26 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import "github.com/emirpasic/gods/multimaps/hashmultimap"
+
+// HashMultimapExample to demonstrate basic usage of HashMultimap
+func main() {
+	m := hashmultimap.New()     // empty
+	m.Put(1, "x")               // 1->[x]
+	m.Put(2, "b")               // 1->[x], 2->[b] (random order)
+	m.PutAll(1, "a", "x")       // 1->[x a x], 2->[b] (random order)
+	_, _ = m.Get(1)             // []interface {}{"x", "a", "x"}, true
+	_, _ = m.Get(3)             // nil, false
+	_ = m.ContainsEntry(1, "a") // true
+	_ = m.KeySet()              // []interface {}{1, 2} (random order)
+	_ = m.Values()              // []interface {}{"x", "a", "x", "b"} (random key order)
+	m.Size()                    // 2 (keys)
+	m.EntryCount()              // 4 (key-value pairs)
+	m.Remove(1, "x")            // 1->[a x], 2->[b] (random order)
+	m.RemoveAll(1)              // 2->[b]
+	m.Clear()                   // empty
+	m.Empty()                   // true
+}
@@ -0,0 +1,34 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import "github.com/emirpasic/gods/multisets/hashmultiset"
+
+// HashMultisetExample to demonstrate basic usage of HashMultiset
+func main() {
+	m := hashmultiset.New()  // empty
+	m.Add("a", 1)            // a:1
+	m.Add("b", 3)            // a:1, b:3 (random order)
+	m.Add("a", 1)            // a:2, b:3 (random order)
+	_ = m.Count("b")         // 3
+	_ = m.Count("x")         // 0
+	_ = m.Contains("a", "b") // true
+	_ = m.ElementSet()       // []interface {}{"a", "b"} (random order)
+	_ = m.EntrySet()         // []multisets.Entry{{"b", 3}, {"a", 2}} (by decreasing count)
+	_ = m.MostCommon(1)      // []multisets.Entry{{"b", 3}}
+	_ = m.Values()           // []interface {}{"a", "a", "b", "b", "b"} (random order)
+	m.Size()                 // 5 (occurrences)
+	m.Remove("b", 2)         // a:2, b:1 (random order)
+	m.SetCount("a", 0)       // b:1
+
+	another := hashmultiset.New("b", "b", "c") // b:2, c:1 (random order)
+	_ = m.Sum(another)                         // b:3, c:1 (random order)
+	_ = m.Union(another)                       // b:2, c:1 (random order)
+	_ = m.Intersection(another)                // b:1
+	_ = m.Difference(another)                  // empty
+
+	m.Clear() // empty
+	m.Empty() // true
+}
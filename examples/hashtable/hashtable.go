@@ -0,0 +1,32 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import "github.com/emirpasic/gods/tables/hashtable"
+
+// HashTableExample to demonstrate basic usage of HashTable
+func main() {
+	table := hashtable.New()   // empty
+	table.Put("x", 1, "a")     // x->1->a
+	table.Put("x", 2, "b")     // x->1->a, x->2->b (random order)
+	table.Put("y", 1, "c")     // x->1->a, x->2->b, y->1->c (random order)
+	_, _ = table.Get("x", 2)   // b, true
+	_, _ = table.Get("y", 2)   // nil, false
+	_ = table.Contains("y", 1) // true
+	_ = table.Row("x")         // 1->a, 2->b (random order)
+	_ = table.Column(1)        // x->a, y->c (random order)
+	_ = table.RowKeys()        // []interface {}{"x", "y"} (random order)
+	_ = table.ColumnKeys()     // []interface {}{1, 2} (random order)
+	_ = table.Values()         // []interface {}{"a", "b", "c"} (random order)
+	_ = table.Cells()          // []tables.Cell{{"x", 1, "a"}, {"x", 2, "b"}, {"y", 1, "c"}} (random order)
+	_ = table.Transpose()      // 1->x->a, 2->x->b, 1->y->c (random order)
+	table.Size()               // 3 (cells)
+	table.Remove("x", 1)       // x->2->b, y->1->c (random order)
+	table.RemoveColumn(2)      // y->1->c
+	table.RemoveRow("y")       // empty
+	table.Put("z", 3, "d")     // z->3->d
+	table.Clear()              // empty
+	table.Empty()              // true
+}
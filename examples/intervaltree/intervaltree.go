@@ -0,0 +1,33 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+	"github.com/emirpasic/gods/trees/intervaltree"
+)
+
+// IntervalTreeExample to demonstrate basic usage of IntervalTree
+func main() {
+	tree := intervaltree.NewWithIntComparator() // empty (endpoints are of type int)
+
+	tree.Put(15, 20, "a") // [15, 20]->a
+	tree.Put(10, 30, "b") // [10, 30]->b, [15, 20]->a (in order)
+	tree.Put(5, 20, "c")  // [5, 20]->c, [10, 30]->b, [15, 20]->a (in order)
+	tree.Put(30, 40, "d") // [5, 20]->c, [10, 30]->b, [15, 20]->a, [30, 40]->d (in order)
+
+	fmt.Println(tree)
+	// IntervalTree
+	// [5, 20], [10, 30], [15, 20], [30, 40]
+
+	_ = tree.Stabbing(25)        // [{[10, 30] b}] (in any order)
+	_ = tree.Overlapping(21, 30) // [{[10, 30] b} {[30, 40] d}] (in any order)
+	_ = tree.Containing(16, 18)  // [{[5, 20] c} {[10, 30] b} {[15, 20] a}] (in any order)
+
+	tree.Remove(10, 30) // [5, 20]->c, [15, 20]->a, [30, 40]->d (in order)
+	tree.Clear()        // empty
+	tree.Empty()        // true
+	tree.Size()         // 0
+}
@@ -0,0 +1,32 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+	"github.com/emirpasic/gods/caches/lfu"
+)
+
+// LFUCacheExample to demonstrate basic usage of LFUCache
+func main() {
+	c := lfu.NewWith(2, func(key interface{}, value interface{}) {
+		fmt.Println("evicted", key) // b
+	})
+	c.Put("a", 1)      // a->1
+	c.Put("b", 2)      // a->1, b->2 (from least to most frequently used)
+	_, _ = c.Get("a")  // 1, true (b->2, a->1)
+	_, _ = c.Get("a")  // 1, true (b->2, a->1)
+	_, _ = c.Peek("b") // 2, true (does not count as use)
+	c.Frequency("a")   // 3
+	c.Put("c", 3)      // c->3, a->1 (b is evicted)
+	_, _ = c.Get("b")  // nil, false
+	_ = c.Keys()       // []interface {}{"c", "a"}
+	_ = c.Stats()      // {Hits:2 Misses:1 Evictions:1}
+	c.Remove("a")      // c->3
+	c.Clear()          // empty
+	c.Empty()          // true
+	c.Size()           // 0
+	c.Capacity()       // 2
+}
@@ -0,0 +1,26 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import "github.com/emirpasic/gods/multimaps/linkedhashmultimap"
+
+// LinkedHashMultimapExample to demonstrate basic usage of LinkedHashMultimap
+func main() {
+	m := linkedhashmultimap.New() // empty
+	m.Put(2, "b")                 // 2->[b]
+	m.Put(1, "x")                 // 2->[b], 1->[x] (insertion-order)
+	m.PutAll(1, "a", "x")         // 2->[b], 1->[x a x] (insertion-order)
+	_, _ = m.Get(1)               // []interface {}{"x", "a", "x"}, true
+	_, _ = m.Get(3)               // nil, false
+	_ = m.ContainsEntry(1, "a")   // true
+	_ = m.KeySet()                // []interface {}{2, 1} (insertion-order)
+	_ = m.Values()                // []interface {}{"b", "x", "a", "x"} (insertion-order)
+	m.Size()                      // 2 (keys)
+	m.EntryCount()                // 4 (key-value pairs)
+	m.Remove(1, "x")              // 2->[b], 1->[a x] (insertion-order)
+	m.RemoveAll(2)                // 1->[a x]
+	m.Clear()                     // empty
+	m.Empty()                     // true
+}
@@ -0,0 +1,34 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import "github.com/emirpasic/gods/multisets/linkedhashmultiset"
+
+// LinkedHashMultisetExample to demonstrate basic usage of LinkedHashMultiset
+func main() {
+	m := linkedhashmultiset.New() // empty
+	m.Add("b", 1)                 // b:1
+	m.Add("a", 3)                 // b:1, a:3 (insertion-order)
+	m.Add("b", 1)                 // b:2, a:3 (insertion-order)
+	_ = m.Count("a")              // 3
+	_ = m.Count("x")              // 0
+	_ = m.Contains("a", "b")      // true
+	_ = m.ElementSet()            // []interface {}{"b", "a"} (insertion-order)
+	_ = m.EntrySet()              // []multisets.Entry{{"a", 3}, {"b", 2}} (by decreasing count)
+	_ = m.MostCommon(1)           // []multisets.Entry{{"a", 3}}
+	_ = m.Values()                // []interface {}{"b", "b", "a", "a", "a"} (insertion-order)
+	m.Size()                      // 5 (occurrences)
+	m.Remove("a", 2)              // b:2, a:1 (insertion-order)
+	m.SetCount("b", 0)            // a:1
+
+	another := linkedhashmultiset.New("c", "a", "a") // c:1, a:2 (insertion-order)
+	_ = m.Sum(another)                               // a:3, c:1 (insertion-order)
+	_ = m.Union(another)                             // a:2, c:1 (insertion-order)
+	_ = m.Intersection(another)                      // a:1
+	_ = m.Difference(another)                        // empty
+
+	m.Clear() // empty
+	m.Empty() // true
+}
@@ -0,0 +1,42 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+	"github.com/emirpasic/gods/maps/radixtree"
+)
+
+// RadixTreeExample to demonstrate basic usage of RadixTree
+func main() {
+	m := radixtree.New()                   // empty (keys are of type string)
+	m.Put("/api", "api")                   // /api->api
+	m.Put("/api/users", "users")           // /api->api, /api/users->users (in order)
+	m.Put("/static", "static")             // /api->api, /api/users->users, /static->static (in order)
+	_, _ = m.Get("/api")                   // api, true
+	_, _ = m.Get("/ap")                    // nil, false
+	_ = m.KeysWithPrefix("/api")           // []interface {}{"/api", "/api/users"}
+	_, _ = m.LongestPrefixMatch("/api/v2") // /api, api
+
+	// Route requests to the most specific handler
+	for _, path := range []string{"/api/users/1", "/api/orders", "/static/logo.png", "/index.html"} {
+		if route, handler := m.LongestPrefixMatch(path); route != nil {
+			fmt.Println(path, "is handled by", handler) // users, api, static
+		} else {
+			fmt.Println(path, "has no handler")
+		}
+	}
+
+	// Autocomplete
+	m.Walk("/a", func(key interface{}, value interface{}) bool {
+		fmt.Println("Suggestion:", key) // /api, /api/users
+		return true
+	})
+
+	m.Remove("/api") // /api/users->users, /static->static
+	m.Clear()        // empty
+	m.Empty()        // true
+	m.Size()         // 0
+}
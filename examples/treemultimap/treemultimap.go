@@ -0,0 +1,26 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import "github.com/emirpasic/gods/multimaps/treemultimap"
+
+// TreeMultimapExample to demonstrate basic usage of TreeMultimap
+func main() {
+	m := treemultimap.NewWithIntComparator() // empty (keys are of type int)
+	m.Put(2, "b")                            // 2->[b]
+	m.Put(1, "x")                            // 1->[x], 2->[b] (in order)
+	m.PutAll(1, "a", "x")                    // 1->[x a x], 2->[b] (in order)
+	_, _ = m.Get(1)                          // []interface {}{"x", "a", "x"}, true
+	_, _ = m.Get(3)                          // nil, false
+	_ = m.ContainsEntry(1, "a")              // true
+	_ = m.KeySet()                           // []interface {}{1, 2} (in order)
+	_ = m.Values()                           // []interface {}{"x", "a", "x", "b"} (in order)
+	m.Size()                                 // 2 (keys)
+	m.EntryCount()                           // 4 (key-value pairs)
+	m.Remove(1, "x")                         // 1->[a x], 2->[b] (in order)
+	m.RemoveAll(1)                           // 2->[b]
+	m.Clear()                                // empty
+	m.Empty()                                // true
+}
@@ -0,0 +1,34 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import "github.com/emirpasic/gods/multisets/treemultiset"
+
+// TreeMultisetExample to demonstrate basic usage of TreeMultiset
+func main() {
+	m := treemultiset.NewWithStringComparator() // empty (elements are of type string)
+	m.Add("b", 3)                               // b:3
+	m.Add("a", 1)                               // a:1, b:3 (in order)
+	m.Add("a", 1)                               // a:2, b:3 (in order)
+	_ = m.Count("b")                            // 3
+	_ = m.Count("x")                            // 0
+	_ = m.Contains("a", "b")                    // true
+	_ = m.ElementSet()                          // []interface {}{"a", "b"} (in order)
+	_ = m.EntrySet()                            // []multisets.Entry{{"a", 2}, {"b", 3}} (in order)
+	_ = m.MostCommon(1)                         // []multisets.Entry{{"b", 3}}
+	_ = m.Values()                              // []interface {}{"a", "a", "b", "b", "b"} (in order)
+	m.Size()                                    // 5 (occurrences)
+	m.Remove("b", 2)                            // a:2, b:1 (in order)
+	m.SetCount("a", 0)                          // b:1
+
+	another := treemultiset.NewWithStringComparator("b", "b", "c") // b:2, c:1 (in order)
+	_ = m.Sum(another)                                             // b:3, c:1 (in order)
+	_ = m.Union(another)                                           // b:2, c:1 (in order)
+	_ = m.Intersection(another)                                    // b:1
+	_ = m.Difference(another)                                      // empty
+
+	m.Clear() // empty
+	m.Empty() // true
+}
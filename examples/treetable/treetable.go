@@ -0,0 +1,36 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"github.com/emirpasic/gods/tables/treetable"
+	"github.com/emirpasic/gods/utils"
+)
+
+// TreeTableExample to demonstrate basic usage of TreeTable
+func main() {
+	table := treetable.NewWith(utils.StringComparator, utils.IntComparator) // empty (rows are of type string, columns of type int)
+
+	table.Put("y", 1, "c")     // y->1->c
+	table.Put("x", 2, "b")     // x->2->b, y->1->c (in order)
+	table.Put("x", 1, "a")     // x->1->a, x->2->b, y->1->c (in order)
+	_, _ = table.Get("x", 2)   // b, true
+	_, _ = table.Get("y", 2)   // nil, false
+	_ = table.Contains("y", 1) // true
+	_ = table.Row("x")         // 1->a, 2->b (in order)
+	_ = table.Column(1)        // x->a, y->c (in order)
+	_ = table.RowKeys()        // []interface {}{"x", "y"} (in order)
+	_ = table.ColumnKeys()     // []interface {}{1, 2} (in order)
+	_ = table.Values()         // []interface {}{"a", "b", "c"} (in order)
+	_ = table.Cells()          // []tables.Cell{{"x", 1, "a"}, {"x", 2, "b"}, {"y", 1, "c"}} (in order)
+	_ = table.Transpose()      // 1->x->a, 1->y->c, 2->x->b (in order)
+	table.Size()               // 3 (cells)
+	table.Remove("x", 1)       // x->2->b, y->1->c (in order)
+	table.RemoveColumn(2)      // y->1->c
+	table.RemoveRow("y")       // empty
+	table.Put("z", 3, "d")     // z->3->d
+	table.Clear()              // empty
+	table.Empty()              // true
+}
@@ -0,0 +1,33 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+	"github.com/emirpasic/gods/maps/ttlmap"
+	"time"
+)
+
+// TTLMapExample to demonstrate basic usage of TTLMap
+func main() {
+	clock := ttlmap.NewManualClock(time.Now()) // time only moves when advanced
+	m := ttlmap.NewWith(time.Minute, clock, func(key interface{}, value interface{}) {
+		fmt.Println("expired", key) // a, b
+	})
+	m.Put("a", 1)                    // a->1 (expires in a minute)
+	m.PutWithTTL("b", 2, time.Hour)  // a->1, b->2 (expires in an hour)
+	m.PutWithTTL("c", 3, 0)          // a->1, b->2, c->3 (never expires)
+	clock.Advance(time.Minute)       // a expires
+	_ = m.Keys()                     // []interface {}{"b", "c"} (insertion-order, expired keys are skipped)
+	_, _ = m.Get("a")                // nil, false (a is removed)
+	_, _ = m.Get("b")                // 2, true
+	_, _ = m.Expiration("c")         // 0001-01-01 00:00:00 +0000 UTC, true (zero time, never expires)
+	clock.Advance(time.Hour)         // b expires
+	_ = m.Sweep()                    // 1 (b is removed)
+	m.Remove("c")                    // empty
+	m.Empty()                        // true
+	m.Size()                         // 0
+	_ = ttlmap.New(10 * time.Second) // empty (reads the system time)
+}
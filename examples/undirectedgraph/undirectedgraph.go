@@ -0,0 +1,44 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"github.com/emirpasic/gods/graphs"
+	"github.com/emirpasic/gods/graphs/undirectedgraph"
+)
+
+// UndirectedGraphExample to demonstrate basic usage of UndirectedGraph
+func main() {
+	graph := undirectedgraph.NewWeighted() // empty
+	graph.AddWeightedEdge("a", "b", 3)     // a-b
+	graph.AddWeightedEdge("b", "c", 1)     // a-b, b-c
+	graph.AddWeightedEdge("a", "c", 2)     // a-b, b-c, a-c
+	graph.AddWeightedEdge("c", "d", 4)     // a-b, b-c, a-c, c-d
+	graph.AddEdge("x", "y")                // a-b, b-c, a-c, c-d, x-y
+	_ = graph.Neighbors("c")               // []interface {}{"b", "a", "d"} (in insertion order)
+	_ = graph.ContainsEdge("b", "a")       // true
+	_, _ = graph.Weight("c", "a")          // 2, true
+	_ = graph.Degree("c")                  // 3
+	_ = graph.EdgeCount()                  // 5
+	_ = graph.Size()                       // 6 (vertices)
+
+	it := graphs.BreadthFirstIterator(graph, "a")
+	for it.Next() {
+		_, _ = it.Index(), it.Value() // 0:a, 1:b, 2:c, 3:d
+	}
+	it = graphs.DepthFirstIterator(graph, "d")
+	for it.Next() {
+		_, _ = it.Index(), it.Value() // 0:d, 1:c, 2:b, 3:a
+	}
+
+	_ = graphs.ConnectedComponents(graph) // [][]interface {}{{"a", "b", "c", "d"}, {"x", "y"}}
+	_ = graphs.MinimumSpanningTree(graph) // []graphs.Edge{{"b", "c", 1}, {"x", "y", 1}, {"a", "c", 2}, {"c", "d", 4}}
+	_ = graphs.FindCycle(graph)           // []interface {}{"a", "b", "c"}
+	_ = graphs.ToDOT(graph)               // graph {...}
+	graph.RemoveEdge("c", "b")            // a-b, a-c, c-d, x-y
+	graph.RemoveVertex("x")               // a-b, a-c, c-d, y
+	graph.Clear()                         // empty
+	graph.Empty()                         // true
+}
@@ -0,0 +1,36 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package containers provides type-parameterized counterparts of the core container interfaces.
+//
+// Container is the base interface for all generic data structures to implement.
+//
+// Iterators provide stateful iterators.
+//
+// Enumerable provides Ruby inspired (each, select, map, find, any?, etc.) container functions.
+//
+// Serialization is shared with the non-generic containers package (see containers.JSONSerializer and containers.JSONDeserializer).
+package containers
+
+import "github.com/emirpasic/gods/generics/utils"
+
+// Container is base interface that all generic data structures implement.
+type Container[T any] interface {
+	Empty() bool
+	Size() int
+	Clear()
+	Values() []T
+	String() string
+}
+
+// GetSortedValues returns sorted container's elements with respect to the passed comparator.
+// Does not affect the ordering of elements within the container.
+func GetSortedValues[T any](container Container[T], comparator utils.Comparator[T]) []T {
+	values := container.Values()
+	if len(values) < 2 {
+		return values
+	}
+	utils.Sort(values, comparator)
+	return values
+}
@@ -0,0 +1,77 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package containers
+
+// EnumerableWithIndex provides functions for ordered containers whose values can be fetched by an index.
+type EnumerableWithIndex[T any] interface {
+	// Each calls the given function once for each element, passing that element's index and value.
+	Each(func(index int, value T))
+
+	// Map invokes the given function once for each element and returns a
+	// container containing the values returned by the given function.
+	// The returned container is of the same kind as the receiver and can be enumerated further.
+	// Methods cannot have type parameters, so the mapped values are of the same type as the container's values.
+	Map(func(index int, value T) T) EnumerableContainerWithIndex[T]
+
+	// Select returns a new container containing all elements for which the given function returns a true value.
+	// The returned container is of the same kind as the receiver and can be enumerated further.
+	Select(func(index int, value T) bool) EnumerableContainerWithIndex[T]
+
+	// Any passes each element of the container to the given function and
+	// returns true if the function ever returns true for any element.
+	Any(func(index int, value T) bool) bool
+
+	// All passes each element of the container to the given function and
+	// returns true if the function returns true for all elements.
+	All(func(index int, value T) bool) bool
+
+	// Find passes each element of the container to the given function and returns
+	// the first (index,value) for which the function is true or -1,zero value otherwise
+	// if no element matches the criteria.
+	Find(func(index int, value T) bool) (int, T)
+}
+
+// EnumerableWithKey provides functions for ordered containers whose values whose elements are key/value pairs.
+type EnumerableWithKey[K, V any] interface {
+	// Each calls the given function once for each element, passing that element's key and value.
+	Each(func(key K, value V))
+
+	// Map invokes the given function once for each element and returns a container
+	// containing the values returned by the given function as key/value pairs.
+	// The returned container is of the same kind as the receiver and can be enumerated further.
+	// Methods cannot have type parameters, so the mapped pairs are of the same types as the container's pairs.
+	Map(func(key K, value V) (K, V)) EnumerableContainerWithKey[K, V]
+
+	// Select returns a new container containing all elements for which the given function returns a true value.
+	// The returned container is of the same kind as the receiver and can be enumerated further.
+	Select(func(key K, value V) bool) EnumerableContainerWithKey[K, V]
+
+	// Any passes each element of the container to the given function and
+	// returns true if the function ever returns true for any element.
+	Any(func(key K, value V) bool) bool
+
+	// All passes each element of the container to the given function and
+	// returns true if the function returns true for all elements.
+	All(func(key K, value V) bool) bool
+
+	// Find passes each element of the container to the given function and returns
+	// the first (key,value) for which the function is true or zero values otherwise if no element
+	// matches the criteria.
+	Find(func(key K, value V) bool) (K, V)
+}
+
+// EnumerableContainerWithIndex is a container whose values can be enumerated by an index.
+// Map and Select return it, so that calls can be chained without knowing the concrete container.
+type EnumerableContainerWithIndex[T any] interface {
+	Container[T]
+	EnumerableWithIndex[T]
+}
+
+// EnumerableContainerWithKey is a container whose key/value pairs can be enumerated.
+// Map and Select return it, so that calls can be chained without knowing the concrete container.
+type EnumerableContainerWithKey[K, V any] interface {
+	Container[V]
+	EnumerableWithKey[K, V]
+}
@@ -0,0 +1,32 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package lists provides an abstract type-parameterized List interface.
+//
+// Reference: https://en.wikipedia.org/wiki/List_%28abstract_data_type%29
+package lists
+
+import (
+	"github.com/emirpasic/gods/generics/containers"
+	"github.com/emirpasic/gods/generics/utils"
+)
+
+// List interface that all generic lists implement
+type List[T any] interface {
+	Get(index int) (T, bool)
+	Remove(index int)
+	Add(values ...T)
+	Contains(values ...T) bool
+	Sort(comparator utils.Comparator[T])
+	Swap(index1, index2 int)
+	Insert(index int, values ...T)
+	Set(index int, value T)
+
+	containers.Container[T]
+	// Empty() bool
+	// Size() int
+	// Clear()
+	// Values() []T
+	// String() string
+}
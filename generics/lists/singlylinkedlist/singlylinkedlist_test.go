@@ -0,0 +1,202 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package singlylinkedlist
+
+import (
+	"encoding/json"
+	"github.com/emirpasic/gods/generics/containers"
+	"github.com/emirpasic/gods/generics/utils"
+	"strings"
+	"testing"
+)
+
+func TestListNew(t *testing.T) {
+	list1 := New[int]()
+	if actualValue := list1.Empty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	list2 := New(1, 2)
+	if actualValue := list2.Size(); actualValue != 2 {
+		t.Errorf("Got %v expected %v", actualValue, 2)
+	}
+	if actualValue, ok := list2.Get(0); actualValue != 1 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 1)
+	}
+	if actualValue, ok := list2.Get(2); actualValue != 0 || ok {
+		t.Errorf("Got %v expected %v", actualValue, 0)
+	}
+}
+
+func TestListAddRemove(t *testing.T) {
+	list := New[string]()
+	list.Add("a")
+	list.Add("b", "c")
+	if actualValue := list.Size(); actualValue != 3 {
+		t.Errorf("Got %v expected %v", actualValue, 3)
+	}
+	list.Remove(2)
+	if actualValue, ok := list.Get(2); actualValue != "" || ok {
+		t.Errorf("Got %v expected %v", actualValue, "")
+	}
+	list.Remove(1)
+	list.Remove(0)
+	list.Remove(0) // no effect
+	if actualValue := list.Empty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+}
+
+func TestListPrependAppend(t *testing.T) {
+	list := New("c")
+	list.Prepend("a", "b")
+	list.Append("d")
+	if actualValue, expectedValue := strings.Join(list.Values(), ""), "abcd"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	list.Remove(3)
+	list.Add("e")
+	if actualValue, expectedValue := strings.Join(list.Values(), ""), "abce"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestListIndexOfContains(t *testing.T) {
+	list := New("a", "b", "c")
+	if actualValue, expectedValue := list.IndexOf("c"), 2; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := list.IndexOf("x"), -1; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue := list.Contains("a", "b", "c"); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := list.Contains("a", "d"); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+}
+
+func TestListSortSwapInsertSet(t *testing.T) {
+	list := New("e", "f", "g", "a", "b", "c", "d")
+	list.Sort(utils.OrderedComparator[string])
+	if actualValue, expectedValue := strings.Join(list.Values(), ""), "abcdefg"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	list.Swap(0, 1)
+	list.Insert(0, "x")
+	list.Insert(100, "y") // ignore
+	list.Set(1, "z")
+	list.Set(list.Size(), "h") // append
+	if actualValue, expectedValue := strings.Join(list.Values(), ""), "xzacdefgh"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestListEnumerable(t *testing.T) {
+	list := New(1, 2, 3, 4)
+	sum := 0
+	list.Each(func(index int, value int) {
+		sum += value
+	})
+	if sum != 10 {
+		t.Errorf("Got %v expected %v", sum, 10)
+	}
+	mapped := list.Map(func(index int, value int) int {
+		return value * value
+	}).(*List[int])
+	if actualValue, _ := mapped.Get(3); actualValue != 16 {
+		t.Errorf("Got %v expected %v", actualValue, 16)
+	}
+	selected := list.Select(func(index int, value int) bool {
+		return value%2 == 0
+	}).(*List[int])
+	if actualValue := selected.Size(); actualValue != 2 {
+		t.Errorf("Got %v expected %v", actualValue, 2)
+	}
+	if actualValue := list.Any(func(index int, value int) bool { return value > 3 }); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := list.All(func(index int, value int) bool { return value > 1 }); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	if index, value := list.Find(func(index int, value int) bool { return value == 3 }); index != 2 || value != 3 {
+		t.Errorf("Got %v,%v expected %v,%v", index, value, 2, 3)
+	}
+	if index, value := list.Find(func(index int, value int) bool { return value == 5 }); index != -1 || value != 0 {
+		t.Errorf("Got %v,%v expected %v,%v", index, value, -1, 0)
+	}
+}
+
+func TestListEnumerableInterface(t *testing.T) {
+	var enumerable containers.EnumerableContainerWithIndex[string] = New("a", "b", "c")
+	result := enumerable.Select(func(index int, value string) bool {
+		return value > "a"
+	}).Map(func(index int, value string) string {
+		return value + value
+	})
+	if _, ok := result.(*List[string]); !ok {
+		t.Errorf("Got %T expected %T", result, &List[string]{})
+	}
+	if actualValue, expectedValue := strings.Join(result.Values(), ""), "bbcc"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestListIterator(t *testing.T) {
+	list := New("a", "b", "c")
+	it := list.Iterator()
+	count := 0
+	for it.Next() {
+		count++
+		index := it.Index()
+		if expectedValue := []string{"a", "b", "c"}[index]; it.Value() != expectedValue {
+			t.Errorf("Got %v expected %v", it.Value(), expectedValue)
+		}
+	}
+	if count != 3 {
+		t.Errorf("Got %v expected %v", count, 3)
+	}
+	if !it.First() || it.Value() != "a" {
+		t.Errorf("Got %v expected %v", it.Value(), "a")
+	}
+	if !it.NextTo(func(index int, value string) bool { return value == "c" }) || it.Index() != 2 {
+		t.Errorf("Got %v expected %v", it.Index(), 2)
+	}
+}
+
+func TestListSerialization(t *testing.T) {
+	list := New("a", "b", "c")
+	bytes, err := list.ToJSON()
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := string(bytes), `["a","b","c"]`; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	list.Clear()
+	if err := list.FromJSON(bytes); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := strings.Join(list.Values(), ""), "abc"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	other := New[int]()
+	if err := json.Unmarshal([]byte(`[1,2,3]`), other); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if err := json.Unmarshal([]byte(`["a"]`), other); err == nil {
+		t.Errorf("Expected type error while decoding strings into a list of ints")
+	}
+	if actualValue := other.Size(); actualValue != 3 {
+		t.Errorf("Got %v expected %v", actualValue, 3)
+	}
+}
+
+func TestListString(t *testing.T) {
+	c := New(1)
+	if !strings.HasPrefix(c.String(), "SinglyLinkedList") {
+		t.Errorf("String should start with container name")
+	}
+}
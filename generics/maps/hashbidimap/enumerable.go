@@ -0,0 +1,74 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package hashbidimap
+
+import "github.com/emirpasic/gods/generics/containers"
+
+// Assert Enumerable implementation
+var _ containers.EnumerableWithKey[string, int] = (*Map[string, int])(nil)
+
+// Each calls the given function once for each element, passing that element's key and value.
+// Elements are visited in random order.
+func (m *Map[K, V]) Each(f func(key K, value V)) {
+	for key, value := range m.forwardMap {
+		f(key, value)
+	}
+}
+
+// Map invokes the given function once for each element and returns a container
+// containing the values returned by the given function as key/value pairs.
+// As in Put, a mapped pair replaces any earlier pair sharing its key or its value.
+func (m *Map[K, V]) Map(f func(key1 K, value1 V) (K, V)) containers.EnumerableContainerWithKey[K, V] {
+	newMap := New[K, V]()
+	for key, value := range m.forwardMap {
+		newMap.Put(f(key, value))
+	}
+	return newMap
+}
+
+// Select returns a new container containing all elements for which the given function returns a true value.
+func (m *Map[K, V]) Select(f func(key K, value V) bool) containers.EnumerableContainerWithKey[K, V] {
+	newMap := New[K, V]()
+	for key, value := range m.forwardMap {
+		if f(key, value) {
+			newMap.Put(key, value)
+		}
+	}
+	return newMap
+}
+
+// Any passes each element of the container to the given function and
+// returns true if the function ever returns true for any element.
+func (m *Map[K, V]) Any(f func(key K, value V) bool) bool {
+	for key, value := range m.forwardMap {
+		if f(key, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// All passes each element of the container to the given function and
+// returns true if the function returns true for all elements.
+func (m *Map[K, V]) All(f func(key K, value V) bool) bool {
+	for key, value := range m.forwardMap {
+		if !f(key, value) {
+			return false
+		}
+	}
+	return true
+}
+
+// Find passes each element of the container to the given function and returns
+// the first (key,value) for which the function is true or zero values otherwise if no element
+// matches the criteria.
+func (m *Map[K, V]) Find(f func(key K, value V) bool) (key K, value V) {
+	for k, v := range m.forwardMap {
+		if f(k, v) {
+			return k, v
+		}
+	}
+	return key, value
+}
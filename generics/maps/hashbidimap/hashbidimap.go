@@ -0,0 +1,110 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package hashbidimap implements a type-parameterized bidirectional map backed by two hashmaps.
+//
+// A bidirectional map, or hash bag, is an associative data structure in which the (key,value) pairs form a one-to-one correspondence.
+// Thus the binary relation is functional in each direction: value can also act as a key to key.
+// A pair (a,b) thus provides a unique coupling between 'a' and 'b' so that 'b' can be found when 'a' is used as a key and 'a' can be found when 'b' is used as a key.
+//
+// Elements are unordered in the map.
+//
+// Structure is not thread safe.
+//
+// Reference: https://en.wikipedia.org/wiki/Bidirectional_map
+package hashbidimap
+
+import (
+	"fmt"
+	"github.com/emirpasic/gods/generics/maps"
+)
+
+// Assert Map implementation
+var _ maps.BidiMap[string, int] = (*Map[string, int])(nil)
+
+// Map holds the elements in two of go's native maps, one for each direction.
+type Map[K comparable, V comparable] struct {
+	forwardMap map[K]V
+	inverseMap map[V]K
+}
+
+// New instantiates a bidirectional map.
+func New[K comparable, V comparable]() *Map[K, V] {
+	return &Map[K, V]{forwardMap: make(map[K]V), inverseMap: make(map[V]K)}
+}
+
+// Put inserts element into the map.
+func (m *Map[K, V]) Put(key K, value V) {
+	if valueByKey, ok := m.forwardMap[key]; ok {
+		delete(m.inverseMap, valueByKey)
+	}
+	if keyByValue, ok := m.inverseMap[value]; ok {
+		delete(m.forwardMap, keyByValue)
+	}
+	m.forwardMap[key] = value
+	m.inverseMap[value] = key
+}
+
+// Get searches the element in the map by key and returns its value or the zero value if key is not found in map.
+// Second return parameter is true if key was found, otherwise false.
+func (m *Map[K, V]) Get(key K) (value V, found bool) {
+	value, found = m.forwardMap[key]
+	return
+}
+
+// GetKey searches the element in the map by value and returns its key or the zero value if value is not found in map.
+// Second return parameter is true if value was found, otherwise false.
+func (m *Map[K, V]) GetKey(value V) (key K, found bool) {
+	key, found = m.inverseMap[value]
+	return
+}
+
+// Remove removes the element from the map by key.
+func (m *Map[K, V]) Remove(key K) {
+	if value, found := m.forwardMap[key]; found {
+		delete(m.forwardMap, key)
+		delete(m.inverseMap, value)
+	}
+}
+
+// Empty returns true if map does not contain any elements
+func (m *Map[K, V]) Empty() bool {
+	return m.Size() == 0
+}
+
+// Size returns number of elements in the map.
+func (m *Map[K, V]) Size() int {
+	return len(m.forwardMap)
+}
+
+// Keys returns all keys (random order).
+func (m *Map[K, V]) Keys() []K {
+	keys := make([]K, 0, m.Size())
+	for key := range m.forwardMap {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// Values returns all values (random order).
+func (m *Map[K, V]) Values() []V {
+	values := make([]V, 0, m.Size())
+	for value := range m.inverseMap {
+		values = append(values, value)
+	}
+	return values
+}
+
+// Clear removes all elements from the map.
+func (m *Map[K, V]) Clear() {
+	m.forwardMap = make(map[K]V)
+	m.inverseMap = make(map[V]K)
+}
+
+// String returns a string representation of container
+func (m *Map[K, V]) String() string {
+	str := "HashBidiMap\n"
+	str += fmt.Sprintf("%v", m.forwardMap)
+	return str
+}
@@ -0,0 +1,97 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package hashbidimap
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+	"testing"
+)
+
+func TestMapPutGetRemove(t *testing.T) {
+	m := New[int, string]()
+	m.Put(5, "e")
+	m.Put(6, "f")
+	m.Put(7, "g")
+	m.Put(1, "x")
+	m.Put(1, "a") //overwrite
+	m.Put(8, "g") // replaces 7->g
+	if actualValue := m.Size(); actualValue != 4 {
+		t.Errorf("Got %v expected %v", actualValue, 4)
+	}
+	if actualValue, found := m.Get(7); actualValue != "" || found {
+		t.Errorf("Got %v expected %v", actualValue, "")
+	}
+	if actualValue, found := m.GetKey("g"); actualValue != 8 || !found {
+		t.Errorf("Got %v expected %v", actualValue, 8)
+	}
+	if actualValue, found := m.GetKey("x"); actualValue != 0 || found {
+		t.Errorf("Got %v expected %v", actualValue, 0)
+	}
+	values := m.Values()
+	sort.Strings(values)
+	if actualValue, expectedValue := strings.Join(values, ""), "aefg"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	m.Remove(5)
+	if actualValue, found := m.GetKey("e"); actualValue != 0 || found {
+		t.Errorf("Got %v expected %v", actualValue, 0)
+	}
+	m.Clear()
+	if actualValue := m.Empty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+}
+
+func TestMapEnumerable(t *testing.T) {
+	m := New[string, int]()
+	m.Put("a", 1)
+	m.Put("b", 2)
+	m.Put("c", 3)
+	mapped := m.Map(func(key string, value int) (string, int) {
+		return "x", value // all pairs collapse into the last one mapped
+	}).(*Map[string, int])
+	if actualValue := mapped.Size(); actualValue != 1 {
+		t.Errorf("Got %v expected %v", actualValue, 1)
+	}
+	selected := m.Select(func(key string, value int) bool {
+		return value >= 2
+	}).(*Map[string, int])
+	if actualValue, found := selected.GetKey(3); actualValue != "c" || !found {
+		t.Errorf("Got %v expected %v", actualValue, "c")
+	}
+	if key, value := m.Find(func(key string, value int) bool { return value == 2 }); key != "b" || value != 2 {
+		t.Errorf("Got %v->%v expected %v->%v", key, value, "b", 2)
+	}
+	if actualValue := m.Any(func(key string, value int) bool { return value > 3 }); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+}
+
+func TestMapSerialization(t *testing.T) {
+	m := New[string, float64]()
+	m.Put("a", 1.0)
+	m.Put("b", 2.0)
+	bytes, err := json.Marshal(m)
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	m.Clear()
+	if err := json.Unmarshal(bytes, m); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, found := m.GetKey(2.0); actualValue != "b" || !found {
+		t.Errorf("Got %v expected %v", actualValue, "b")
+	}
+}
+
+func TestMapString(t *testing.T) {
+	c := New[string, int]()
+	c.Put("a", 1)
+	if !strings.HasPrefix(c.String(), "HashBidiMap") {
+		t.Errorf("String should start with container name")
+	}
+}
@@ -0,0 +1,77 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package hashmap
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+	"testing"
+)
+
+func TestMapPutGetRemove(t *testing.T) {
+	m := New[int, string]()
+	m.Put(5, "e")
+	m.Put(6, "f")
+	m.Put(1, "x")
+	m.Put(1, "a") //overwrite
+	if actualValue := m.Size(); actualValue != 3 {
+		t.Errorf("Got %v expected %v", actualValue, 3)
+	}
+	if actualValue, found := m.Get(1); actualValue != "a" || !found {
+		t.Errorf("Got %v expected %v", actualValue, "a")
+	}
+	if actualValue, found := m.Get(2); actualValue != "" || found {
+		t.Errorf("Got %v expected %v", actualValue, "")
+	}
+	keys := m.Keys()
+	sort.Ints(keys)
+	if len(keys) != 3 || keys[0] != 1 || keys[2] != 6 {
+		t.Errorf("Got %v expected %v", keys, []int{1, 5, 6})
+	}
+	values := m.Values()
+	sort.Strings(values)
+	if actualValue, expectedValue := strings.Join(values, ""), "aef"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	m.Remove(5)
+	m.Remove(6)
+	m.Remove(7)
+	m.Remove(1)
+	if actualValue := m.Empty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+}
+
+func TestMapSerialization(t *testing.T) {
+	m := New[string, float64]()
+	m.Put("a", 1.0)
+	m.Put("b", 2.0)
+	bytes, err := m.ToJSON()
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	m.Clear()
+	if err := m.FromJSON(bytes); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, found := m.Get("b"); actualValue != 2.0 || !found {
+		t.Errorf("Got %v expected %v", actualValue, 2.0)
+	}
+	if err := json.Unmarshal([]byte(`{"c":3}`), m); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue := m.Size(); actualValue != 1 {
+		t.Errorf("Got %v expected %v", actualValue, 1)
+	}
+}
+
+func TestMapString(t *testing.T) {
+	c := New[string, int]()
+	c.Put("a", 1)
+	if !strings.HasPrefix(c.String(), "HashMap") {
+		t.Errorf("String should start with container name")
+	}
+}
@@ -0,0 +1,224 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package linkedhashmap implements a type-parameterized map that preserves insertion-order.
+//
+// It is backed by a hash table to store values and doubly-linked list to store ordering.
+// Every entry of the hash table is itself a node of the doubly-linked list, so that entries can be removed and
+// reordered in constant time.
+//
+// Optionally, the map can be ordered by access instead, i.e. from the least recently to the most recently accessed
+// entry, which makes it a suitable basis for recency-ordered structures such as caches.
+//
+// Structure is not thread safe.
+//
+// Reference: http://en.wikipedia.org/wiki/Associative_array
+package linkedhashmap
+
+import (
+	"fmt"
+	"github.com/emirpasic/gods/generics/maps"
+	"strings"
+)
+
+// Assert Map implementation
+var _ maps.Map[string, int] = (*Map[string, int])(nil)
+
+// Map holds the elements in a regular hash table, whose entries are linked in a doubly-linked list to store key ordering.
+type Map[K comparable, V any] struct {
+	table       map[K]*entry[K, V]
+	head        *entry[K, V] // first entry in the ordering
+	tail        *entry[K, V] // last entry in the ordering
+	accessOrder bool
+}
+
+type entry[K comparable, V any] struct {
+	key   K
+	value V
+	prev  *entry[K, V]
+	next  *entry[K, V]
+}
+
+// New instantiates a linked-hash-map ordered by insertion.
+func New[K comparable, V any]() *Map[K, V] {
+	return &Map[K, V]{table: make(map[K]*entry[K, V])}
+}
+
+// NewWithAccessOrder instantiates a linked-hash-map ordered by access, i.e. Get and Put of an existing key move
+// its entry to the back, so that entries are ordered from the least recently to the most recently accessed.
+func NewWithAccessOrder[K comparable, V any]() *Map[K, V] {
+	return &Map[K, V]{table: make(map[K]*entry[K, V]), accessOrder: true}
+}
+
+// Put inserts key-value pair into the map.
+// In insertion order, updating the value of an existing key does not change its position.
+// In access order, the entry is moved to the back.
+func (m *Map[K, V]) Put(key K, value V) {
+	if e, contains := m.table[key]; contains {
+		e.value = value
+		if m.accessOrder {
+			m.moveToBack(e)
+		}
+		return
+	}
+	e := &entry[K, V]{key: key, value: value}
+	m.table[key] = e
+	m.linkLast(e)
+}
+
+// Get searches the element in the map by key and returns its value or the zero value if key is not found in map.
+// Second return parameter is true if key was found, otherwise false.
+// In access order, the entry is moved to the back.
+func (m *Map[K, V]) Get(key K) (value V, found bool) {
+	e, found := m.table[key]
+	if !found {
+		return value, false
+	}
+	if m.accessOrder {
+		m.moveToBack(e)
+	}
+	return e.value, true
+}
+
+// Remove removes the element from the map by key.
+func (m *Map[K, V]) Remove(key K) {
+	if e, contains := m.table[key]; contains {
+		delete(m.table, key)
+		m.unlink(e)
+	}
+}
+
+// MoveToFront moves the element with the given key to the front of the ordering, if the key is found.
+func (m *Map[K, V]) MoveToFront(key K) {
+	if e, contains := m.table[key]; contains && e != m.head {
+		m.unlink(e)
+		m.linkFirst(e)
+	}
+}
+
+// MoveToBack moves the element with the given key to the back of the ordering, if the key is found.
+func (m *Map[K, V]) MoveToBack(key K) {
+	if e, contains := m.table[key]; contains {
+		m.moveToBack(e)
+	}
+}
+
+// PollFirst removes the first key in the ordering and its value from the map and returns them.
+// Third return parameter is true if map was not empty, otherwise false.
+func (m *Map[K, V]) PollFirst() (key K, value V, found bool) {
+	if m.head == nil {
+		return key, value, false
+	}
+	e := m.head
+	delete(m.table, e.key)
+	m.unlink(e)
+	return e.key, e.value, true
+}
+
+// PollLast removes the last key in the ordering and its value from the map and returns them.
+// Third return parameter is true if map was not empty, otherwise false.
+func (m *Map[K, V]) PollLast() (key K, value V, found bool) {
+	if m.tail == nil {
+		return key, value, false
+	}
+	e := m.tail
+	delete(m.table, e.key)
+	m.unlink(e)
+	return e.key, e.value, true
+}
+
+// Empty returns true if map does not contain any elements
+func (m *Map[K, V]) Empty() bool {
+	return m.Size() == 0
+}
+
+// Size returns number of elements in the map.
+func (m *Map[K, V]) Size() int {
+	return len(m.table)
+}
+
+// Keys returns all keys in-order
+func (m *Map[K, V]) Keys() []K {
+	keys := make([]K, m.Size())
+	count := 0
+	it := m.Iterator()
+	for it.Next() {
+		keys[count] = it.Key()
+		count++
+	}
+	return keys
+}
+
+// Values returns all values in-order based on the key.
+func (m *Map[K, V]) Values() []V {
+	values := make([]V, m.Size())
+	count := 0
+	it := m.Iterator()
+	for it.Next() {
+		values[count] = it.Value()
+		count++
+	}
+	return values
+}
+
+// Clear removes all elements from the map.
+func (m *Map[K, V]) Clear() {
+	m.table = make(map[K]*entry[K, V])
+	m.head = nil
+	m.tail = nil
+}
+
+// String returns a string representation of container
+func (m *Map[K, V]) String() string {
+	str := "LinkedHashMap\nmap["
+	it := m.Iterator()
+	for it.Next() {
+		str += fmt.Sprintf("%v:%v ", it.Key(), it.Value())
+	}
+	return strings.TrimRight(str, " ") + "]"
+}
+
+func (m *Map[K, V]) moveToBack(e *entry[K, V]) {
+	if e != m.tail {
+		m.unlink(e)
+		m.linkLast(e)
+	}
+}
+
+func (m *Map[K, V]) linkFirst(e *entry[K, V]) {
+	e.prev = nil
+	e.next = m.head
+	if m.head != nil {
+		m.head.prev = e
+	} else {
+		m.tail = e
+	}
+	m.head = e
+}
+
+func (m *Map[K, V]) linkLast(e *entry[K, V]) {
+	e.prev = m.tail
+	e.next = nil
+	if m.tail != nil {
+		m.tail.next = e
+	} else {
+		m.head = e
+	}
+	m.tail = e
+}
+
+func (m *Map[K, V]) unlink(e *entry[K, V]) {
+	if e.prev != nil {
+		e.prev.next = e.next
+	} else {
+		m.head = e.next
+	}
+	if e.next != nil {
+		e.next.prev = e.prev
+	} else {
+		m.tail = e.prev
+	}
+	e.prev = nil
+	e.next = nil
+}
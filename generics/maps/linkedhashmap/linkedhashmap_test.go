@@ -0,0 +1,131 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package linkedhashmap
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestMapPutGetRemove(t *testing.T) {
+	m := New[int, string]()
+	m.Put(5, "e")
+	m.Put(6, "f")
+	m.Put(1, "x")
+	m.Put(1, "a") //overwrite
+	if actualValue := m.Size(); actualValue != 3 {
+		t.Errorf("Got %v expected %v", actualValue, 3)
+	}
+	if actualValue, expectedValue := fmt.Sprint(m.Keys()), "[5 6 1]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := strings.Join(m.Values(), ""), "efa"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, found := m.Get(2); actualValue != "" || found {
+		t.Errorf("Got %v expected %v", actualValue, "")
+	}
+	m.Remove(6)
+	m.Remove(7)
+	if actualValue, expectedValue := fmt.Sprint(m.Keys()), "[5 1]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if key, value, found := m.PollFirst(); key != 5 || value != "e" || !found {
+		t.Errorf("Got %v->%v expected %v->%v", key, value, 5, "e")
+	}
+	if key, value, found := m.PollLast(); key != 1 || value != "a" || !found {
+		t.Errorf("Got %v->%v expected %v->%v", key, value, 1, "a")
+	}
+	if key, value, found := m.PollLast(); key != 0 || value != "" || found {
+		t.Errorf("Got %v->%v expected %v->%v", key, value, 0, "")
+	}
+	if actualValue := m.Empty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+}
+
+func TestMapAccessOrder(t *testing.T) {
+	m := NewWithAccessOrder[string, int]()
+	m.Put("a", 1)
+	m.Put("b", 2)
+	m.Put("c", 3)
+	m.Get("a")
+	m.Put("b", 20)
+	if actualValue, expectedValue := strings.Join(m.Keys(), ""), "cab"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	m.MoveToFront("b")
+	m.MoveToBack("c")
+	if actualValue, expectedValue := strings.Join(m.Keys(), ""), "bac"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestMapEnumerableAndIterator(t *testing.T) {
+	m := New[string, int]()
+	m.Put("c", 3)
+	m.Put("a", 1)
+	m.Put("b", 2)
+	selected := m.Select(func(key string, value int) bool {
+		return value >= 2
+	}).(*Map[string, int])
+	if actualValue, expectedValue := strings.Join(selected.Keys(), ""), "cb"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	mapped := m.Map(func(key string, value int) (string, int) {
+		return "x" + key, value * 10
+	}).(*Map[string, int])
+	if actualValue, expectedValue := strings.Join(mapped.Keys(), ""), "xcxaxb"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if key, value := m.Find(func(key string, value int) bool { return value == 2 }); key != "b" || value != 2 {
+		t.Errorf("Got %v->%v expected %v->%v", key, value, "b", 2)
+	}
+	it := m.Iterator()
+	keys := ""
+	for it.End(); it.Prev(); {
+		keys += it.Key()
+	}
+	if keys != "bac" {
+		t.Errorf("Got %v expected %v", keys, "bac")
+	}
+}
+
+func TestMapSerialization(t *testing.T) {
+	m := New[int, string]()
+	m.Put(3, "c")
+	m.Put(1, "a")
+	m.Put(2, "b")
+	bytes, err := json.Marshal(m)
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := string(bytes), `{"3":"c","1":"a","2":"b"}`; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	other := New[int, string]()
+	if err := json.Unmarshal(bytes, other); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := fmt.Sprint(other.Keys()), "[3 1 2]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if err := other.FromJSON([]byte(`{"x":"y"}`)); err == nil {
+		t.Errorf("Expected type error while decoding a string key into an int key")
+	}
+	if actualValue := other.Size(); actualValue != 3 {
+		t.Errorf("Got %v expected %v", actualValue, 3)
+	}
+}
+
+func TestMapString(t *testing.T) {
+	c := New[string, int]()
+	c.Put("a", 1)
+	if !strings.HasPrefix(c.String(), "LinkedHashMap") {
+		t.Errorf("String should start with container name")
+	}
+}
@@ -0,0 +1,84 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package linkedhashmap
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/emirpasic/gods/containers"
+)
+
+// Assert Serialization implementation
+var _ containers.JSONSerializer = (*Map[string, int])(nil)
+var _ containers.JSONDeserializer = (*Map[string, int])(nil)
+
+// ToJSON outputs the JSON representation of map, with the keys in the map's order.
+// Keys must be of a type encoding/json supports as object keys (strings, integers or encoding.TextMarshaler).
+func (m *Map[K, V]) ToJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteRune('{')
+	for it, index := m.Iterator(), 0; it.Next(); index++ {
+		if index > 0 {
+			buf.WriteRune(',')
+		}
+		// a single entry go map is marshalled, so that the key is encoded the same way as encoding/json encodes it
+		element, err := json.Marshal(map[K]V{it.Key(): it.Value()})
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(element[1 : len(element)-1])
+	}
+	buf.WriteRune('}')
+	return buf.Bytes(), nil
+}
+
+// FromJSON populates map from the input JSON representation, preserving the order of the keys in the input.
+func (m *Map[K, V]) FromJSON(data []byte) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	if token, err := decoder.Token(); err != nil {
+		return err
+	} else if token != json.Delim('{') {
+		return fmt.Errorf("expected a JSON object, got %v", token)
+	}
+	elements := New[K, V]()
+	for decoder.More() {
+		token, err := decoder.Token()
+		if err != nil {
+			return err
+		}
+		key, err := json.Marshal(token)
+		if err != nil {
+			return err
+		}
+		var value json.RawMessage
+		if err := decoder.Decode(&value); err != nil {
+			return err
+		}
+		// a single entry go map is unmarshalled, so that the key is decoded the same way as encoding/json decodes it
+		element := make(map[K]V)
+		if err := json.Unmarshal([]byte(fmt.Sprintf("{%s:%s}", key, value)), &element); err != nil {
+			return err
+		}
+		for key, value := range element {
+			elements.Put(key, value)
+		}
+	}
+	if _, err := decoder.Token(); err != nil {
+		return err
+	}
+	m.table, m.head, m.tail = elements.table, elements.head, elements.tail
+	return nil
+}
+
+// UnmarshalJSON @implements json.Unmarshaler
+func (m *Map[K, V]) UnmarshalJSON(bytes []byte) error {
+	return m.FromJSON(bytes)
+}
+
+// MarshalJSON @implements json.Marshaler
+func (m *Map[K, V]) MarshalJSON() ([]byte, error) {
+	return m.ToJSON()
+}
@@ -0,0 +1,32 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package maps provides an abstract type-parameterized Map interface.
+//
+// Reference: https://en.wikipedia.org/wiki/Associative_array
+package maps
+
+import "github.com/emirpasic/gods/generics/containers"
+
+// Map interface that all generic maps implement
+type Map[K any, V any] interface {
+	Put(key K, value V)
+	Get(key K) (value V, found bool)
+	Remove(key K)
+	Keys() []K
+
+	containers.Container[V]
+	// Empty() bool
+	// Size() int
+	// Clear()
+	// Values() []V
+	// String() string
+}
+
+// BidiMap interface that all generic bidirectional maps implement (extends the Map interface)
+type BidiMap[K any, V any] interface {
+	GetKey(value V) (key K, found bool)
+
+	Map[K, V]
+}
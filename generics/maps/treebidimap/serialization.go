@@ -0,0 +1,49 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package treebidimap
+
+import (
+	"github.com/emirpasic/gods/containers"
+	rbt "github.com/emirpasic/gods/generics/trees/redblacktree"
+)
+
+// Assert Serialization implementation
+var _ containers.JSONSerializer = (*Map[string, int])(nil)
+var _ containers.JSONDeserializer = (*Map[string, int])(nil)
+
+// ToJSON outputs the JSON representation of the map.
+// Keys must be of a type encoding/json supports as object keys (strings, integers or encoding.TextMarshaler).
+func (m *Map[K, V]) ToJSON() ([]byte, error) {
+	elements := rbt.NewWith[K, V](m.keyComparator)
+	it := m.Iterator()
+	for it.Next() {
+		elements.Put(it.Key(), it.Value())
+	}
+	return elements.ToJSON()
+}
+
+// FromJSON populates the map from the input JSON representation.
+func (m *Map[K, V]) FromJSON(data []byte) error {
+	elements := rbt.NewWith[K, V](m.keyComparator)
+	if err := elements.FromJSON(data); err != nil {
+		return err
+	}
+	m.Clear()
+	it := elements.Iterator()
+	for it.Next() {
+		m.Put(it.Key(), it.Value())
+	}
+	return nil
+}
+
+// UnmarshalJSON @implements json.Unmarshaler
+func (m *Map[K, V]) UnmarshalJSON(bytes []byte) error {
+	return m.FromJSON(bytes)
+}
+
+// MarshalJSON @implements json.Marshaler
+func (m *Map[K, V]) MarshalJSON() ([]byte, error) {
+	return m.ToJSON()
+}
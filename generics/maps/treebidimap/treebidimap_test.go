@@ -0,0 +1,106 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package treebidimap
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/emirpasic/gods/generics/utils"
+	"strings"
+	"testing"
+)
+
+func TestMapPutGetRemove(t *testing.T) {
+	m := New[int, string]()
+	m.Put(5, "e")
+	m.Put(6, "f")
+	m.Put(7, "g")
+	m.Put(3, "c")
+	m.Put(4, "d")
+	m.Put(1, "x")
+	m.Put(2, "b")
+	m.Put(1, "a") //overwrite
+	m.Put(8, "g") // replaces 7->g
+	if actualValue, expectedValue := fmt.Sprint(m.Keys()), "[1 2 3 4 5 6 8]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := strings.Join(m.Values(), ""), "abcdefg"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, found := m.GetKey("x"); actualValue != 0 || found {
+		t.Errorf("Got %v expected %v", actualValue, 0)
+	}
+	if actualValue, found := m.GetKey("g"); actualValue != 8 || !found {
+		t.Errorf("Got %v expected %v", actualValue, 8)
+	}
+	m.Remove(5)
+	m.Remove(9)
+	if actualValue, found := m.GetKey("e"); actualValue != 0 || found {
+		t.Errorf("Got %v expected %v", actualValue, 0)
+	}
+	if actualValue := m.Size(); actualValue != 6 {
+		t.Errorf("Got %v expected %v", actualValue, 6)
+	}
+}
+
+func TestMapIteratorAndEnumerable(t *testing.T) {
+	m := NewWith[string, int](utils.OrderedComparator[string], func(a, b int) int { return b - a })
+	m.Put("a", 1)
+	m.Put("b", 2)
+	m.Put("c", 3)
+	if actualValue, expectedValue := fmt.Sprint(m.Values()), "[3 2 1]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	it := m.Iterator()
+	if !it.Last() || it.Key() != "c" || it.Value() != 3 {
+		t.Errorf("Got %v->%v expected %v->%v", it.Key(), it.Value(), "c", 3)
+	}
+	mapped := m.Map(func(key string, value int) (string, int) {
+		return strings.ToUpper(key), value * value
+	}).(*Map[string, int])
+	if actualValue, expectedValue := mapped.String(), "TreeBidiMap\nmap[A:1 B:4 C:9]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprint(mapped.Values()), "[9 4 1]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	selected := m.Select(func(key string, value int) bool {
+		return value >= 2
+	}).(*Map[string, int])
+	if actualValue, found := selected.GetKey(2); actualValue != "b" || !found {
+		t.Errorf("Got %v expected %v", actualValue, "b")
+	}
+	if key, value := m.Find(func(key string, value int) bool { return value > 1 }); key != "b" || value != 2 {
+		t.Errorf("Got %v->%v expected %v->%v", key, value, "b", 2)
+	}
+}
+
+func TestMapSerialization(t *testing.T) {
+	m := New[string, float64]()
+	m.Put("a", 1.0)
+	m.Put("b", 2.0)
+	bytes, err := json.Marshal(m)
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := string(bytes), `{"a":1,"b":2}`; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	m.Clear()
+	if err := json.Unmarshal(bytes, m); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, found := m.GetKey(2.0); actualValue != "b" || !found {
+		t.Errorf("Got %v expected %v", actualValue, "b")
+	}
+}
+
+func TestMapString(t *testing.T) {
+	c := New[string, int]()
+	c.Put("a", 1)
+	if !strings.HasPrefix(c.String(), "TreeBidiMap") {
+		t.Errorf("String should start with container name")
+	}
+}
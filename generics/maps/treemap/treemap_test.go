@@ -0,0 +1,156 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package treemap
+
+import (
+	"fmt"
+	"github.com/emirpasic/gods/generics/containers"
+	"strings"
+	"testing"
+)
+
+func TestMapPutGetRemove(t *testing.T) {
+	m := New[int, string]()
+	m.Put(5, "e")
+	m.Put(6, "f")
+	m.Put(7, "g")
+	m.Put(3, "c")
+	m.Put(4, "d")
+	m.Put(1, "x")
+	m.Put(2, "b")
+	m.Put(1, "a") //overwrite
+	if actualValue, expectedValue := fmt.Sprint(m.Keys()), "[1 2 3 4 5 6 7]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := strings.Join(m.Values(), ""), "abcdefg"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	m.Remove(5)
+	m.Remove(8)
+	if actualValue, found := m.Get(5); actualValue != "" || found {
+		t.Errorf("Got %v expected %v", actualValue, "")
+	}
+	if actualValue := m.Size(); actualValue != 6 {
+		t.Errorf("Got %v expected %v", actualValue, 6)
+	}
+}
+
+func TestMapMinMaxFloorCeiling(t *testing.T) {
+	m := New[int, string]()
+	if _, _, found := m.Min(); found {
+		t.Errorf("Got %v expected %v", found, false)
+	}
+	m.Put(1, "a")
+	m.Put(3, "c")
+	m.Put(5, "e")
+	if key, value, found := m.Min(); key != 1 || value != "a" || !found {
+		t.Errorf("Got %v->%v expected %v->%v", key, value, 1, "a")
+	}
+	if key, value, found := m.Max(); key != 5 || value != "e" || !found {
+		t.Errorf("Got %v->%v expected %v->%v", key, value, 5, "e")
+	}
+	if key, _, found := m.Floor(4); key != 3 || !found {
+		t.Errorf("Got %v expected %v", key, 3)
+	}
+	if key, _, found := m.Floor(0); key != 0 || found {
+		t.Errorf("Got %v expected %v", key, 0)
+	}
+	if key, _, found := m.Ceiling(4); key != 5 || !found {
+		t.Errorf("Got %v expected %v", key, 5)
+	}
+	if key, _, found := m.Ceiling(6); key != 0 || found {
+		t.Errorf("Got %v expected %v", key, 0)
+	}
+}
+
+func TestMapEnumerableAndIterator(t *testing.T) {
+	m := New[string, int]()
+	m.Put("c", 3)
+	m.Put("a", 1)
+	m.Put("b", 2)
+	selected := m.Select(func(key string, value int) bool {
+		return value >= 2
+	}).(*Map[string, int])
+	if actualValue, expectedValue := strings.Join(selected.Keys(), ""), "bc"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	mapped := m.Map(func(key string, value int) (string, int) {
+		return "x" + key, value * 10
+	}).(*Map[string, int])
+	if actualValue, found := mapped.Get("xc"); actualValue != 30 || !found {
+		t.Errorf("Got %v expected %v", actualValue, 30)
+	}
+	if key, value := m.Find(func(key string, value int) bool { return value == 2 }); key != "b" || value != 2 {
+		t.Errorf("Got %v->%v expected %v->%v", key, value, "b", 2)
+	}
+	if !m.All(func(key string, value int) bool { return value > 0 }) {
+		t.Errorf("Got %v expected %v", false, true)
+	}
+	it := m.Iterator()
+	keys := ""
+	for it.End(); it.Prev(); {
+		keys += it.Key()
+	}
+	if keys != "cba" {
+		t.Errorf("Got %v expected %v", keys, "cba")
+	}
+}
+
+func TestMapEnumerableInterface(t *testing.T) {
+	var enumerable containers.EnumerableContainerWithKey[string, int] = New[string, int]()
+	enumerable.(*Map[string, int]).Put("a", 1)
+	enumerable.(*Map[string, int]).Put("b", 2)
+	result := enumerable.Select(func(key string, value int) bool {
+		return value > 1
+	}).Map(func(key string, value int) (string, int) {
+		return key + key, value * 10
+	})
+	if _, ok := result.(*Map[string, int]); !ok {
+		t.Errorf("Got %T expected %T", result, &Map[string, int]{})
+	}
+	if actualValue, expectedValue := fmt.Sprint(result.Values()), "[20]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestMapSerialization(t *testing.T) {
+	m := New[string, int]()
+	m.Put("b", 2)
+	m.Put("a", 1)
+	bytes, err := m.ToJSON()
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := string(bytes), `{"a":1,"b":2}`; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	m.Clear()
+	if err := m.FromJSON(bytes); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue := m.Size(); actualValue != 2 {
+		t.Errorf("Got %v expected %v", actualValue, 2)
+	}
+}
+
+func TestMapNonComparableKeys(t *testing.T) {
+	m := NewWith[[]int, string](func(a, b []int) int { return len(a) - len(b) })
+	m.Put([]int{1, 2}, "b")
+	m.Put([]int{1}, "a")
+	if actualValue, found := m.Get([]int{3, 4}); actualValue != "b" || !found {
+		t.Errorf("Got %v expected %v", actualValue, "b")
+	}
+	if actualValue, expectedValue := fmt.Sprint(m.Keys()), "[[1] [1 2]]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestMapString(t *testing.T) {
+	c := New[string, int]()
+	c.Put("a", 1)
+	if !strings.HasPrefix(c.String(), "TreeMap") {
+		t.Errorf("String should start with container name")
+	}
+}
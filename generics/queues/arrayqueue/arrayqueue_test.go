@@ -0,0 +1,42 @@
+// Copyright (c) 2021, Aryan Ahadinia. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package arrayqueue
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestQueueEnqueueDequeuePeek(t *testing.T) {
+	queue := New[string]()
+	if actualValue, ok := queue.Dequeue(); actualValue != "" || ok {
+		t.Errorf("Got %v expected %v", actualValue, "")
+	}
+	queue.Enqueue("a")
+	queue.Enqueue("b")
+	queue.Enqueue("c")
+	if actualValue, expectedValue := strings.Join(queue.Values(), ""), "abc"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, ok := queue.Peek(); actualValue != "a" || !ok {
+		t.Errorf("Got %v expected %v", actualValue, "a")
+	}
+	for _, expectedValue := range []string{"a", "b", "c"} {
+		if actualValue, ok := queue.Dequeue(); actualValue != expectedValue || !ok {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+	if actualValue := queue.Empty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+}
+
+func TestQueueString(t *testing.T) {
+	c := New[int]()
+	c.Enqueue(1)
+	if !strings.HasPrefix(c.String(), "ArrayQueue") {
+		t.Errorf("String should start with container name")
+	}
+}
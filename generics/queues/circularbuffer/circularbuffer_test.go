@@ -0,0 +1,108 @@
+// Copyright (c) 2021, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package circularbuffer
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestQueueEnqueueDequeueOverwrite(t *testing.T) {
+	queue := New[int](3)
+	if actualValue, ok := queue.Dequeue(); actualValue != 0 || ok {
+		t.Errorf("Got %v expected %v", actualValue, 0)
+	}
+	for i := 1; i <= 5; i++ {
+		queue.Enqueue(i)
+	}
+	if actualValue := queue.Full(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue, expectedValue := fmt.Sprint(queue.Values()), "[3 4 5]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, ok := queue.Peek(); actualValue != 3 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 3)
+	}
+	for expectedValue := 3; expectedValue <= 5; expectedValue++ {
+		if actualValue, ok := queue.Dequeue(); actualValue != expectedValue || !ok {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+	if actualValue := queue.Empty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+}
+
+func TestQueueZeroValues(t *testing.T) {
+	queue := New[*int](2)
+	queue.Enqueue(nil)
+	queue.Enqueue(nil)
+	queue.Dequeue()
+	if actualValue := queue.Size(); actualValue != 1 {
+		t.Errorf("Got %v expected %v", actualValue, 1)
+	}
+}
+
+func TestQueueEnumerableAndIterator(t *testing.T) {
+	queue := New[int](3)
+	for i := 0; i <= 3; i++ {
+		queue.Enqueue(i)
+	}
+	values := []int{}
+	it := queue.Iterator()
+	for it.End(); it.Prev(); {
+		values = append(values, it.Value())
+	}
+	if actualValue, expectedValue := fmt.Sprint(values), "[3 2 1]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	mapped := queue.Map(func(index int, value int) int {
+		return value * 10
+	}).(*Queue[int])
+	mapped.Enqueue(40)
+	if actualValue, expectedValue := fmt.Sprint(mapped.Values()), "[20 30 40]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	selected := queue.Select(func(index int, value int) bool {
+		return value != 2
+	})
+	if actualValue, expectedValue := fmt.Sprint(selected.Values()), "[1 3]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if index, value := queue.Find(func(index int, value int) bool { return value == 3 }); index != 2 || value != 3 {
+		t.Errorf("Got %v,%v expected %v,%v", index, value, 2, 3)
+	}
+}
+
+func TestQueueSerialization(t *testing.T) {
+	queue := New[string](2)
+	queue.Enqueue("a")
+	queue.Enqueue("b")
+	queue.Enqueue("c")
+	bytes, err := queue.ToJSON()
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := string(bytes), `["b","c"]`; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	queue.Clear()
+	if err := queue.FromJSON(bytes); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, ok := queue.Dequeue(); actualValue != "b" || !ok {
+		t.Errorf("Got %v expected %v", actualValue, "b")
+	}
+}
+
+func TestQueueString(t *testing.T) {
+	c := New[int](1)
+	c.Enqueue(1)
+	if !strings.HasPrefix(c.String(), "CircularBuffer") {
+		t.Errorf("String should start with container name")
+	}
+}
@@ -0,0 +1,80 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package linkedlistqueue
+
+import "github.com/emirpasic/gods/generics/containers"
+
+// Assert Enumerable implementation
+var _ containers.EnumerableWithIndex[int] = (*Queue[int])(nil)
+
+// Each calls the given function once for each element, passing that element's index and value.
+func (queue *Queue[T]) Each(f func(index int, value T)) {
+	iterator := queue.Iterator()
+	for iterator.Next() {
+		f(iterator.Index(), iterator.Value())
+	}
+}
+
+// Map invokes the given function once for each element and returns a
+// container containing the values returned by the given function.
+// Elements are visited in dequeue (FIFO) order and the returned queue dequeues the results in that same order.
+func (queue *Queue[T]) Map(f func(index int, value T) T) containers.EnumerableContainerWithIndex[T] {
+	newQueue := New[T]()
+	iterator := queue.Iterator()
+	for iterator.Next() {
+		newQueue.list.Add(f(iterator.Index(), iterator.Value()))
+	}
+	return newQueue
+}
+
+// Select returns a new container containing all elements for which the given function returns a true value.
+func (queue *Queue[T]) Select(f func(index int, value T) bool) containers.EnumerableContainerWithIndex[T] {
+	newQueue := New[T]()
+	iterator := queue.Iterator()
+	for iterator.Next() {
+		if f(iterator.Index(), iterator.Value()) {
+			newQueue.list.Add(iterator.Value())
+		}
+	}
+	return newQueue
+}
+
+// Any passes each element of the container to the given function and
+// returns true if the function ever returns true for any element.
+func (queue *Queue[T]) Any(f func(index int, value T) bool) bool {
+	iterator := queue.Iterator()
+	for iterator.Next() {
+		if f(iterator.Index(), iterator.Value()) {
+			return true
+		}
+	}
+	return false
+}
+
+// All passes each element of the container to the given function and
+// returns true if the function returns true for all elements.
+func (queue *Queue[T]) All(f func(index int, value T) bool) bool {
+	iterator := queue.Iterator()
+	for iterator.Next() {
+		if !f(iterator.Index(), iterator.Value()) {
+			return false
+		}
+	}
+	return true
+}
+
+// Find passes each element of the container to the given function and returns
+// the first (index,value) for which the function is true or -1,zero value otherwise
+// if no element matches the criteria.
+func (queue *Queue[T]) Find(f func(index int, value T) bool) (int, T) {
+	iterator := queue.Iterator()
+	for iterator.Next() {
+		if f(iterator.Index(), iterator.Value()) {
+			return iterator.Index(), iterator.Value()
+		}
+	}
+	var zero T
+	return -1, zero
+}
@@ -0,0 +1,92 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package linkedlistqueue
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestQueueEnqueueDequeuePeek(t *testing.T) {
+	queue := New[int]()
+	if actualValue, ok := queue.Dequeue(); actualValue != 0 || ok {
+		t.Errorf("Got %v expected %v", actualValue, 0)
+	}
+	queue.Enqueue(1)
+	queue.Enqueue(2)
+	queue.Enqueue(3)
+	if actualValue, expectedValue := fmt.Sprint(queue.Values()), "[1 2 3]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, ok := queue.Peek(); actualValue != 1 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 1)
+	}
+	for expectedValue := 1; expectedValue <= 3; expectedValue++ {
+		if actualValue, ok := queue.Dequeue(); actualValue != expectedValue || !ok {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+	if actualValue := queue.Empty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+}
+
+func TestQueueEnumerableAndIterator(t *testing.T) {
+	queue := New[int]()
+	queue.Enqueue(1)
+	queue.Enqueue(2)
+	queue.Enqueue(3)
+	values := []int{}
+	for it := queue.Iterator(); it.Next(); {
+		values = append(values, it.Value())
+	}
+	if actualValue, expectedValue := fmt.Sprint(values), "[1 2 3]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	mapped := queue.Map(func(index int, value int) int {
+		return value * 10
+	}).(*Queue[int])
+	if actualValue, ok := mapped.Dequeue(); actualValue != 10 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 10)
+	}
+	selected := queue.Select(func(index int, value int) bool {
+		return value != 2
+	})
+	if actualValue, expectedValue := fmt.Sprint(selected.Values()), "[1 3]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if index, value := queue.Find(func(index int, value int) bool { return value == 3 }); index != 2 || value != 3 {
+		t.Errorf("Got %v,%v expected %v,%v", index, value, 2, 3)
+	}
+	if actualValue := queue.All(func(index int, value int) bool { return value > 0 }); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+}
+
+func TestQueueSerialization(t *testing.T) {
+	queue := New[string]()
+	queue.Enqueue("a")
+	queue.Enqueue("b")
+	bytes, err := queue.ToJSON()
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	queue.Clear()
+	if err := queue.FromJSON(bytes); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, ok := queue.Dequeue(); actualValue != "a" || !ok {
+		t.Errorf("Got %v expected %v", actualValue, "a")
+	}
+}
+
+func TestQueueString(t *testing.T) {
+	c := New[int]()
+	c.Enqueue(1)
+	if !strings.HasPrefix(c.String(), "LinkedListQueue") {
+		t.Errorf("String should start with container name")
+	}
+}
@@ -0,0 +1,69 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package priorityqueue
+
+import (
+	"encoding/json"
+	"github.com/emirpasic/gods/generics/utils"
+	"strings"
+	"testing"
+)
+
+type Element struct {
+	priority int
+	name     string
+}
+
+func TestQueueEnqueueDequeue(t *testing.T) {
+	byPriority := func(a, b Element) int {
+		return -utils.OrderedComparator(a.priority, b.priority) // "-" descending order
+	}
+	queue := NewWith(byPriority)
+	if actualValue, ok := queue.Dequeue(); actualValue != (Element{}) || ok {
+		t.Errorf("Got %v expected %v", actualValue, Element{})
+	}
+	queue.Enqueue(Element{1, "a"})
+	queue.Enqueue(Element{3, "c"})
+	queue.Enqueue(Element{2, "b"})
+	if actualValue, ok := queue.Peek(); actualValue.name != "c" || !ok {
+		t.Errorf("Got %v expected %v", actualValue.name, "c")
+	}
+	names := ""
+	for !queue.Empty() {
+		element, _ := queue.Dequeue()
+		names += element.name
+	}
+	if actualValue, expectedValue := names, "cba"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestQueueSerialization(t *testing.T) {
+	queue := New[int]()
+	queue.Enqueue(3)
+	queue.Enqueue(1)
+	queue.Enqueue(2)
+	bytes, err := json.Marshal(queue)
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	other := New[int]()
+	if err := json.Unmarshal(bytes, other); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	for expectedValue := 1; expectedValue <= 3; expectedValue++ {
+		if actualValue, ok := other.Dequeue(); actualValue != expectedValue || !ok {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+}
+
+func TestQueueString(t *testing.T) {
+	c := New[int]()
+	c.Enqueue(1)
+	if !strings.HasPrefix(c.String(), "PriorityQueue") {
+		t.Errorf("String should start with container name")
+	}
+}
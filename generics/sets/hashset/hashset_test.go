@@ -0,0 +1,64 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package hashset
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSetAddRemoveContains(t *testing.T) {
+	set := New(1, 2, 2, 3)
+	if actualValue := set.Size(); actualValue != 3 {
+		t.Errorf("Got %v expected %v", actualValue, 3)
+	}
+	if actualValue := set.Contains(1, 2, 3); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	set.Remove(2, 4)
+	if actualValue := set.Contains(2); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	set.Clear()
+	if actualValue := set.Empty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+}
+
+func TestSetAlgebra(t *testing.T) {
+	set := New("a", "b", "c")
+	another := New("b", "c", "d")
+	if actualValue := set.Intersection(another); actualValue.Size() != 2 || !actualValue.Contains("b", "c") {
+		t.Errorf("Got %v expected %v", actualValue, "b, c")
+	}
+	if actualValue := set.Union(another); actualValue.Size() != 4 || !actualValue.Contains("a", "b", "c", "d") {
+		t.Errorf("Got %v expected %v", actualValue, "a, b, c, d")
+	}
+	if actualValue := set.Difference(another); actualValue.Size() != 1 || !actualValue.Contains("a") {
+		t.Errorf("Got %v expected %v", actualValue, "a")
+	}
+}
+
+func TestSetSerialization(t *testing.T) {
+	set := New("a", "b", "c")
+	bytes, err := set.ToJSON()
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	set.Clear()
+	if err := set.FromJSON(bytes); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue := set.Contains("a", "b", "c"); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+}
+
+func TestSetString(t *testing.T) {
+	c := New(1)
+	if !strings.HasPrefix(c.String(), "HashSet") {
+		t.Errorf("String should start with container name")
+	}
+}
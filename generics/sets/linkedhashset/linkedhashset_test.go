@@ -0,0 +1,85 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package linkedhashset
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestSetAddRemoveContains(t *testing.T) {
+	set := New(3, 1, 2, 1)
+	if actualValue, expectedValue := fmt.Sprint(set.Values()), "[3 1 2]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	set.Remove(1, 4)
+	set.Add(1)
+	if actualValue, expectedValue := fmt.Sprint(set.Values()), "[3 2 1]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue := set.Contains(1, 2, 3); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	set.Clear()
+	if actualValue := set.Empty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+}
+
+func TestSetAlgebra(t *testing.T) {
+	set := New("c", "b", "a")
+	another := New("d", "c", "b")
+	if actualValue, expectedValue := fmt.Sprint(set.Intersection(another).Values()), "[c b]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprint(set.Union(another).Values()), "[c b a d]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprint(set.Difference(another).Values()), "[a]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestSetIteratorAndEnumerable(t *testing.T) {
+	set := New(3, 1, 2)
+	it := set.Iterator()
+	if !it.Last() || it.Index() != 2 || it.Value() != 2 {
+		t.Errorf("Got %v at %v expected %v at %v", it.Value(), it.Index(), 2, 2)
+	}
+	mapped := set.Map(func(index int, value int) int { return value % 2 }).(*Set[int])
+	if actualValue, expectedValue := fmt.Sprint(mapped.Values()), "[1 0]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	selected := set.Select(func(index int, value int) bool { return value > 1 }).(*Set[int])
+	if actualValue, expectedValue := fmt.Sprint(selected.Values()), "[3 2]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if index, value := set.Find(func(index int, value int) bool { return value < 3 }); index != 1 || value != 1 {
+		t.Errorf("Got %v at %v expected %v at %v", value, index, 1, 1)
+	}
+}
+
+func TestSetSerialization(t *testing.T) {
+	set := New("c", "a", "b")
+	bytes, err := set.ToJSON()
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	set.Clear()
+	if err := set.FromJSON(bytes); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := strings.Join(set.Values(), ""), "cab"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestSetString(t *testing.T) {
+	c := New(1)
+	if !strings.HasPrefix(c.String(), "LinkedHashSet") {
+		t.Errorf("String should start with container name")
+	}
+}
@@ -0,0 +1,24 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package sets provides an abstract type-parameterized Set interface.
+//
+// Reference: https://en.wikipedia.org/wiki/Set_%28abstract_data_type%29
+package sets
+
+import "github.com/emirpasic/gods/generics/containers"
+
+// Set interface that all generic sets implement
+type Set[T any] interface {
+	Add(elements ...T)
+	Remove(elements ...T)
+	Contains(elements ...T) bool
+
+	containers.Container[T]
+	// Empty() bool
+	// Size() int
+	// Clear()
+	// Values() []T
+	// String() string
+}
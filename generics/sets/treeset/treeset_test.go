@@ -0,0 +1,78 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package treeset
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestSetAddRemoveContains(t *testing.T) {
+	set := New(3, 1, 2, 2)
+	if actualValue, expectedValue := fmt.Sprint(set.Values()), "[1 2 3]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue := set.Contains(1, 2, 3); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	set.Remove(2, 4)
+	if actualValue := set.Contains(2); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	set.Clear()
+	if actualValue := set.Empty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+}
+
+func TestSetIterator(t *testing.T) {
+	set := New("c", "a", "b")
+	it := set.Iterator()
+	for it.Next() {
+		if expectedValue := []string{"a", "b", "c"}[it.Index()]; it.Value() != expectedValue {
+			t.Errorf("Got %v expected %v", it.Value(), expectedValue)
+		}
+	}
+	if !it.Last() || it.Index() != 2 || it.Value() != "c" {
+		t.Errorf("Got %v at %v expected %v at %v", it.Value(), it.Index(), "c", 2)
+	}
+}
+
+func TestSetSerialization(t *testing.T) {
+	set := New("c", "b", "a")
+	bytes, err := set.ToJSON()
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := string(bytes), `["a","b","c"]`; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	set.Clear()
+	if err := set.FromJSON(bytes); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue := set.Size(); actualValue != 3 {
+		t.Errorf("Got %v expected %v", actualValue, 3)
+	}
+}
+
+func TestSetNonComparableElements(t *testing.T) {
+	set := NewWith(func(a, b []string) int { return strings.Compare(strings.Join(a, ""), strings.Join(b, "")) })
+	set.Add([]string{"b"}, []string{"a", "b"}, []string{"a"})
+	if actualValue := set.Contains([]string{"ab"}); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue, expectedValue := fmt.Sprint(set.Values()), "[[a] [a b] [b]]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestSetString(t *testing.T) {
+	c := New(1)
+	if !strings.HasPrefix(c.String(), "TreeSet") {
+		t.Errorf("String should start with container name")
+	}
+}
@@ -0,0 +1,43 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package arraystack
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestStackPushPopPeek(t *testing.T) {
+	stack := New[int]()
+	if actualValue, ok := stack.Pop(); actualValue != 0 || ok {
+		t.Errorf("Got %v expected %v", actualValue, 0)
+	}
+	stack.Push(1)
+	stack.Push(2)
+	stack.Push(3)
+	if actualValue, expectedValue := fmt.Sprint(stack.Values()), "[3 2 1]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, ok := stack.Peek(); actualValue != 3 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 3)
+	}
+	for expectedValue := 3; expectedValue > 0; expectedValue-- {
+		if actualValue, ok := stack.Pop(); actualValue != expectedValue || !ok {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+	if actualValue := stack.Empty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+}
+
+func TestStackString(t *testing.T) {
+	c := New[int]()
+	c.Push(1)
+	if !strings.HasPrefix(c.String(), "ArrayStack") {
+		t.Errorf("String should start with container name")
+	}
+}
@@ -0,0 +1,80 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package linkedliststack
+
+import "github.com/emirpasic/gods/generics/containers"
+
+// Assert Enumerable implementation
+var _ containers.EnumerableWithIndex[int] = (*Stack[int])(nil)
+
+// Each calls the given function once for each element, passing that element's index and value.
+func (stack *Stack[T]) Each(f func(index int, value T)) {
+	iterator := stack.Iterator()
+	for iterator.Next() {
+		f(iterator.Index(), iterator.Value())
+	}
+}
+
+// Map invokes the given function once for each element and returns a
+// container containing the values returned by the given function.
+// Elements are visited in pop (LIFO) order and the returned stack pops the results in that same order.
+func (stack *Stack[T]) Map(f func(index int, value T) T) containers.EnumerableContainerWithIndex[T] {
+	newStack := New[T]()
+	iterator := stack.Iterator()
+	for iterator.Next() {
+		newStack.list.Add(f(iterator.Index(), iterator.Value()))
+	}
+	return newStack
+}
+
+// Select returns a new container containing all elements for which the given function returns a true value.
+func (stack *Stack[T]) Select(f func(index int, value T) bool) containers.EnumerableContainerWithIndex[T] {
+	newStack := New[T]()
+	iterator := stack.Iterator()
+	for iterator.Next() {
+		if f(iterator.Index(), iterator.Value()) {
+			newStack.list.Add(iterator.Value())
+		}
+	}
+	return newStack
+}
+
+// Any passes each element of the container to the given function and
+// returns true if the function ever returns true for any element.
+func (stack *Stack[T]) Any(f func(index int, value T) bool) bool {
+	iterator := stack.Iterator()
+	for iterator.Next() {
+		if f(iterator.Index(), iterator.Value()) {
+			return true
+		}
+	}
+	return false
+}
+
+// All passes each element of the container to the given function and
+// returns true if the function returns true for all elements.
+func (stack *Stack[T]) All(f func(index int, value T) bool) bool {
+	iterator := stack.Iterator()
+	for iterator.Next() {
+		if !f(iterator.Index(), iterator.Value()) {
+			return false
+		}
+	}
+	return true
+}
+
+// Find passes each element of the container to the given function and returns
+// the first (index,value) for which the function is true or -1,zero value otherwise
+// if no element matches the criteria.
+func (stack *Stack[T]) Find(f func(index int, value T) bool) (int, T) {
+	iterator := stack.Iterator()
+	for iterator.Next() {
+		if f(iterator.Index(), iterator.Value()) {
+			return iterator.Index(), iterator.Value()
+		}
+	}
+	var zero T
+	return -1, zero
+}
@@ -0,0 +1,92 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package linkedliststack
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestStackPushPopPeek(t *testing.T) {
+	stack := New[int]()
+	if actualValue, ok := stack.Pop(); actualValue != 0 || ok {
+		t.Errorf("Got %v expected %v", actualValue, 0)
+	}
+	stack.Push(1)
+	stack.Push(2)
+	stack.Push(3)
+	if actualValue, expectedValue := fmt.Sprint(stack.Values()), "[3 2 1]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, ok := stack.Peek(); actualValue != 3 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 3)
+	}
+	for expectedValue := 3; expectedValue > 0; expectedValue-- {
+		if actualValue, ok := stack.Pop(); actualValue != expectedValue || !ok {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+	if actualValue := stack.Empty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+}
+
+func TestStackEnumerableAndIterator(t *testing.T) {
+	stack := New[int]()
+	stack.Push(1)
+	stack.Push(2)
+	stack.Push(3)
+	values := []int{}
+	for it := stack.Iterator(); it.Next(); {
+		values = append(values, it.Value())
+	}
+	if actualValue, expectedValue := fmt.Sprint(values), "[3 2 1]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	mapped := stack.Map(func(index int, value int) int {
+		return value * 10
+	}).(*Stack[int])
+	if actualValue, ok := mapped.Pop(); actualValue != 30 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 30)
+	}
+	selected := stack.Select(func(index int, value int) bool {
+		return value != 2
+	})
+	if actualValue, expectedValue := fmt.Sprint(selected.Values()), "[3 1]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if index, value := stack.Find(func(index int, value int) bool { return value == 1 }); index != 2 || value != 1 {
+		t.Errorf("Got %v,%v expected %v,%v", index, value, 2, 1)
+	}
+	if actualValue := stack.Any(func(index int, value int) bool { return value > 3 }); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+}
+
+func TestStackSerialization(t *testing.T) {
+	stack := New[string]()
+	stack.Push("a")
+	stack.Push("b")
+	bytes, err := stack.ToJSON()
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	stack.Clear()
+	if err := stack.FromJSON(bytes); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, ok := stack.Pop(); actualValue != "b" || !ok {
+		t.Errorf("Got %v expected %v", actualValue, "b")
+	}
+}
+
+func TestStackString(t *testing.T) {
+	c := New[int]()
+	c.Push(1)
+	if !strings.HasPrefix(c.String(), "LinkedListStack") {
+		t.Errorf("String should start with container name")
+	}
+}
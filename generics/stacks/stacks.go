@@ -0,0 +1,24 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package stacks provides an abstract type-parameterized Stack interface.
+//
+// Reference: https://en.wikipedia.org/wiki/Stack_%28abstract_data_type%29
+package stacks
+
+import "github.com/emirpasic/gods/generics/containers"
+
+// Stack interface that all generic stacks implement
+type Stack[T any] interface {
+	Push(value T)
+	Pop() (value T, ok bool)
+	Peek() (value T, ok bool)
+
+	containers.Container[T]
+	// Empty() bool
+	// Size() int
+	// Clear()
+	// Values() []T
+	// String() string
+}
@@ -0,0 +1,150 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package binaryheap implements a type-parameterized binary heap backed by a slice.
+//
+// Comparator defines this heap as either min or max heap.
+//
+// Structure is not thread safe.
+//
+// References: http://en.wikipedia.org/wiki/Binary_heap
+package binaryheap
+
+import (
+	"fmt"
+	"github.com/emirpasic/gods/generics/trees"
+	"github.com/emirpasic/gods/generics/utils"
+	"strings"
+)
+
+// Assert Tree implementation
+var _ trees.Tree[int] = (*Heap[int])(nil)
+
+// Heap holds elements in a slice
+type Heap[T any] struct {
+	values     []T
+	Comparator utils.Comparator[T]
+}
+
+// New instantiates a new empty min-heap with the OrderedComparator, i.e. elements are of an ordered type (integers, floats or strings).
+func New[T utils.Ordered]() *Heap[T] {
+	return &Heap[T]{Comparator: utils.OrderedComparator[T]}
+}
+
+// NewWith instantiates a new empty heap tree with the custom comparator.
+func NewWith[T any](comparator utils.Comparator[T]) *Heap[T] {
+	return &Heap[T]{Comparator: comparator}
+}
+
+// Push adds a value onto the heap and bubbles it up accordingly.
+func (heap *Heap[T]) Push(values ...T) {
+	if len(values) == 1 {
+		heap.values = append(heap.values, values[0])
+		heap.bubbleUp()
+	} else {
+		// Reference: https://en.wikipedia.org/wiki/Binary_heap#Building_a_heap
+		heap.values = append(heap.values, values...)
+		size := len(heap.values)/2 + 1
+		for i := size; i >= 0; i-- {
+			heap.bubbleDownIndex(i)
+		}
+	}
+}
+
+// Pop removes top element on heap and returns it, or the zero value if heap is empty.
+// Second return parameter is true, unless the heap was empty and there was nothing to pop.
+func (heap *Heap[T]) Pop() (value T, ok bool) {
+	if len(heap.values) == 0 {
+		return value, false
+	}
+	value = heap.values[0]
+	lastIndex := len(heap.values) - 1
+	heap.values[0] = heap.values[lastIndex]
+	var zero T
+	heap.values[lastIndex] = zero // release the reference for garbage collection
+	heap.values = heap.values[:lastIndex]
+	heap.bubbleDown()
+	return value, true
+}
+
+// Peek returns top element on the heap without removing it, or the zero value if heap is empty.
+// Second return parameter is true, unless the heap was empty and there was nothing to peek.
+func (heap *Heap[T]) Peek() (value T, ok bool) {
+	if len(heap.values) == 0 {
+		return value, false
+	}
+	return heap.values[0], true
+}
+
+// Empty returns true if heap does not contain any elements.
+func (heap *Heap[T]) Empty() bool {
+	return len(heap.values) == 0
+}
+
+// Size returns number of elements within the heap.
+func (heap *Heap[T]) Size() int {
+	return len(heap.values)
+}
+
+// Clear removes all elements from the heap.
+func (heap *Heap[T]) Clear() {
+	heap.values = nil
+}
+
+// Values returns all elements in the heap.
+func (heap *Heap[T]) Values() []T {
+	values := make([]T, len(heap.values))
+	copy(values, heap.values)
+	return values
+}
+
+// String returns a string representation of container
+func (heap *Heap[T]) String() string {
+	str := "BinaryHeap\n"
+	values := []string{}
+	for _, value := range heap.values {
+		values = append(values, fmt.Sprintf("%v", value))
+	}
+	str += strings.Join(values, ", ")
+	return str
+}
+
+// Performs the "bubble down" operation. This is to place the element that is at the root
+// of the heap in its correct place so that the heap maintains the min/max-heap order property.
+func (heap *Heap[T]) bubbleDown() {
+	heap.bubbleDownIndex(0)
+}
+
+// Performs the "bubble down" operation. This is to place the element that is at the index
+// of the heap in its correct place so that the heap maintains the min/max-heap order property.
+func (heap *Heap[T]) bubbleDownIndex(index int) {
+	size := len(heap.values)
+	for leftIndex := index<<1 + 1; leftIndex < size; leftIndex = index<<1 + 1 {
+		rightIndex := index<<1 + 2
+		smallerIndex := leftIndex
+		if rightIndex < size && heap.Comparator(heap.values[leftIndex], heap.values[rightIndex]) > 0 {
+			smallerIndex = rightIndex
+		}
+		if heap.Comparator(heap.values[index], heap.values[smallerIndex]) > 0 {
+			heap.values[index], heap.values[smallerIndex] = heap.values[smallerIndex], heap.values[index]
+		} else {
+			break
+		}
+		index = smallerIndex
+	}
+}
+
+// Performs the "bubble up" operation. This is to place a newly inserted
+// element (i.e. last element in the list) in its correct place so that
+// the heap maintains the min/max-heap order property.
+func (heap *Heap[T]) bubbleUp() {
+	index := len(heap.values) - 1
+	for parentIndex := (index - 1) >> 1; index > 0; parentIndex = (index - 1) >> 1 {
+		if heap.Comparator(heap.values[parentIndex], heap.values[index]) <= 0 {
+			break
+		}
+		heap.values[index], heap.values[parentIndex] = heap.values[parentIndex], heap.values[index]
+		index = parentIndex
+	}
+}
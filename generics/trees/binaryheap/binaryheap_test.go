@@ -0,0 +1,85 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package binaryheap
+
+import (
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+func TestBinaryHeapPushPop(t *testing.T) {
+	heap := New[int]()
+	if actualValue := heap.Empty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	heap.Push(3)
+	heap.Push(2)
+	heap.Push(1)
+	heap.Push(4, 0, 5) // bulk
+	if actualValue := heap.Size(); actualValue != 6 {
+		t.Errorf("Got %v expected %v", actualValue, 6)
+	}
+	if actualValue, ok := heap.Peek(); actualValue != 0 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 0)
+	}
+	for expectedValue := 0; expectedValue <= 5; expectedValue++ {
+		if actualValue, ok := heap.Pop(); actualValue != expectedValue || !ok {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+	if actualValue, ok := heap.Pop(); actualValue != 0 || ok {
+		t.Errorf("Got %v expected %v", actualValue, 0)
+	}
+}
+
+func TestBinaryHeapMaxHeap(t *testing.T) {
+	heap := NewWith[string](func(a, b string) int {
+		return -strings.Compare(a, b)
+	})
+	heap.Push("b", "c", "a")
+	if actualValue, ok := heap.Pop(); actualValue != "c" || !ok {
+		t.Errorf("Got %v expected %v", actualValue, "c")
+	}
+}
+
+func TestBinaryHeapRandom(t *testing.T) {
+	heap := New[int]()
+	rand.Seed(3)
+	for i := 0; i < 10000; i++ {
+		r := int(rand.Int31n(30))
+		heap.Push(r)
+	}
+	prev, _ := heap.Pop()
+	for !heap.Empty() {
+		curr, _ := heap.Pop()
+		if prev > curr {
+			t.Errorf("Heap property invalidated. prev: %v current: %v", prev, curr)
+		}
+		prev = curr
+	}
+}
+
+func TestBinaryHeapNonComparableElements(t *testing.T) {
+	heap := NewWith(func(a, b []int) int { return len(a) - len(b) })
+	heap.Push([]int{1, 2, 3}, []int{1})
+	heap.Push([]int{1, 2})
+	for _, expectedValue := range []int{1, 2, 3} {
+		if actualValue, ok := heap.Pop(); len(actualValue) != expectedValue || !ok {
+			t.Errorf("Got %v expected length %v", actualValue, expectedValue)
+		}
+	}
+	if actualValue, ok := heap.Pop(); actualValue != nil || ok {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+}
+
+func TestBinaryHeapString(t *testing.T) {
+	c := New[int]()
+	c.Push(1)
+	if !strings.HasPrefix(c.String(), "BinaryHeap") {
+		t.Errorf("String should start with container name")
+	}
+}
@@ -0,0 +1,153 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package btree
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestBTreePutGetRemove(t *testing.T) {
+	tree := New[int, string](3)
+	tree.Put(5, "e")
+	tree.Put(6, "f")
+	tree.Put(7, "g")
+	tree.Put(3, "c")
+	tree.Put(4, "d")
+	tree.Put(1, "x")
+	tree.Put(2, "b")
+	tree.Put(1, "a") //overwrite
+
+	if actualValue := tree.Size(); actualValue != 7 {
+		t.Errorf("Got %v expected %v", actualValue, 7)
+	}
+	if actualValue, expectedValue := fmt.Sprint(tree.Keys()), "[1 2 3 4 5 6 7]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := strings.Join(tree.Values(), ""), "abcdefg"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, found := tree.Get(8); actualValue != "" || found {
+		t.Errorf("Got %v expected %v", actualValue, "")
+	}
+	if actualValue := tree.Height(); actualValue != 3 {
+		t.Errorf("Got %v expected %v", actualValue, 3)
+	}
+
+	tree.Remove(5)
+	tree.Remove(6)
+	tree.Remove(7)
+	tree.Remove(8)
+	if actualValue, expectedValue := fmt.Sprint(tree.Keys()), "[1 2 3 4]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if key, value := tree.LeftKey(), tree.LeftValue(); key != 1 || value != "a" {
+		t.Errorf("Got %v->%v expected %v->%v", key, value, 1, "a")
+	}
+	if key, value := tree.RightKey(), tree.RightValue(); key != 4 || value != "d" {
+		t.Errorf("Got %v->%v expected %v->%v", key, value, 4, "d")
+	}
+
+	tree.Clear()
+	if key, value := tree.LeftKey(), tree.LeftValue(); key != 0 || value != "" {
+		t.Errorf("Got %v->%v expected %v->%v", key, value, 0, "")
+	}
+}
+
+func TestBTreeRemoveRebalance(t *testing.T) {
+	tree := New[int, int](4)
+	for i := 1; i <= 100; i++ {
+		tree.Put(i, i)
+	}
+	for i := 2; i <= 100; i += 2 {
+		tree.Remove(i)
+	}
+	if actualValue := tree.Size(); actualValue != 50 {
+		t.Errorf("Got %v expected %v", actualValue, 50)
+	}
+	it := tree.Iterator()
+	for i := 1; it.Next(); i += 2 {
+		if it.Key() != i {
+			t.Errorf("Got %v expected %v", it.Key(), i)
+		}
+	}
+	for i := 1; i <= 100; i += 2 {
+		tree.Remove(i)
+	}
+	if actualValue := tree.Empty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+}
+
+func TestBTreeIterator(t *testing.T) {
+	tree := New[string, int](3)
+	tree.Put("c", 3)
+	tree.Put("a", 1)
+	tree.Put("b", 2)
+	it := tree.Iterator()
+	keys := ""
+	for it.Next() {
+		keys += it.Key()
+	}
+	if keys != "abc" {
+		t.Errorf("Got %v expected %v", keys, "abc")
+	}
+	keys = ""
+	for it.Prev() {
+		keys += it.Key()
+	}
+	if keys != "cba" {
+		t.Errorf("Got %v expected %v", keys, "cba")
+	}
+	if !it.NextTo(func(key string, value int) bool { return value == 2 }) || it.Key() != "b" {
+		t.Errorf("Got %v expected %v", it.Key(), "b")
+	}
+	if !it.First() || it.Key() != "a" {
+		t.Errorf("Got %v expected %v", it.Key(), "a")
+	}
+	if !it.Last() || it.Value() != 3 {
+		t.Errorf("Got %v expected %v", it.Value(), 3)
+	}
+}
+
+func TestBTreeSerialization(t *testing.T) {
+	tree := New[string, string](3)
+	tree.Put("c", "3")
+	tree.Put("b", "2")
+	tree.Put("a", "1")
+
+	bytes, err := tree.ToJSON()
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := string(bytes), `{"a":"1","b":"2","c":"3"}`; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	tree.Clear()
+	if err := tree.FromJSON(bytes); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := strings.Join(tree.Keys(), ""), "abc"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	intTree := New[int, float64](3)
+	if err := json.Unmarshal([]byte(`{"2":2.5,"1":1.5}`), intTree); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := fmt.Sprint(intTree.Keys()), "[1 2]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestBTreeString(t *testing.T) {
+	c := New[int, int](3)
+	c.Put(1, 1)
+	if !strings.HasPrefix(c.String(), "BTree") {
+		t.Errorf("String should start with container name")
+	}
+}
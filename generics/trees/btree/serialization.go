@@ -0,0 +1,75 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package btree
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/emirpasic/gods/containers"
+	"reflect"
+)
+
+// Assert Serialization implementation
+var _ containers.JSONSerializer = (*Tree[string, int])(nil)
+var _ containers.JSONDeserializer = (*Tree[string, int])(nil)
+
+// ToJSON outputs the JSON representation of the tree.
+// Keys must be of a type encoding/json supports as object keys (strings, integers or encoding.TextMarshaler).
+func (tree *Tree[K, V]) ToJSON() ([]byte, error) {
+	elements, err := tree.elements()
+	if err != nil {
+		return nil, err
+	}
+	it := tree.Iterator()
+	for it.Next() {
+		key, value := it.Key(), it.Value()
+		elements.SetMapIndex(reflect.ValueOf(&key).Elem(), reflect.ValueOf(&value).Elem())
+	}
+	return json.Marshal(elements.Interface())
+}
+
+// FromJSON populates the tree from the input JSON representation.
+func (tree *Tree[K, V]) FromJSON(data []byte) error {
+	elements, err := tree.elements()
+	if err != nil {
+		return err
+	}
+	pointer := reflect.New(elements.Type())
+	pointer.Elem().Set(elements)
+	if err := json.Unmarshal(data, pointer.Interface()); err != nil {
+		return err
+	}
+	tree.Clear()
+	for it := pointer.Elem().MapRange(); it.Next(); {
+		var key K
+		var value V
+		reflect.ValueOf(&key).Elem().Set(it.Key())
+		reflect.ValueOf(&value).Elem().Set(it.Value())
+		tree.Put(key, value)
+	}
+	return nil
+}
+
+// elements returns an empty go map from keys to values of the tree, which encoding/json can (un)marshal.
+// Keys are not constrained to comparable types, since the tree orders them with its comparator, so go maps are
+// instantiated through reflection and only for comparable key types.
+func (tree *Tree[K, V]) elements() (reflect.Value, error) {
+	keyType := reflect.TypeOf((*K)(nil)).Elem()
+	if !keyType.Comparable() {
+		return reflect.Value{}, fmt.Errorf("key type %v cannot be used as a JSON object key", keyType)
+	}
+	valueType := reflect.TypeOf((*V)(nil)).Elem()
+	return reflect.MakeMap(reflect.MapOf(keyType, valueType)), nil
+}
+
+// UnmarshalJSON @implements json.Unmarshaler
+func (tree *Tree[K, V]) UnmarshalJSON(bytes []byte) error {
+	return tree.FromJSON(bytes)
+}
+
+// MarshalJSON @implements json.Marshaler
+func (tree *Tree[K, V]) MarshalJSON() ([]byte, error) {
+	return tree.ToJSON()
+}
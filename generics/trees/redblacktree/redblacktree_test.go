@@ -0,0 +1,189 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package redblacktree
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestRedBlackTreePutGetRemove(t *testing.T) {
+	tree := New[int, string]()
+	tree.Put(5, "e")
+	tree.Put(6, "f")
+	tree.Put(7, "g")
+	tree.Put(3, "c")
+	tree.Put(4, "d")
+	tree.Put(1, "x")
+	tree.Put(2, "b")
+	tree.Put(1, "a") //overwrite
+
+	if actualValue := tree.Size(); actualValue != 7 {
+		t.Errorf("Got %v expected %v", actualValue, 7)
+	}
+	if actualValue, expectedValue := fmt.Sprint(tree.Keys()), "[1 2 3 4 5 6 7]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := strings.Join(tree.Values(), ""), "abcdefg"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, found := tree.Get(8); actualValue != "" || found {
+		t.Errorf("Got %v expected %v", actualValue, "")
+	}
+
+	tree.Remove(5)
+	tree.Remove(6)
+	tree.Remove(7)
+	tree.Remove(8)
+	if actualValue, expectedValue := fmt.Sprint(tree.Keys()), "[1 2 3 4]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if node := tree.GetNode(3); node == nil || node.Value != "c" {
+		t.Errorf("Got %v expected %v", node, "c")
+	}
+}
+
+func TestRedBlackTreeCeilingAndFloor(t *testing.T) {
+	tree := New[int, string]()
+	if node, found := tree.Floor(0); node != nil || found {
+		t.Errorf("Got %v expected %v", node, "<nil>")
+	}
+	tree.Put(5, "e")
+	tree.Put(6, "f")
+	tree.Put(7, "g")
+	tree.Put(3, "c")
+	tree.Put(4, "d")
+	tree.Put(1, "x")
+	tree.Put(2, "b")
+	if node, found := tree.Floor(4); node.Key != 4 || !found {
+		t.Errorf("Got %v expected %v", node.Key, 4)
+	}
+	if node, found := tree.Floor(0); node != nil || found {
+		t.Errorf("Got %v expected %v", node, "<nil>")
+	}
+	if node, found := tree.Ceiling(4); node.Key != 4 || !found {
+		t.Errorf("Got %v expected %v", node.Key, 4)
+	}
+	if node, found := tree.Ceiling(8); node != nil || found {
+		t.Errorf("Got %v expected %v", node, "<nil>")
+	}
+	if actualValue := tree.Left().Key; actualValue != 1 {
+		t.Errorf("Got %v expected %v", actualValue, 1)
+	}
+	if actualValue := tree.Right().Key; actualValue != 7 {
+		t.Errorf("Got %v expected %v", actualValue, 7)
+	}
+}
+
+func TestRedBlackTreeIterator(t *testing.T) {
+	tree := New[string, int]()
+	tree.Put("c", 3)
+	tree.Put("a", 1)
+	tree.Put("b", 2)
+	it := tree.Iterator()
+	keys := ""
+	for it.Next() {
+		keys += it.Key()
+	}
+	if keys != "abc" {
+		t.Errorf("Got %v expected %v", keys, "abc")
+	}
+	keys = ""
+	for it.Prev() {
+		keys += it.Key()
+	}
+	if keys != "cba" {
+		t.Errorf("Got %v expected %v", keys, "cba")
+	}
+	if !it.NextTo(func(key string, value int) bool { return value == 2 }) || it.Key() != "b" {
+		t.Errorf("Got %v expected %v", it.Key(), "b")
+	}
+	if !it.First() || it.Key() != "a" {
+		t.Errorf("Got %v expected %v", it.Key(), "a")
+	}
+	if !it.Last() || it.Value() != 3 {
+		t.Errorf("Got %v expected %v", it.Value(), 3)
+	}
+}
+
+func TestRedBlackTreeCustomComparator(t *testing.T) {
+	type Key struct {
+		major, minor int
+	}
+	tree := NewWith[Key, string](func(a, b Key) int {
+		if a.major != b.major {
+			return a.major - b.major
+		}
+		return a.minor - b.minor
+	})
+	tree.Put(Key{2, 1}, "c")
+	tree.Put(Key{1, 2}, "b")
+	tree.Put(Key{1, 1}, "a")
+	if actualValue, expectedValue := strings.Join(tree.Values(), ""), "abc"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestRedBlackTreeSerialization(t *testing.T) {
+	tree := New[string, string]()
+	tree.Put("c", "3")
+	tree.Put("b", "2")
+	tree.Put("a", "1")
+
+	bytes, err := tree.ToJSON()
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := string(bytes), `{"a":"1","b":"2","c":"3"}`; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	tree.Clear()
+	if err := tree.FromJSON(bytes); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := strings.Join(tree.Keys(), ""), "abc"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	intTree := New[int, float64]()
+	if err := json.Unmarshal([]byte(`{"2":2.5,"1":1.5}`), intTree); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := fmt.Sprint(intTree.Keys()), "[1 2]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestRedBlackTreeNonComparableKeys(t *testing.T) {
+	tree := NewWith[[]byte, int](bytes.Compare)
+	tree.Put([]byte("b"), 2)
+	tree.Put([]byte("a"), 1)
+	tree.Put([]byte("b"), 3) // overwrite
+	if actualValue, found := tree.Get([]byte("b")); actualValue != 3 || !found {
+		t.Errorf("Got %v expected %v", actualValue, 3)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%s", tree.Keys()), "[a b]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	// go maps, and thus JSON objects, need comparable keys
+	if _, err := tree.ToJSON(); err == nil {
+		t.Errorf("Expected an error")
+	}
+	if err := tree.FromJSON([]byte(`{"a":1}`)); err == nil {
+		t.Errorf("Expected an error")
+	}
+}
+
+func TestRedBlackTreeString(t *testing.T) {
+	c := New[int, int]()
+	c.Put(1, 1)
+	if !strings.HasPrefix(c.String(), "RedBlackTree") {
+		t.Errorf("String should start with container name")
+	}
+}
@@ -0,0 +1,20 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package trees provides an abstract type-parameterized Tree interface.
+//
+// Reference: https://en.wikipedia.org/wiki/Tree_%28data_structure%29
+package trees
+
+import "github.com/emirpasic/gods/generics/containers"
+
+// Tree interface that all generic trees implement
+type Tree[V any] interface {
+	containers.Container[V]
+	// Empty() bool
+	// Size() int
+	// Clear()
+	// Values() []V
+	// String() string
+}
@@ -0,0 +1,34 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package utils
+
+// Ordered is a constraint that permits any type that supports the operators < <= >= >.
+type Ordered interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64 |
+		~string
+}
+
+// Comparator is the type-safe counterpart of utils.Comparator, no type assertions are made.
+//
+// Should return a number:
+//
+//	negative , if a < b
+//	zero     , if a == b
+//	positive , if a > b
+type Comparator[T any] func(a, b T) int
+
+// OrderedComparator provides a basic comparison on any ordered type (integers, floats and strings).
+func OrderedComparator[T Ordered](a, b T) int {
+	switch {
+	case a > b:
+		return 1
+	case a < b:
+		return -1
+	default:
+		return 0
+	}
+}
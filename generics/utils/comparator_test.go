@@ -0,0 +1,74 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package utils
+
+import (
+	"testing"
+	"time"
+)
+
+func TestOrderedComparatorInt(t *testing.T) {
+	// i1,i2,expected
+	tests := [][]int{
+		{1, 1, 0},
+		{1, 2, -1},
+		{2, 1, 1},
+		{11, 22, -1},
+		{0, 0, 0},
+		{1, 0, 1},
+		{0, 1, -1},
+	}
+	for _, test := range tests {
+		actual := OrderedComparator(test[0], test[1])
+		expected := test[2]
+		if actual != expected {
+			t.Errorf("Got %v expected %v", actual, expected)
+		}
+	}
+}
+
+func TestOrderedComparatorString(t *testing.T) {
+	// s1,s2,expected
+	tests := [][]interface{}{
+		{"a", "a", 0},
+		{"a", "b", -1},
+		{"b", "a", 1},
+		{"aa", "aab", -1},
+		{"", "", 0},
+		{"a", "", 1},
+		{"", "a", -1},
+		{"", "aaaaaaa", -1},
+	}
+	for _, test := range tests {
+		actual := OrderedComparator(test[0].(string), test[1].(string))
+		expected := test[2]
+		if actual != expected {
+			t.Errorf("Got %v expected %v", actual, expected)
+		}
+	}
+}
+
+func TestOrderedComparatorNamedType(t *testing.T) {
+	if actual := OrderedComparator(time.Second, time.Minute); actual != -1 {
+		t.Errorf("Got %v expected %v", actual, -1)
+	}
+}
+
+func TestCustomComparator(t *testing.T) {
+	type Custom struct {
+		id   int
+		name string
+	}
+	byID := func(a, b Custom) int {
+		return OrderedComparator(a.id, b.id)
+	}
+	var comparator Comparator[Custom] = byID
+	if actual := comparator(Custom{1, "a"}, Custom{2, "a"}); actual != -1 {
+		t.Errorf("Got %v expected %v", actual, -1)
+	}
+	if actual := comparator(Custom{2, "b"}, Custom{2, "a"}); actual != 0 {
+		t.Errorf("Got %v expected %v", actual, 0)
+	}
+}
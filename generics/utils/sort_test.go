@@ -0,0 +1,54 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package utils
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestSortInts(t *testing.T) {
+	ints := []int{4, 1, 2, 3}
+	Sort(ints, OrderedComparator[int])
+	for i := 1; i < len(ints); i++ {
+		if ints[i-1] > ints[i] {
+			t.Errorf("Not sorted!")
+		}
+	}
+}
+
+func TestSortStrings(t *testing.T) {
+	strings := []string{"d", "a", "b", "c"}
+	Sort(strings, OrderedComparator[string])
+	for i := 1; i < len(strings); i++ {
+		if strings[i-1] > strings[i] {
+			t.Errorf("Not sorted!")
+		}
+	}
+}
+
+func TestSortRandom(t *testing.T) {
+	ints := []int{}
+	for i := 0; i < 10000; i++ {
+		ints = append(ints, rand.Int())
+	}
+	Sort(ints, OrderedComparator[int])
+	for i := 1; i < len(ints); i++ {
+		if ints[i-1] > ints[i] {
+			t.Errorf("Not sorted!")
+		}
+	}
+}
+
+func BenchmarkGoSortRandom(b *testing.B) {
+	b.StopTimer()
+	ints := []int{}
+	for i := 0; i < 100000; i++ {
+		ints = append(ints, rand.Int())
+	}
+	b.StartTimer()
+	Sort(ints, OrderedComparator[int])
+	b.StopTimer()
+}
@@ -0,0 +1,10 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package utils provides type-parameterized counterparts of the common utility functions.
+//
+// Provided functionalities:
+// - sorting
+// - comparators
+package utils
@@ -0,0 +1,102 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package graphs
+
+import "github.com/emirpasic/gods/sets/disjointset"
+
+// StronglyConnectedComponents returns the vertices of every strongly connected component of the graph, i.e. of every
+// maximal group of vertices that can all reach each other. In an undirected graph these are the connected components.
+// Components are ordered by their first vertex and the vertices of a component by their order in the graph.
+//
+// Reference: https://en.wikipedia.org/wiki/Tarjan%27s_strongly_connected_components_algorithm
+func StronglyConnectedComponents(graph Graph) [][]interface{} {
+	type frame struct {
+		vertex    interface{}
+		neighbors []interface{}
+		next      int // index of the next neighbor to explore
+	}
+	vertices := graph.Vertices()
+	indexes := make(map[interface{}]int, len(vertices))  // order of discovery
+	lowLinks := make(map[interface{}]int, len(vertices)) // lowest index reachable through the vertex's subtree
+	components := make(map[interface{}]int, len(vertices))
+	onStack := make(map[interface{}]struct{})
+	var stack []interface{}
+	count := 0
+	discover := func(vertex interface{}) *frame {
+		indexes[vertex] = len(indexes)
+		lowLinks[vertex] = indexes[vertex]
+		stack = append(stack, vertex)
+		onStack[vertex] = struct{}{}
+		return &frame{vertex: vertex, neighbors: graph.Neighbors(vertex)}
+	}
+	for _, root := range vertices {
+		if _, found := indexes[root]; found {
+			continue
+		}
+		path := []*frame{discover(root)}
+		for len(path) > 0 {
+			top := path[len(path)-1]
+			if top.next < len(top.neighbors) {
+				neighbor := top.neighbors[top.next]
+				top.next++
+				if _, found := indexes[neighbor]; !found {
+					path = append(path, discover(neighbor))
+				} else if _, found := onStack[neighbor]; found && indexes[neighbor] < lowLinks[top.vertex] {
+					lowLinks[top.vertex] = indexes[neighbor]
+				}
+				continue
+			}
+			path = path[:len(path)-1]
+			if len(path) > 0 {
+				if parent := path[len(path)-1].vertex; lowLinks[top.vertex] < lowLinks[parent] {
+					lowLinks[parent] = lowLinks[top.vertex]
+				}
+			}
+			if lowLinks[top.vertex] == indexes[top.vertex] {
+				for {
+					vertex := stack[len(stack)-1]
+					stack = stack[:len(stack)-1]
+					delete(onStack, vertex)
+					components[vertex] = count
+					if vertex == top.vertex {
+						break
+					}
+				}
+				count++
+			}
+		}
+	}
+	return group(vertices, components, count)
+}
+
+// ConnectedComponents returns the vertices of every connected component of the graph, i.e. of every maximal group of
+// vertices that are connected by edges. Directions are ignored, so in a directed graph these are the weakly connected
+// components.
+// Components are ordered by their first vertex and the vertices of a component by their order in the graph.
+//
+// Reference: https://en.wikipedia.org/wiki/Component_(graph_theory)
+func ConnectedComponents(graph Graph) [][]interface{} {
+	set := disjointset.New(graph.Vertices()...)
+	for _, edge := range graph.Edges() {
+		set.Union(edge.From, edge.To)
+	}
+	return set.Sets()
+}
+
+// group collects the vertices by their component numbers, ordering the components by their first vertex
+func group(vertices []interface{}, components map[interface{}]int, count int) [][]interface{} {
+	groups := make([][]interface{}, 0, count)
+	positions := make(map[int]int, count) // component number -> position in groups
+	for _, vertex := range vertices {
+		position, found := positions[components[vertex]]
+		if !found {
+			position = len(groups)
+			positions[components[vertex]] = position
+			groups = append(groups, nil)
+		}
+		groups[position] = append(groups[position], vertex)
+	}
+	return groups
+}
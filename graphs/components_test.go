@@ -0,0 +1,66 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package graphs_test
+
+import (
+	"fmt"
+	"github.com/emirpasic/gods/graphs"
+	"github.com/emirpasic/gods/graphs/directedgraph"
+	"github.com/emirpasic/gods/graphs/undirectedgraph"
+	"testing"
+)
+
+func TestStronglyConnectedComponents(t *testing.T) {
+	graph := withEdges(directedgraph.New(), [][]interface{}{
+		{"a", "b"}, {"b", "c"}, {"c", "a"}, {"b", "d"}, {"d", "e"}, {"e", "f"}, {"f", "d"},
+		{"g", "f"}, {"g", "h"}, {"h", "i"}, {"i", "g"}, {"j", "j"},
+	})
+	graph.AddVertex("k")
+	if actualValue, expectedValue := fmt.Sprintf("%v", graphs.StronglyConnectedComponents(graph)), "[[a b c] [d e f] [g h i] [j] [k]]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	graph.AddEdge("f", "g")
+	if actualValue, expectedValue := fmt.Sprintf("%v", graphs.StronglyConnectedComponents(graph)), "[[a b c] [d e f g h i] [j] [k]]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	undirected := withEdges(undirectedgraph.New(), [][]interface{}{{1, 2}, {3, 4}, {2, 5}})
+	if actualValue, expectedValue := fmt.Sprintf("%v", graphs.StronglyConnectedComponents(undirected)), "[[1 2 5] [3 4]]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	if actualValue, expectedValue := len(graphs.StronglyConnectedComponents(directedgraph.New())), 0; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestStronglyConnectedComponentsDeepGraph(t *testing.T) {
+	graph := directedgraph.New()
+	size := 10000
+	for n := 1; n < size; n++ {
+		graph.AddEdge(n-1, n)
+	}
+	if actualValue, expectedValue := len(graphs.StronglyConnectedComponents(graph)), size; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	graph.AddEdge(size-1, 0)
+	if actualValue, expectedValue := len(graphs.StronglyConnectedComponents(graph)), 1; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestConnectedComponents(t *testing.T) {
+	graph := withEdges(directedgraph.New(), [][]interface{}{{"a", "b"}, {"c", "b"}, {"d", "e"}, {"f", "f"}})
+	if actualValue, expectedValue := fmt.Sprintf("%v", graphs.ConnectedComponents(graph)), "[[a b c] [d e] [f]]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	undirected := withEdges(undirectedgraph.New(), [][]interface{}{{1, 2}, {3, 4}, {2, 5}})
+	undirected.AddVertex(6)
+	if actualValue, expectedValue := fmt.Sprintf("%v", graphs.ConnectedComponents(undirected)), "[[1 2 5] [3 4] [6]]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
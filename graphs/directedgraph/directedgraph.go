@@ -0,0 +1,242 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package directedgraph implements a directed graph backed by adjacency lists.
+//
+// Every vertex keeps its outgoing and its incoming edges in linked hash maps, so that vertices and edges are added,
+// removed and looked up in constant time. Vertices are ordered by insertion and so are the edges of every vertex.
+//
+// Structure is not thread safe.
+//
+// Reference: https://en.wikipedia.org/wiki/Adjacency_list
+package directedgraph
+
+import (
+	"fmt"
+	"github.com/emirpasic/gods/graphs"
+	"github.com/emirpasic/gods/maps/linkedhashmap"
+	"strings"
+)
+
+// Assert Graph implementation
+var _ graphs.Graph = (*Graph)(nil)
+
+// Graph holds the edges of every vertex in linked hash maps from the adjacent vertices to the weights
+type Graph struct {
+	vertices *linkedhashmap.Map // vertex -> *adjacency
+	edges    int
+	weighted bool
+}
+
+type adjacency struct {
+	successors   *linkedhashmap.Map // vertex -> weight of the edge to it
+	predecessors *linkedhashmap.Map // vertex -> weight of the edge from it
+}
+
+// New instantiates an unweighted directed graph, in which all edges weigh 1.
+func New() *Graph {
+	return &Graph{vertices: linkedhashmap.New()}
+}
+
+// NewWeighted instantiates a weighted directed graph.
+func NewWeighted() *Graph {
+	return &Graph{vertices: linkedhashmap.New(), weighted: true}
+}
+
+// AddVertex adds the vertices (one or more) to the graph.
+// Vertices that are already present are ignored.
+func (graph *Graph) AddVertex(vertices ...interface{}) {
+	for _, vertex := range vertices {
+		graph.adjacency(vertex)
+	}
+}
+
+// RemoveVertex removes the vertex from the graph together with all its edges.
+func (graph *Graph) RemoveVertex(vertex interface{}) {
+	a, found := graph.lookup(vertex)
+	if !found {
+		return
+	}
+	for _, successor := range a.successors.Keys() {
+		graph.RemoveEdge(vertex, successor)
+	}
+	for _, predecessor := range a.predecessors.Keys() {
+		graph.RemoveEdge(predecessor, vertex)
+	}
+	graph.vertices.Remove(vertex)
+}
+
+// ContainsVertex returns true if the vertex is in the graph.
+func (graph *Graph) ContainsVertex(vertex interface{}) bool {
+	_, found := graph.vertices.Get(vertex)
+	return found
+}
+
+// AddEdge adds the edge from one vertex to the other with weight 1, or sets the weight of an existing edge to 1.
+// Vertices that are not present are added first.
+func (graph *Graph) AddEdge(from interface{}, to interface{}) {
+	graph.putEdge(from, to, 1)
+}
+
+// AddWeightedEdge adds the edge from one vertex to the other with the weight, or sets the weight of an existing
+// edge. Vertices that are not present are added first.
+// Unweighted graphs do not support weights, so the method panics on them.
+func (graph *Graph) AddWeightedEdge(from interface{}, to interface{}, weight float64) {
+	if !graph.weighted {
+		panic("Unweighted graph does not support weighted edges, use NewWeighted")
+	}
+	graph.putEdge(from, to, weight)
+}
+
+// RemoveEdge removes the edge from one vertex to the other, if any.
+// The vertices are kept.
+func (graph *Graph) RemoveEdge(from interface{}, to interface{}) {
+	if !graph.ContainsEdge(from, to) {
+		return
+	}
+	graph.adjacency(from).successors.Remove(to)
+	graph.adjacency(to).predecessors.Remove(from)
+	graph.edges--
+}
+
+// ContainsEdge returns true if there is an edge from one vertex to the other.
+func (graph *Graph) ContainsEdge(from interface{}, to interface{}) bool {
+	_, found := graph.Weight(from, to)
+	return found
+}
+
+// Weight returns the weight of the edge from one vertex to the other, or 0 if there is no such edge.
+// Second return parameter is true if the edge was found, otherwise false.
+func (graph *Graph) Weight(from interface{}, to interface{}) (weight float64, found bool) {
+	if a, found := graph.lookup(from); found {
+		if weight, found := a.successors.Get(to); found {
+			return weight.(float64), true
+		}
+	}
+	return 0, false
+}
+
+// Neighbors returns the vertices that the edges of the vertex lead to, in order of the insertion of the edges.
+func (graph *Graph) Neighbors(vertex interface{}) []interface{} {
+	if a, found := graph.lookup(vertex); found {
+		return a.successors.Keys()
+	}
+	return []interface{}{}
+}
+
+// Predecessors returns the vertices that have an edge leading to the vertex, in order of the insertion of the edges.
+func (graph *Graph) Predecessors(vertex interface{}) []interface{} {
+	if a, found := graph.lookup(vertex); found {
+		return a.predecessors.Keys()
+	}
+	return []interface{}{}
+}
+
+// OutDegree returns the number of edges leading from the vertex.
+func (graph *Graph) OutDegree(vertex interface{}) int {
+	if a, found := graph.lookup(vertex); found {
+		return a.successors.Size()
+	}
+	return 0
+}
+
+// InDegree returns the number of edges leading to the vertex.
+func (graph *Graph) InDegree(vertex interface{}) int {
+	if a, found := graph.lookup(vertex); found {
+		return a.predecessors.Size()
+	}
+	return 0
+}
+
+// Vertices returns all vertices in insertion order.
+func (graph *Graph) Vertices() []interface{} {
+	return graph.vertices.Keys()
+}
+
+// Edges returns all edges, ordered by the vertex they lead from and then by insertion.
+func (graph *Graph) Edges() []graphs.Edge {
+	edges := make([]graphs.Edge, 0, graph.edges)
+	vertices := graph.vertices.Iterator()
+	for vertices.Next() {
+		successors := vertices.Value().(*adjacency).successors.Iterator()
+		for successors.Next() {
+			edges = append(edges, graphs.Edge{From: vertices.Key(), To: successors.Key(), Weight: successors.Value().(float64)})
+		}
+	}
+	return edges
+}
+
+// EdgeCount returns the number of edges.
+func (graph *Graph) EdgeCount() int {
+	return graph.edges
+}
+
+// Directed returns true.
+func (graph *Graph) Directed() bool {
+	return true
+}
+
+// Weighted returns true if the graph was instantiated by NewWeighted.
+func (graph *Graph) Weighted() bool {
+	return graph.weighted
+}
+
+// Empty returns true if graph does not contain any vertices.
+func (graph *Graph) Empty() bool {
+	return graph.vertices.Empty()
+}
+
+// Size returns the number of vertices.
+func (graph *Graph) Size() int {
+	return graph.vertices.Size()
+}
+
+// Clear removes all vertices and edges.
+func (graph *Graph) Clear() {
+	graph.vertices.Clear()
+	graph.edges = 0
+}
+
+// Values returns all vertices in insertion order.
+func (graph *Graph) Values() []interface{} {
+	return graph.Vertices()
+}
+
+// String returns a string representation of container
+func (graph *Graph) String() string {
+	str := "DirectedGraph\n"
+	items := []string{}
+	vertices := graph.vertices.Iterator()
+	for vertices.Next() {
+		items = append(items, fmt.Sprintf("%v->%v", vertices.Key(), vertices.Value().(*adjacency).successors.Keys()))
+	}
+	str += strings.Join(items, ", ")
+	return str
+}
+
+func (graph *Graph) putEdge(from interface{}, to interface{}, weight float64) {
+	if !graph.ContainsEdge(from, to) {
+		graph.edges++
+	}
+	graph.adjacency(from).successors.Put(to, weight)
+	graph.adjacency(to).predecessors.Put(from, weight)
+}
+
+// lookup returns the edges of the vertex, if it is present
+func (graph *Graph) lookup(vertex interface{}) (*adjacency, bool) {
+	if a, found := graph.vertices.Get(vertex); found {
+		return a.(*adjacency), true
+	}
+	return nil, false
+}
+
+// adjacency returns the edges of the vertex, adding the vertex if it is not present
+func (graph *Graph) adjacency(vertex interface{}) *adjacency {
+	a, found := graph.lookup(vertex)
+	if !found {
+		a = &adjacency{successors: linkedhashmap.New(), predecessors: linkedhashmap.New()}
+		graph.vertices.Put(vertex, a)
+	}
+	return a
+}
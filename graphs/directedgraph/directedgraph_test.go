@@ -0,0 +1,217 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package directedgraph
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestGraphDegrees(t *testing.T) {
+	graph := New()
+	graph.AddEdge("a", "b")
+	graph.AddEdge("c", "b")
+	graph.AddEdge("b", "d")
+	graph.AddEdge("b", "b")
+
+	tests := [][]interface{}{
+		{"a", 1, 0, "[]"},
+		{"b", 2, 3, "[a c b]"},
+		{"c", 1, 0, "[]"},
+		{"d", 0, 1, "[b]"},
+		{"x", 0, 0, "[]"},
+	}
+	for _, test := range tests {
+		if actualValue := graph.OutDegree(test[0]); actualValue != test[1] {
+			t.Errorf("Got %v expected %v for %v", actualValue, test[1], test[0])
+		}
+		if actualValue := graph.InDegree(test[0]); actualValue != test[2] {
+			t.Errorf("Got %v expected %v for %v", actualValue, test[2], test[0])
+		}
+		if actualValue := fmt.Sprintf("%v", graph.Predecessors(test[0])); actualValue != test[3] {
+			t.Errorf("Got %v expected %v for %v", actualValue, test[3], test[0])
+		}
+	}
+
+	graph.RemoveVertex("b")
+	if actualValue, expectedValue := graph.OutDegree("a")+graph.InDegree("d"), 0; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", graph.Edges()), "[]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestGraphNeighborIterator(t *testing.T) {
+	graph := NewWeighted()
+	it := graph.NeighborIterator("a")
+	for it.Next() {
+		t.Errorf("Shouldn't iterate on missing vertex")
+	}
+
+	graph.AddWeightedEdge("a", "c", 3)
+	graph.AddWeightedEdge("a", "b", 2)
+	graph.AddWeightedEdge("b", "a", 1)
+
+	it = graph.NeighborIterator("a")
+	items := []string{}
+	for it.Next() {
+		items = append(items, fmt.Sprintf("%v:%v", it.Key(), it.Value()))
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", items), "[c:3 b:2]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	items = []string{}
+	for it.Prev() {
+		items = append(items, fmt.Sprintf("%v:%v", it.Key(), it.Value()))
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", items), "[b:2 c:3]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := it.Last(), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := it.Key(), "b"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	it.Begin()
+	if actualValue, expectedValue := it.NextTo(func(key interface{}, value interface{}) bool { return value.(float64) < 3 }), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := it.Key(), "b"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestGraphSerialization(t *testing.T) {
+	graph := New()
+	graph.AddVertex("x")
+	graph.AddEdge("a", "b")
+	serialized, err := graph.ToJSON()
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := string(serialized), `{"vertices":["x","a","b"],"edges":[{"from":"a","to":"b"}]}`; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	weighted := NewWeighted()
+	err = weighted.FromJSON([]byte(`{"vertices":[1],"edges":[{"from":1,"to":2,"weight":0.5},{"from":2,"to":1}]}`))
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	serialized, err = json.Marshal(weighted)
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := string(serialized), `{"vertices":[1,2],"edges":[{"from":1,"to":2,"weight":0.5},{"from":2,"to":1,"weight":1}]}`; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	err = graph.FromJSON([]byte(`{"vertices":[],"edges":[{"from":"a","to":"b","weight":2}]}`))
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, _ := graph.Weight("a", "b"); actualValue != 1 {
+		t.Errorf("Got %v expected %v", actualValue, 1)
+	}
+}
+
+func TestGraphString(t *testing.T) {
+	graph := New()
+	graph.AddEdge("a", "b")
+	if !strings.HasPrefix(graph.String(), "DirectedGraph") {
+		t.Errorf("String should start with container name")
+	}
+	if actualValue, expectedValue := graph.String(), "DirectedGraph\na->[b], b->[]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func benchmarkContainsEdge(b *testing.B, graph *Graph, size int) {
+	for i := 0; i < b.N; i++ {
+		for n := 0; n < size; n++ {
+			graph.ContainsEdge(n, n+1)
+		}
+	}
+}
+
+func benchmarkAddEdge(b *testing.B, graph *Graph, size int) {
+	for i := 0; i < b.N; i++ {
+		for n := 0; n < size; n++ {
+			graph.AddEdge(n, n+1)
+		}
+	}
+}
+
+func benchmarkRemoveVertex(b *testing.B, graph *Graph, size int) {
+	for i := 0; i < b.N; i++ {
+		for n := 0; n < size; n++ {
+			graph.RemoveVertex(n)
+		}
+	}
+}
+
+func BenchmarkDirectedGraphContainsEdge100(b *testing.B) {
+	b.StopTimer()
+	size := 100
+	graph := New()
+	for n := 0; n < size; n++ {
+		graph.AddEdge(n, n+1)
+	}
+	b.StartTimer()
+	benchmarkContainsEdge(b, graph, size)
+}
+
+func BenchmarkDirectedGraphContainsEdge10000(b *testing.B) {
+	b.StopTimer()
+	size := 10000
+	graph := New()
+	for n := 0; n < size; n++ {
+		graph.AddEdge(n, n+1)
+	}
+	b.StartTimer()
+	benchmarkContainsEdge(b, graph, size)
+}
+
+func BenchmarkDirectedGraphAddEdge100(b *testing.B) {
+	b.StopTimer()
+	size := 100
+	graph := New()
+	b.StartTimer()
+	benchmarkAddEdge(b, graph, size)
+}
+
+func BenchmarkDirectedGraphAddEdge10000(b *testing.B) {
+	b.StopTimer()
+	size := 10000
+	graph := New()
+	b.StartTimer()
+	benchmarkAddEdge(b, graph, size)
+}
+
+func BenchmarkDirectedGraphRemoveVertex100(b *testing.B) {
+	b.StopTimer()
+	size := 100
+	graph := New()
+	for n := 0; n < size; n++ {
+		graph.AddEdge(n, n+1)
+	}
+	b.StartTimer()
+	benchmarkRemoveVertex(b, graph, size)
+}
+
+func BenchmarkDirectedGraphRemoveVertex10000(b *testing.B) {
+	b.StopTimer()
+	size := 10000
+	graph := New()
+	for n := 0; n < size; n++ {
+		graph.AddEdge(n, n+1)
+	}
+	b.StartTimer()
+	benchmarkRemoveVertex(b, graph, size)
+}
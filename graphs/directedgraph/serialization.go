@@ -0,0 +1,69 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package directedgraph
+
+import (
+	"encoding/json"
+	"github.com/emirpasic/gods/containers"
+)
+
+// Assert Serialization implementation
+var _ containers.JSONSerializer = (*Graph)(nil)
+var _ containers.JSONDeserializer = (*Graph)(nil)
+
+type jsonGraph struct {
+	Vertices []interface{} `json:"vertices"`
+	Edges    []jsonEdge    `json:"edges"`
+}
+
+type jsonEdge struct {
+	From   interface{} `json:"from"`
+	To     interface{} `json:"to"`
+	Weight *float64    `json:"weight,omitempty"`
+}
+
+// ToJSON outputs the JSON representation of the graph, i.e. an object holding the array of vertices and the array of
+// edges, each of which is an object with the vertices it leads from and to and, in weighted graphs, its weight.
+func (graph *Graph) ToJSON() ([]byte, error) {
+	elements := jsonGraph{Vertices: graph.Vertices(), Edges: []jsonEdge{}}
+	for _, edge := range graph.Edges() {
+		element := jsonEdge{From: edge.From, To: edge.To}
+		if graph.weighted {
+			weight := edge.Weight
+			element.Weight = &weight
+		}
+		elements.Edges = append(elements.Edges, element)
+	}
+	return json.Marshal(&elements)
+}
+
+// FromJSON populates the graph from the input JSON representation.
+// Weights of the edges are ignored by unweighted graphs, and edges without weight weigh 1 in weighted graphs.
+func (graph *Graph) FromJSON(data []byte) error {
+	elements := jsonGraph{}
+	err := json.Unmarshal(data, &elements)
+	if err == nil {
+		graph.Clear()
+		graph.AddVertex(elements.Vertices...)
+		for _, edge := range elements.Edges {
+			if graph.weighted && edge.Weight != nil {
+				graph.AddWeightedEdge(edge.From, edge.To, *edge.Weight)
+			} else {
+				graph.AddEdge(edge.From, edge.To)
+			}
+		}
+	}
+	return err
+}
+
+// UnmarshalJSON @implements json.Unmarshaler
+func (graph *Graph) UnmarshalJSON(bytes []byte) error {
+	return graph.FromJSON(bytes)
+}
+
+// MarshalJSON @implements json.Marshaler
+func (graph *Graph) MarshalJSON() ([]byte, error) {
+	return graph.ToJSON()
+}
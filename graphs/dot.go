@@ -0,0 +1,43 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package graphs
+
+import (
+	"github.com/emirpasic/gods/utils"
+	"strconv"
+	"strings"
+)
+
+// ToDOT returns the graph in the DOT language of Graphviz, with every vertex and every edge on a line of its own.
+// Vertices are named by their string representation and edges of a weighted graph are labeled with their weights.
+//
+// Reference: https://graphviz.org/doc/info/lang.html
+func ToDOT(graph Graph) string {
+	var builder strings.Builder
+	kind, connector := "graph", " -- "
+	if graph.Directed() {
+		kind, connector = "digraph", " -> "
+	}
+	builder.WriteString(kind + " {\n")
+	for _, vertex := range graph.Vertices() {
+		builder.WriteString("\t" + quote(vertex) + ";\n")
+	}
+	for _, edge := range graph.Edges() {
+		builder.WriteString("\t" + quote(edge.From) + connector + quote(edge.To))
+		if graph.Weighted() {
+			builder.WriteString(` [label="` + strconv.FormatFloat(edge.Weight, 'g', -1, 64) + `"]`)
+		}
+		builder.WriteString(";\n")
+	}
+	builder.WriteString("}\n")
+	return builder.String()
+}
+
+var escaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+// quote returns the vertex as a quoted DOT identifier
+func quote(vertex interface{}) string {
+	return `"` + escaper.Replace(utils.ToString(vertex)) + `"`
+}
@@ -0,0 +1,43 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package graphs_test
+
+import (
+	"github.com/emirpasic/gods/graphs"
+	"github.com/emirpasic/gods/graphs/directedgraph"
+	"github.com/emirpasic/gods/graphs/undirectedgraph"
+	"testing"
+)
+
+func TestToDOT(t *testing.T) {
+	directed := withEdges(directedgraph.New(), [][]interface{}{{"a", "b"}, {`say "hi"`, 1}})
+	expectedValue := "digraph {\n" +
+		"\t\"a\";\n" +
+		"\t\"b\";\n" +
+		"\t\"say \\\"hi\\\"\";\n" +
+		"\t\"1\";\n" +
+		"\t\"a\" -> \"b\";\n" +
+		"\t\"say \\\"hi\\\"\" -> \"1\";\n" +
+		"}\n"
+	if actualValue := graphs.ToDOT(directed); actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	undirected := withEdges(undirectedgraph.NewWeighted(), [][]interface{}{{"a", "b", 2.5}, {"b", "c", 1.0}})
+	expectedValue = "graph {\n" +
+		"\t\"a\";\n" +
+		"\t\"b\";\n" +
+		"\t\"c\";\n" +
+		"\t\"a\" -- \"b\" [label=\"2.5\"];\n" +
+		"\t\"b\" -- \"c\" [label=\"1\"];\n" +
+		"}\n"
+	if actualValue := graphs.ToDOT(undirected); actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	if actualValue, expectedValue := graphs.ToDOT(undirectedgraph.New()), "graph {\n}\n"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
@@ -0,0 +1,49 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package graphs provides an abstract Graph interface and algorithms that work on any graph.
+//
+// A graph is a set of vertices together with a set of edges, each of which connects two vertices. In a directed
+// graph an edge leads from one vertex to another, while in an undirected graph it connects both vertices with each
+// other. In a weighted graph every edge has a weight, while in an unweighted graph all edges weigh 1.
+//
+// Size returns the number of vertices and Values returns the vertices.
+//
+// Reference: https://en.wikipedia.org/wiki/Graph_(abstract_data_type)
+package graphs
+
+import "github.com/emirpasic/gods/containers"
+
+// Graph interface that all graphs implement
+type Graph interface {
+	AddVertex(vertices ...interface{})
+	RemoveVertex(vertex interface{})
+	ContainsVertex(vertex interface{}) bool
+	AddEdge(from interface{}, to interface{})
+	AddWeightedEdge(from interface{}, to interface{}, weight float64)
+	RemoveEdge(from interface{}, to interface{})
+	ContainsEdge(from interface{}, to interface{}) bool
+	Weight(from interface{}, to interface{}) (weight float64, found bool)
+	Neighbors(vertex interface{}) []interface{}
+	Vertices() []interface{}
+	Edges() []Edge
+	EdgeCount() int
+	Directed() bool
+	Weighted() bool
+
+	containers.Container
+	// Empty() bool
+	// Size() int
+	// Clear()
+	// Values() []interface{}
+	// String() string
+}
+
+// Edge is an edge of a graph together with its weight.
+// In an undirected graph, From and To are interchangeable.
+type Edge struct {
+	From   interface{}
+	To     interface{}
+	Weight float64
+}
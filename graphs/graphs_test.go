@@ -0,0 +1,228 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package graphs_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/emirpasic/gods/graphs"
+	"github.com/emirpasic/gods/graphs/directedgraph"
+	"github.com/emirpasic/gods/graphs/undirectedgraph"
+	"testing"
+)
+
+// implementations returns a fresh empty instance of every graph
+func implementations() map[string]func() graphs.Graph {
+	return map[string]func() graphs.Graph{
+		"DirectedGraph":           func() graphs.Graph { return directedgraph.New() },
+		"WeightedDirectedGraph":   func() graphs.Graph { return directedgraph.NewWeighted() },
+		"UndirectedGraph":         func() graphs.Graph { return undirectedgraph.New() },
+		"WeightedUndirectedGraph": func() graphs.Graph { return undirectedgraph.NewWeighted() },
+	}
+}
+
+// withEdges adds the edges to the graph, each given by the vertices it connects and optionally its weight
+func withEdges(graph graphs.Graph, edges [][]interface{}) graphs.Graph {
+	for _, edge := range edges {
+		if len(edge) == 3 {
+			graph.AddWeightedEdge(edge[0], edge[1], edge[2].(float64))
+		} else {
+			graph.AddEdge(edge[0], edge[1])
+		}
+	}
+	return graph
+}
+
+func TestGraphVerticesAndEdges(t *testing.T) {
+	for name, newGraph := range implementations() {
+		graph := newGraph()
+		if actualValue, expectedValue := graph.Empty(), true; actualValue != expectedValue {
+			t.Errorf("[%s] Got %v expected %v", name, actualValue, expectedValue)
+		}
+		graph.AddVertex("a", "b")
+		graph.AddVertex("a") // ignored
+		graph.AddEdge("b", "c")
+		graph.AddEdge("a", "b")
+		graph.AddEdge("a", "b") // ignored
+		graph.AddEdge("c", "c")
+
+		if actualValue, expectedValue := fmt.Sprintf("%v", graph.Vertices()), "[a b c]"; actualValue != expectedValue {
+			t.Errorf("[%s] Got %v expected %v", name, actualValue, expectedValue)
+		}
+		if actualValue, expectedValue := fmt.Sprintf("%v", graph.Values()), "[a b c]"; actualValue != expectedValue {
+			t.Errorf("[%s] Got %v expected %v", name, actualValue, expectedValue)
+		}
+		if actualValue, expectedValue := graph.Size(), 3; actualValue != expectedValue {
+			t.Errorf("[%s] Got %v expected %v", name, actualValue, expectedValue)
+		}
+		if actualValue, expectedValue := graph.EdgeCount(), 3; actualValue != expectedValue {
+			t.Errorf("[%s] Got %v expected %v", name, actualValue, expectedValue)
+		}
+		if actualValue, expectedValue := len(graph.Edges()), 3; actualValue != expectedValue {
+			t.Errorf("[%s] Got %v expected %v", name, actualValue, expectedValue)
+		}
+
+		tests := [][]interface{}{
+			{"a", "b", true},
+			{"b", "c", true},
+			{"c", "c", true},
+			{"b", "a", !graph.Directed()},
+			{"c", "b", !graph.Directed()},
+			{"a", "c", false},
+			{"a", "x", false},
+			{"x", "a", false},
+		}
+		for _, test := range tests {
+			if actualValue := graph.ContainsEdge(test[0], test[1]); actualValue != test[2] {
+				t.Errorf("[%s] Got %v expected %v for %v-%v", name, actualValue, test[2], test[0], test[1])
+			}
+			actualWeight, actualFound := graph.Weight(test[0], test[1])
+			if expectedWeight := map[bool]float64{true: 1, false: 0}[test[2].(bool)]; actualWeight != expectedWeight || actualFound != test[2] {
+				t.Errorf("[%s] Got %v,%v expected %v,%v", name, actualWeight, actualFound, expectedWeight, test[2])
+			}
+		}
+		if actualValue := graph.ContainsVertex("c"); actualValue != true {
+			t.Errorf("[%s] Got %v expected %v", name, actualValue, true)
+		}
+		if actualValue := graph.ContainsVertex("x"); actualValue != false {
+			t.Errorf("[%s] Got %v expected %v", name, actualValue, false)
+		}
+	}
+}
+
+func TestGraphNeighbors(t *testing.T) {
+	for name, newGraph := range implementations() {
+		graph := newGraph()
+		graph.AddEdge("a", "c")
+		graph.AddEdge("a", "b")
+		graph.AddEdge("b", "c")
+
+		expectedValues := map[string]string{"a": "[c b]", "b": "[c]", "c": "[]", "x": "[]"}
+		if !graph.Directed() {
+			expectedValues = map[string]string{"a": "[c b]", "b": "[a c]", "c": "[a b]", "x": "[]"}
+		}
+		for vertex, expectedValue := range expectedValues {
+			if actualValue := fmt.Sprintf("%v", graph.Neighbors(vertex)); actualValue != expectedValue {
+				t.Errorf("[%s] Got %v expected %v for %v", name, actualValue, expectedValue, vertex)
+			}
+		}
+	}
+}
+
+func TestGraphRemove(t *testing.T) {
+	for name, newGraph := range implementations() {
+		graph := newGraph()
+		graph.AddEdge("a", "b")
+		graph.AddEdge("b", "c")
+		graph.AddEdge("c", "a")
+		graph.AddEdge("c", "c")
+
+		graph.RemoveEdge("a", "b")
+		graph.RemoveEdge("a", "x") // ignored
+		graph.RemoveEdge("x", "y") // ignored
+		if actualValue, expectedValue := graph.EdgeCount(), 3; actualValue != expectedValue {
+			t.Errorf("[%s] Got %v expected %v", name, actualValue, expectedValue)
+		}
+		if actualValue, expectedValue := graph.ContainsEdge("a", "b"), false; actualValue != expectedValue {
+			t.Errorf("[%s] Got %v expected %v", name, actualValue, expectedValue)
+		}
+		if actualValue, expectedValue := graph.Size(), 3; actualValue != expectedValue {
+			t.Errorf("[%s] Got %v expected %v", name, actualValue, expectedValue)
+		}
+
+		graph.RemoveVertex("c")
+		graph.RemoveVertex("x") // ignored
+		if actualValue, expectedValue := fmt.Sprintf("%v", graph.Vertices()), "[a b]"; actualValue != expectedValue {
+			t.Errorf("[%s] Got %v expected %v", name, actualValue, expectedValue)
+		}
+		if actualValue, expectedValue := graph.EdgeCount(), 0; actualValue != expectedValue {
+			t.Errorf("[%s] Got %v expected %v", name, actualValue, expectedValue)
+		}
+		if actualValue, expectedValue := fmt.Sprintf("%v", graph.Neighbors("b")), "[]"; actualValue != expectedValue {
+			t.Errorf("[%s] Got %v expected %v", name, actualValue, expectedValue)
+		}
+
+		graph.Clear()
+		if actualValue, expectedValue := graph.Empty(), true; actualValue != expectedValue {
+			t.Errorf("[%s] Got %v expected %v", name, actualValue, expectedValue)
+		}
+		if actualValue, expectedValue := graph.EdgeCount(), 0; actualValue != expectedValue {
+			t.Errorf("[%s] Got %v expected %v", name, actualValue, expectedValue)
+		}
+	}
+}
+
+func TestGraphWeights(t *testing.T) {
+	for name, newGraph := range implementations() {
+		graph := newGraph()
+		if !graph.Weighted() {
+			func() {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("[%s] Should panic on a weighted edge in an unweighted graph", name)
+					}
+				}()
+				graph.AddWeightedEdge("a", "b", 2)
+			}()
+			continue
+		}
+		graph.AddWeightedEdge("a", "b", 2.5)
+		graph.AddWeightedEdge("b", "c", -1)
+		graph.AddWeightedEdge("a", "b", 3) // overwrite
+		graph.AddEdge("c", "d")
+
+		tests := [][]interface{}{
+			{"a", "b", 3.0},
+			{"b", "c", -1.0},
+			{"c", "d", 1.0},
+		}
+		for _, test := range tests {
+			if actualValue, actualFound := graph.Weight(test[0], test[1]); actualValue != test[2] || !actualFound {
+				t.Errorf("[%s] Got %v,%v expected %v,%v", name, actualValue, actualFound, test[2], true)
+			}
+		}
+		if actualValue, expectedValue := graph.EdgeCount(), 3; actualValue != expectedValue {
+			t.Errorf("[%s] Got %v expected %v", name, actualValue, expectedValue)
+		}
+		if actualValue, expectedValue := fmt.Sprintf("%v", graph.Edges()), "[{a b 3} {b c -1} {c d 1}]"; actualValue != expectedValue {
+			t.Errorf("[%s] Got %v expected %v", name, actualValue, expectedValue)
+		}
+	}
+}
+
+func TestGraphSerialization(t *testing.T) {
+	for name, newGraph := range implementations() {
+		original := newGraph()
+		original.AddVertex("x")
+		original.AddEdge("a", "b")
+		original.AddEdge("b", "c")
+		if original.Weighted() {
+			original.AddWeightedEdge("c", "a", 0.5)
+		} else {
+			original.AddEdge("c", "a")
+		}
+
+		serialized, err := json.Marshal(original)
+		if err != nil {
+			t.Errorf("[%s] Got error %v", name, err)
+		}
+		deserialized := newGraph()
+		err = json.Unmarshal(serialized, deserialized)
+		if err != nil {
+			t.Errorf("[%s] Got error %v", name, err)
+		}
+		if actualValue, expectedValue := fmt.Sprintf("%v", deserialized.Vertices()), "[x a b c]"; actualValue != expectedValue {
+			t.Errorf("[%s] Got %v expected %v", name, actualValue, expectedValue)
+		}
+		if actualValue, expectedValue := fmt.Sprintf("%v", deserialized.Edges()), fmt.Sprintf("%v", original.Edges()); actualValue != expectedValue {
+			t.Errorf("[%s] Got %v expected %v", name, actualValue, expectedValue)
+		}
+
+		err = deserialized.(interface{ FromJSON([]byte) error }).FromJSON([]byte(`[1,2]`))
+		if err == nil {
+			t.Errorf("[%s] Expected an error", name)
+		}
+	}
+}
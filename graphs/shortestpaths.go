@@ -0,0 +1,108 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package graphs
+
+import "github.com/emirpasic/gods/queues/priorityqueue"
+
+// ShortestPaths holds the shortest paths from a source vertex to all vertices reachable from it.
+type ShortestPaths struct {
+	source    interface{}
+	distances map[interface{}]float64
+	previous  map[interface{}]interface{} // vertex -> vertex before it on its shortest path
+}
+
+// candidate is a vertex waiting in the queue with the length of a path to it
+type candidate struct {
+	vertex   interface{}
+	distance float64
+	order    int // enqueue order, so that equally distant vertices are settled first come first served
+}
+
+// Dijkstra finds the shortest paths from the source vertex to all vertices reachable from it, where the length of a
+// path is the sum of its edge weights, or its number of edges in an unweighted graph.
+// Panics if it comes across an edge with a negative weight.
+//
+// Reference: https://en.wikipedia.org/wiki/Dijkstra%27s_algorithm
+func Dijkstra(graph Graph, source interface{}) *ShortestPaths {
+	paths := &ShortestPaths{
+		source:    source,
+		distances: make(map[interface{}]float64),
+		previous:  make(map[interface{}]interface{}),
+	}
+	if !graph.ContainsVertex(source) {
+		return paths
+	}
+	queue := priorityqueue.NewWith(func(a, b interface{}) int {
+		x, y := a.(candidate), b.(candidate)
+		switch {
+		case x.distance < y.distance:
+			return -1
+		case x.distance > y.distance:
+			return 1
+		}
+		return x.order - y.order
+	})
+	tentative := map[interface{}]float64{source: 0}
+	settled := make(map[interface{}]struct{})
+	queue.Enqueue(candidate{vertex: source})
+	for order := 1; !queue.Empty(); {
+		value, _ := queue.Dequeue()
+		current := value.(candidate)
+		if _, found := settled[current.vertex]; found {
+			continue // outdated candidate, the vertex was reached by a shorter path already
+		}
+		settled[current.vertex] = struct{}{}
+		paths.distances[current.vertex] = current.distance
+		for _, neighbor := range graph.Neighbors(current.vertex) {
+			weight, _ := graph.Weight(current.vertex, neighbor)
+			if weight < 0 {
+				panic("Negative edge weight, Dijkstra requires all weights to be non-negative")
+			}
+			if _, found := settled[neighbor]; found {
+				continue
+			}
+			distance := current.distance + weight
+			if known, found := tentative[neighbor]; found && known <= distance {
+				continue
+			}
+			tentative[neighbor] = distance
+			paths.previous[neighbor] = current.vertex
+			queue.Enqueue(candidate{vertex: neighbor, distance: distance, order: order})
+			order++
+		}
+	}
+	return paths
+}
+
+// Source returns the vertex that all paths start from.
+func (paths *ShortestPaths) Source() interface{} {
+	return paths.source
+}
+
+// Distance returns the length of the shortest path to the vertex.
+// Second return parameter is true if the vertex is reachable from the source, otherwise false.
+func (paths *ShortestPaths) Distance(to interface{}) (distance float64, found bool) {
+	distance, found = paths.distances[to]
+	return
+}
+
+// PathTo returns the vertices of the shortest path to the vertex, from the source to the vertex itself.
+// Second return parameter is true if the vertex is reachable from the source, otherwise false.
+func (paths *ShortestPaths) PathTo(to interface{}) (path []interface{}, found bool) {
+	if _, found = paths.distances[to]; !found {
+		return nil, false
+	}
+	for vertex := to; ; {
+		path = append(path, vertex)
+		if vertex == paths.source {
+			break
+		}
+		vertex = paths.previous[vertex]
+	}
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+	return path, true
+}
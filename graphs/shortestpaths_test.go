@@ -0,0 +1,65 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package graphs_test
+
+import (
+	"fmt"
+	"github.com/emirpasic/gods/graphs"
+	"github.com/emirpasic/gods/graphs/directedgraph"
+	"github.com/emirpasic/gods/graphs/undirectedgraph"
+	"testing"
+)
+
+func TestDijkstra(t *testing.T) {
+	graph := withEdges(directedgraph.NewWeighted(), [][]interface{}{
+		{"a", "b", 7.0}, {"a", "c", 9.0}, {"a", "f", 14.0}, {"b", "c", 10.0}, {"b", "d", 15.0},
+		{"c", "d", 11.0}, {"c", "f", 2.0}, {"d", "e", 6.0}, {"e", "f", 9.0}, {"f", "e", 9.0},
+	})
+	graph.AddVertex("x")
+	paths := graphs.Dijkstra(graph, "a")
+
+	tests := [][]interface{}{
+		{"a", 0.0, "[a]", true},
+		{"b", 7.0, "[a b]", true},
+		{"c", 9.0, "[a c]", true},
+		{"d", 20.0, "[a c d]", true},
+		{"e", 20.0, "[a c f e]", true},
+		{"f", 11.0, "[a c f]", true},
+		{"x", 0.0, "[]", false},
+		{"y", 0.0, "[]", false},
+	}
+	for _, test := range tests {
+		if actualValue, actualFound := paths.Distance(test[0]); actualValue != test[1] || actualFound != test[3] {
+			t.Errorf("Got %v,%v expected %v,%v for %v", actualValue, actualFound, test[1], test[3], test[0])
+		}
+		path, actualFound := paths.PathTo(test[0])
+		if actualValue := fmt.Sprintf("%v", path); actualValue != test[2] || actualFound != test[3] {
+			t.Errorf("Got %v,%v expected %v,%v for %v", actualValue, actualFound, test[2], test[3], test[0])
+		}
+	}
+	if actualValue, expectedValue := paths.Source(), "a"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	// unweighted edges weigh 1, so the shortest paths have the fewest edges
+	undirected := withEdges(undirectedgraph.New(), [][]interface{}{{1, 2}, {2, 3}, {3, 4}, {1, 5}, {5, 4}})
+	path, _ := graphs.Dijkstra(undirected, 4).PathTo(1)
+	if actualValue, expectedValue := fmt.Sprintf("%v", path), "[4 5 1]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	if _, found := graphs.Dijkstra(graph, "y").Distance("y"); found {
+		t.Errorf("Got %v expected %v", found, false)
+	}
+}
+
+func TestDijkstraNegativeWeight(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("Should panic on a negative weight")
+		}
+	}()
+	graphs.Dijkstra(withEdges(directedgraph.NewWeighted(), [][]interface{}{{"a", "b", 1.0}, {"b", "c", -1.0}}), "a")
+}
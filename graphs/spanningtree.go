@@ -0,0 +1,29 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package graphs
+
+import (
+	"github.com/emirpasic/gods/sets/disjointset"
+	"sort"
+)
+
+// MinimumSpanningTree returns the edges of a minimum spanning tree of the graph, i.e. of a set of edges with the
+// lowest total weight that connects all vertices connected in the graph, ordered by weight.
+// If the graph is not connected, it returns a minimum spanning forest, i.e. a tree for every connected component.
+// Directions are ignored, so in a directed graph the edges of the tree might not lead from one root to all vertices.
+//
+// Reference: https://en.wikipedia.org/wiki/Kruskal%27s_algorithm
+func MinimumSpanningTree(graph Graph) []Edge {
+	edges := graph.Edges()
+	sort.SliceStable(edges, func(i, j int) bool { return edges[i].Weight < edges[j].Weight })
+	set := disjointset.New(graph.Vertices()...)
+	tree := make([]Edge, 0, graph.Size())
+	for _, edge := range edges {
+		if set.Union(edge.From, edge.To) {
+			tree = append(tree, edge)
+		}
+	}
+	return tree
+}
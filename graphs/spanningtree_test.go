@@ -0,0 +1,37 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package graphs_test
+
+import (
+	"fmt"
+	"github.com/emirpasic/gods/graphs"
+	"github.com/emirpasic/gods/graphs/directedgraph"
+	"github.com/emirpasic/gods/graphs/undirectedgraph"
+	"testing"
+)
+
+func TestMinimumSpanningTree(t *testing.T) {
+	graph := withEdges(undirectedgraph.NewWeighted(), [][]interface{}{
+		{"a", "b", 7.0}, {"a", "d", 5.0}, {"b", "c", 8.0}, {"b", "d", 9.0}, {"b", "e", 7.0}, {"c", "e", 5.0},
+		{"d", "e", 15.0}, {"d", "f", 6.0}, {"e", "f", 8.0}, {"e", "g", 9.0}, {"f", "g", 11.0},
+	})
+	tree := graphs.MinimumSpanningTree(graph)
+	if actualValue, expectedValue := fmt.Sprintf("%v", tree), "[{a d 5} {c e 5} {d f 6} {a b 7} {b e 7} {e g 9}]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	// a forest for a disconnected graph, self-loops are never part of it
+	graph.AddWeightedEdge("x", "y", 1)
+	graph.AddWeightedEdge("y", "y", 0)
+	graph.AddVertex("z")
+	if actualValue, expectedValue := len(graphs.MinimumSpanningTree(graph)), 7; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	directed := withEdges(directedgraph.New(), [][]interface{}{{1, 2}, {2, 1}, {3, 2}, {1, 3}})
+	if actualValue, expectedValue := fmt.Sprintf("%v", graphs.MinimumSpanningTree(directed)), "[{1 2 1} {1 3 1}]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
@@ -0,0 +1,100 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package graphs
+
+import "github.com/emirpasic/gods/queues/linkedlistqueue"
+
+// TopologicalSort returns the vertices ordered so that every edge leads from an earlier to a later vertex, and true.
+// If the graph has a cycle, there is no such order and it returns the vertices that could be ordered, i.e. those not
+// on or after a cycle, and false. See FindCycle to find out which vertices are on a cycle.
+// Vertices without ordering constraints between them keep their order in the graph.
+// In an undirected graph every edge leads both ways, so there is no order unless the graph has no edges.
+//
+// Reference: https://en.wikipedia.org/wiki/Topological_sorting#Kahn's_algorithm
+func TopologicalSort(graph Graph) (order []interface{}, acyclic bool) {
+	vertices := graph.Vertices()
+	inDegrees := make(map[interface{}]int, len(vertices))
+	for _, vertex := range vertices {
+		for _, neighbor := range graph.Neighbors(vertex) {
+			inDegrees[neighbor]++
+		}
+	}
+	queue := linkedlistqueue.New()
+	for _, vertex := range vertices {
+		if inDegrees[vertex] == 0 {
+			queue.Enqueue(vertex)
+		}
+	}
+	order = make([]interface{}, 0, len(vertices))
+	for !queue.Empty() {
+		vertex, _ := queue.Dequeue()
+		order = append(order, vertex)
+		for _, neighbor := range graph.Neighbors(vertex) {
+			inDegrees[neighbor]--
+			if inDegrees[neighbor] == 0 {
+				queue.Enqueue(neighbor)
+			}
+		}
+	}
+	return order, len(order) == len(vertices)
+}
+
+// FindCycle returns the vertices of a cycle in the graph, in order along the cycle so that the last vertex leads back
+// to the first one, or nil if the graph is acyclic. A self-loop is a cycle of a single vertex.
+// In an undirected graph a cycle does not use the same edge twice, so it has at least three vertices unless it is a
+// self-loop.
+//
+// Reference: https://en.wikipedia.org/wiki/Cycle_(graph_theory)#Cycle_detection
+func FindCycle(graph Graph) []interface{} {
+	type frame struct {
+		vertex    interface{}
+		neighbors []interface{}
+		next      int  // index of the next neighbor to explore
+		parent    bool // whether the edge back to the parent was skipped already
+	}
+	const (
+		unvisited = iota
+		onPath
+		finished
+	)
+	states := make(map[interface{}]int)
+	for _, root := range graph.Vertices() {
+		if states[root] != unvisited {
+			continue
+		}
+		states[root] = onPath
+		path := []*frame{{vertex: root, neighbors: graph.Neighbors(root), parent: true}}
+		for len(path) > 0 {
+			top := path[len(path)-1]
+			if top.next == len(top.neighbors) {
+				states[top.vertex] = finished
+				path = path[:len(path)-1]
+				continue
+			}
+			neighbor := top.neighbors[top.next]
+			top.next++
+			if !graph.Directed() && !top.parent && neighbor == path[len(path)-2].vertex {
+				top.parent = true
+				continue
+			}
+			switch states[neighbor] {
+			case unvisited:
+				states[neighbor] = onPath
+				path = append(path, &frame{vertex: neighbor, neighbors: graph.Neighbors(neighbor)})
+			case onPath:
+				start := len(path) - 1
+				for path[start].vertex != neighbor {
+					start--
+				}
+				cycle := make([]interface{}, 0, len(path)-start)
+				for _, f := range path[start:] {
+					cycle = append(cycle, f.vertex)
+				}
+				return cycle
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,77 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package graphs_test
+
+import (
+	"fmt"
+	"github.com/emirpasic/gods/graphs"
+	"github.com/emirpasic/gods/graphs/directedgraph"
+	"github.com/emirpasic/gods/graphs/undirectedgraph"
+	"testing"
+)
+
+func TestTopologicalSort(t *testing.T) {
+	graph := withEdges(directedgraph.New(), [][]interface{}{
+		{"shirt", "tie"}, {"tie", "jacket"}, {"pants", "shoes"}, {"pants", "belt"}, {"belt", "jacket"},
+		{"shirt", "belt"}, {"socks", "shoes"},
+	})
+	graph.AddVertex("watch")
+
+	order, acyclic := graphs.TopologicalSort(graph)
+	if actualValue, expectedValue := acyclic, true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", order), "[shirt pants socks watch tie belt shoes jacket]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	positions := map[interface{}]int{}
+	for position, vertex := range order {
+		positions[vertex] = position
+	}
+	for _, edge := range graph.Edges() {
+		if positions[edge.From] > positions[edge.To] {
+			t.Errorf("Got %v after %v", edge.From, edge.To)
+		}
+	}
+
+	graph.AddEdge("jacket", "tie") // tie -> jacket -> tie
+	order, acyclic = graphs.TopologicalSort(graph)
+	if actualValue, expectedValue := acyclic, false; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", order), "[shirt pants socks watch belt shoes]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	order, acyclic = graphs.TopologicalSort(directedgraph.New())
+	if len(order) != 0 || !acyclic {
+		t.Errorf("Got %v,%v expected %v,%v", order, acyclic, "[]", true)
+	}
+	order, acyclic = graphs.TopologicalSort(withEdges(undirectedgraph.New(), [][]interface{}{{"a", "b"}}))
+	if len(order) != 0 || acyclic {
+		t.Errorf("Got %v,%v expected %v,%v", order, acyclic, "[]", false)
+	}
+}
+
+func TestFindCycle(t *testing.T) {
+	tests := []struct {
+		graph    graphs.Graph
+		expected string
+	}{
+		{withEdges(directedgraph.New(), [][]interface{}{{"a", "b"}, {"b", "c"}, {"a", "c"}}), "[]"},
+		{withEdges(directedgraph.New(), [][]interface{}{{"a", "b"}, {"b", "c"}, {"c", "d"}, {"d", "b"}}), "[b c d]"},
+		{withEdges(directedgraph.New(), [][]interface{}{{"a", "b"}, {"b", "a"}}), "[a b]"},
+		{withEdges(directedgraph.New(), [][]interface{}{{"a", "b"}, {"b", "b"}}), "[b]"},
+		{withEdges(undirectedgraph.New(), [][]interface{}{{"a", "b"}, {"b", "c"}, {"b", "d"}}), "[]"},
+		{withEdges(undirectedgraph.New(), [][]interface{}{{"a", "b"}, {"b", "c"}, {"c", "d"}, {"d", "b"}}), "[b c d]"},
+		{withEdges(undirectedgraph.New(), [][]interface{}{{"a", "b"}, {"b", "b"}}), "[b]"},
+		{directedgraph.New(), "[]"},
+	}
+	for _, test := range tests {
+		if actualValue := fmt.Sprintf("%v", graphs.FindCycle(test.graph)); actualValue != test.expected {
+			t.Errorf("Got %v expected %v for %v", actualValue, test.expected, test.graph)
+		}
+	}
+}
@@ -0,0 +1,160 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package graphs
+
+import (
+	"github.com/emirpasic/gods/containers"
+	"github.com/emirpasic/gods/queues/linkedlistqueue"
+	"github.com/emirpasic/gods/stacks/arraystack"
+)
+
+// Assert Iterator implementation
+var _ containers.IteratorWithIndex = (*Iterator)(nil)
+
+// Iterator holding the state of a traversal
+type Iterator struct {
+	graph   Graph
+	start   interface{}
+	queue   *linkedlistqueue.Queue // pending vertices of a breadth-first traversal
+	stack   *arraystack.Stack      // pending vertices of a depth-first traversal
+	visited map[interface{}]struct{}
+	vertex  interface{}
+	index   int
+}
+
+// BreadthFirstIterator returns a stateful iterator whose values are the vertices reachable from the start vertex, in
+// breadth-first order, i.e. ordered by their distance in edges from the start vertex.
+// Neighbors are visited in the order returned by the graph. The index of a vertex is its position in the traversal.
+// It does not iterate at all if the start vertex is not in the graph.
+// The graph should not be modified while iterating.
+func BreadthFirstIterator(graph Graph, start interface{}) Iterator {
+	iterator := Iterator{graph: graph, start: start, queue: linkedlistqueue.New()}
+	iterator.Begin()
+	return iterator
+}
+
+// DepthFirstIterator returns a stateful iterator whose values are the vertices reachable from the start vertex, in
+// depth-first pre-order, i.e. every vertex is followed by the vertices first reached through it.
+// Neighbors are visited in the order returned by the graph. The index of a vertex is its position in the traversal.
+// It does not iterate at all if the start vertex is not in the graph.
+// The graph should not be modified while iterating.
+func DepthFirstIterator(graph Graph, start interface{}) Iterator {
+	iterator := Iterator{graph: graph, start: start, stack: arraystack.New()}
+	iterator.Begin()
+	return iterator
+}
+
+// Next moves the iterator to the next vertex and returns true if there was a next vertex in the traversal.
+// If Next() returns true, then next vertex's index and value can be retrieved by Index() and Value().
+// If Next() was called for the first time, then it will point the iterator to the start vertex if it exists.
+// Modifies the state of the iterator.
+func (iterator *Iterator) Next() bool {
+	if iterator.queue != nil {
+		return iterator.nextBreadthFirst()
+	}
+	return iterator.nextDepthFirst()
+}
+
+// Value returns the current vertex.
+// Does not modify the state of the iterator.
+func (iterator *Iterator) Value() interface{} {
+	return iterator.vertex
+}
+
+// Index returns the position of the current vertex in the traversal.
+// Does not modify the state of the iterator.
+func (iterator *Iterator) Index() int {
+	return iterator.index
+}
+
+// Begin resets the iterator to its initial state (one-before-first)
+// Call Next() to fetch the start vertex if any.
+func (iterator *Iterator) Begin() {
+	iterator.visited = make(map[interface{}]struct{})
+	iterator.vertex = nil
+	iterator.index = -1
+	if iterator.queue != nil {
+		iterator.queue.Clear()
+		if iterator.graph.ContainsVertex(iterator.start) {
+			iterator.visited[iterator.start] = struct{}{}
+			iterator.queue.Enqueue(iterator.start)
+		}
+	} else {
+		iterator.stack.Clear()
+		if iterator.graph.ContainsVertex(iterator.start) {
+			iterator.stack.Push(iterator.start)
+		}
+	}
+}
+
+// First moves the iterator to the start vertex and returns true if it is in the graph.
+// If First() returns true, then first vertex's index and value can be retrieved by Index() and Value().
+// Modifies the state of the iterator.
+func (iterator *Iterator) First() bool {
+	iterator.Begin()
+	return iterator.Next()
+}
+
+// NextTo moves the iterator to the next vertex from current position that satisfies the condition given by the
+// passed function, and returns true if there was a next vertex in the traversal.
+// If NextTo() returns true, then next vertex's index and value can be retrieved by Index() and Value().
+// Modifies the state of the iterator.
+func (iterator *Iterator) NextTo(f func(index int, value interface{}) bool) bool {
+	for iterator.Next() {
+		index, value := iterator.Index(), iterator.Value()
+		if f(index, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// nextBreadthFirst visits the oldest pending vertex and marks its neighbors pending, once per vertex
+func (iterator *Iterator) nextBreadthFirst() bool {
+	vertex, ok := iterator.queue.Dequeue()
+	if !ok {
+		return iterator.end()
+	}
+	for _, neighbor := range iterator.graph.Neighbors(vertex) {
+		if _, visited := iterator.visited[neighbor]; !visited {
+			iterator.visited[neighbor] = struct{}{}
+			iterator.queue.Enqueue(neighbor)
+		}
+	}
+	return iterator.visit(vertex)
+}
+
+// nextDepthFirst visits the newest pending vertex that was not visited yet and marks its neighbors pending, the first
+// neighbor last so that it is visited next
+func (iterator *Iterator) nextDepthFirst() bool {
+	for {
+		vertex, ok := iterator.stack.Pop()
+		if !ok {
+			return iterator.end()
+		}
+		if _, visited := iterator.visited[vertex]; visited {
+			continue
+		}
+		iterator.visited[vertex] = struct{}{}
+		neighbors := iterator.graph.Neighbors(vertex)
+		for i := len(neighbors) - 1; i >= 0; i-- {
+			if _, visited := iterator.visited[neighbors[i]]; !visited {
+				iterator.stack.Push(neighbors[i])
+			}
+		}
+		return iterator.visit(vertex)
+	}
+}
+
+func (iterator *Iterator) visit(vertex interface{}) bool {
+	iterator.vertex = vertex
+	iterator.index++
+	return true
+}
+
+func (iterator *Iterator) end() bool {
+	iterator.vertex = nil
+	return false
+}
@@ -0,0 +1,103 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package graphs_test
+
+import (
+	"fmt"
+	"github.com/emirpasic/gods/graphs"
+	"github.com/emirpasic/gods/graphs/directedgraph"
+	"github.com/emirpasic/gods/graphs/undirectedgraph"
+	"testing"
+)
+
+// traverse returns the vertices visited by the iterator, checking that the indexes count up from 0
+func traverse(t *testing.T, it graphs.Iterator) string {
+	values := []interface{}{}
+	for it.Next() {
+		if actualValue, expectedValue := it.Index(), len(values); actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		values = append(values, it.Value())
+	}
+	return fmt.Sprintf("%v", values)
+}
+
+func TestBreadthFirstIterator(t *testing.T) {
+	graph := withEdges(directedgraph.New(), [][]interface{}{
+		{"a", "b"}, {"a", "c"}, {"b", "d"}, {"c", "d"}, {"d", "a"}, {"c", "e"}, {"x", "a"},
+	})
+
+	tests := [][]interface{}{
+		{"a", "[a b c d e]"},
+		{"c", "[c d e a b]"},
+		{"e", "[e]"},
+		{"y", "[]"},
+	}
+	for _, test := range tests {
+		if actualValue := traverse(t, graphs.BreadthFirstIterator(graph, test[0])); actualValue != test[1] {
+			t.Errorf("Got %v expected %v", actualValue, test[1])
+		}
+	}
+
+	undirected := withEdges(undirectedgraph.New(), [][]interface{}{{"a", "b"}, {"b", "c"}, {"a", "d"}})
+	if actualValue, expectedValue := traverse(t, graphs.BreadthFirstIterator(undirected, "b")), "[b a c d]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestDepthFirstIterator(t *testing.T) {
+	graph := withEdges(directedgraph.New(), [][]interface{}{
+		{"a", "b"}, {"a", "c"}, {"b", "d"}, {"c", "d"}, {"d", "a"}, {"c", "e"}, {"x", "a"},
+	})
+
+	tests := [][]interface{}{
+		{"a", "[a b d c e]"},
+		{"c", "[c d a b e]"},
+		{"x", "[x a b d c e]"},
+		{"y", "[]"},
+	}
+	for _, test := range tests {
+		if actualValue := traverse(t, graphs.DepthFirstIterator(graph, test[0])); actualValue != test[1] {
+			t.Errorf("Got %v expected %v", actualValue, test[1])
+		}
+	}
+
+	undirected := withEdges(undirectedgraph.New(), [][]interface{}{{"a", "b"}, {"b", "c"}, {"a", "d"}})
+	if actualValue, expectedValue := traverse(t, graphs.DepthFirstIterator(undirected, "b")), "[b a d c]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestTraversalIteratorBeginAndNextTo(t *testing.T) {
+	graph := withEdges(directedgraph.New(), [][]interface{}{{1, 2}, {2, 3}, {3, 4}})
+	it := graphs.BreadthFirstIterator(graph, 1)
+	for it.Next() {
+	}
+	if actualValue, expectedValue := it.Next(), false; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	it.Begin()
+	if actualValue, expectedValue := it.NextTo(func(index int, value interface{}) bool { return value.(int) > 2 }), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if index, value := it.Index(), it.Value(); index != 2 || value != 3 {
+		t.Errorf("Got %v,%v expected %v,%v", index, value, 2, 3)
+	}
+	if actualValue, expectedValue := it.NextTo(func(index int, value interface{}) bool { return value.(int) > 4 }), false; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	it = graphs.DepthFirstIterator(graph, 2)
+	if actualValue, expectedValue := it.First(), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if index, value := it.Index(), it.Value(); index != 0 || value != 2 {
+		t.Errorf("Got %v,%v expected %v,%v", index, value, 0, 2)
+	}
+	if actualValue, expectedValue := traverse(t, graphs.DepthFirstIterator(graph, 2)), "[2 3 4]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
@@ -0,0 +1,224 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package undirectedgraph implements an undirected graph backed by adjacency lists.
+//
+// Every vertex keeps its edges in a linked hash map, so that vertices and edges are added, removed and looked up in
+// constant time. Every edge is kept by both vertices it connects. Vertices are ordered by insertion and so are the
+// edges of every vertex.
+//
+// Structure is not thread safe.
+//
+// Reference: https://en.wikipedia.org/wiki/Adjacency_list
+package undirectedgraph
+
+import (
+	"fmt"
+	"github.com/emirpasic/gods/graphs"
+	"github.com/emirpasic/gods/maps/linkedhashmap"
+	"strings"
+)
+
+// Assert Graph implementation
+var _ graphs.Graph = (*Graph)(nil)
+
+// Graph holds the edges of every vertex in linked hash maps from the adjacent vertices to the weights
+type Graph struct {
+	vertices *linkedhashmap.Map // vertex -> adjacent vertex -> weight
+	edges    int
+	weighted bool
+}
+
+// New instantiates an unweighted undirected graph, in which all edges weigh 1.
+func New() *Graph {
+	return &Graph{vertices: linkedhashmap.New()}
+}
+
+// NewWeighted instantiates a weighted undirected graph.
+func NewWeighted() *Graph {
+	return &Graph{vertices: linkedhashmap.New(), weighted: true}
+}
+
+// AddVertex adds the vertices (one or more) to the graph.
+// Vertices that are already present are ignored.
+func (graph *Graph) AddVertex(vertices ...interface{}) {
+	for _, vertex := range vertices {
+		graph.adjacency(vertex)
+	}
+}
+
+// RemoveVertex removes the vertex from the graph together with all its edges.
+func (graph *Graph) RemoveVertex(vertex interface{}) {
+	a, found := graph.lookup(vertex)
+	if !found {
+		return
+	}
+	for _, neighbor := range a.Keys() {
+		graph.RemoveEdge(vertex, neighbor)
+	}
+	graph.vertices.Remove(vertex)
+}
+
+// ContainsVertex returns true if the vertex is in the graph.
+func (graph *Graph) ContainsVertex(vertex interface{}) bool {
+	_, found := graph.vertices.Get(vertex)
+	return found
+}
+
+// AddEdge adds the edge between the vertices with weight 1, or sets the weight of an existing edge to 1.
+// Vertices that are not present are added first.
+func (graph *Graph) AddEdge(from interface{}, to interface{}) {
+	graph.putEdge(from, to, 1)
+}
+
+// AddWeightedEdge adds the edge between the vertices with the weight, or sets the weight of an existing edge.
+// Vertices that are not present are added first.
+// Unweighted graphs do not support weights, so the method panics on them.
+func (graph *Graph) AddWeightedEdge(from interface{}, to interface{}, weight float64) {
+	if !graph.weighted {
+		panic("Unweighted graph does not support weighted edges, use NewWeighted")
+	}
+	graph.putEdge(from, to, weight)
+}
+
+// RemoveEdge removes the edge between the vertices, if any.
+// The vertices are kept.
+func (graph *Graph) RemoveEdge(from interface{}, to interface{}) {
+	if !graph.ContainsEdge(from, to) {
+		return
+	}
+	graph.adjacency(from).Remove(to)
+	graph.adjacency(to).Remove(from)
+	graph.edges--
+}
+
+// ContainsEdge returns true if there is an edge between the vertices.
+func (graph *Graph) ContainsEdge(from interface{}, to interface{}) bool {
+	_, found := graph.Weight(from, to)
+	return found
+}
+
+// Weight returns the weight of the edge between the vertices, or 0 if there is no such edge.
+// Second return parameter is true if the edge was found, otherwise false.
+func (graph *Graph) Weight(from interface{}, to interface{}) (weight float64, found bool) {
+	if a, found := graph.lookup(from); found {
+		if weight, found := a.Get(to); found {
+			return weight.(float64), true
+		}
+	}
+	return 0, false
+}
+
+// Neighbors returns the vertices adjacent to the vertex, in order of the insertion of the edges.
+func (graph *Graph) Neighbors(vertex interface{}) []interface{} {
+	if a, found := graph.lookup(vertex); found {
+		return a.Keys()
+	}
+	return []interface{}{}
+}
+
+// Degree returns the number of edges of the vertex, where a loop counts once.
+func (graph *Graph) Degree(vertex interface{}) int {
+	if a, found := graph.lookup(vertex); found {
+		return a.Size()
+	}
+	return 0
+}
+
+// Vertices returns all vertices in insertion order.
+func (graph *Graph) Vertices() []interface{} {
+	return graph.vertices.Keys()
+}
+
+// Edges returns every edge once, ordered by the vertex that was inserted first and then by insertion.
+// From is the vertex that was inserted first.
+func (graph *Graph) Edges() []graphs.Edge {
+	edges := make([]graphs.Edge, 0, graph.edges)
+	visited := make(map[interface{}]struct{}, graph.vertices.Size())
+	vertices := graph.vertices.Iterator()
+	for vertices.Next() {
+		neighbors := vertices.Value().(*linkedhashmap.Map).Iterator()
+		for neighbors.Next() {
+			if _, found := visited[neighbors.Key()]; !found {
+				edges = append(edges, graphs.Edge{From: vertices.Key(), To: neighbors.Key(), Weight: neighbors.Value().(float64)})
+			}
+		}
+		visited[vertices.Key()] = struct{}{}
+	}
+	return edges
+}
+
+// EdgeCount returns the number of edges.
+func (graph *Graph) EdgeCount() int {
+	return graph.edges
+}
+
+// Directed returns false.
+func (graph *Graph) Directed() bool {
+	return false
+}
+
+// Weighted returns true if the graph was instantiated by NewWeighted.
+func (graph *Graph) Weighted() bool {
+	return graph.weighted
+}
+
+// Empty returns true if graph does not contain any vertices.
+func (graph *Graph) Empty() bool {
+	return graph.vertices.Empty()
+}
+
+// Size returns the number of vertices.
+func (graph *Graph) Size() int {
+	return graph.vertices.Size()
+}
+
+// Clear removes all vertices and edges.
+func (graph *Graph) Clear() {
+	graph.vertices.Clear()
+	graph.edges = 0
+}
+
+// Values returns all vertices in insertion order.
+func (graph *Graph) Values() []interface{} {
+	return graph.Vertices()
+}
+
+// String returns a string representation of container
+func (graph *Graph) String() string {
+	str := "UndirectedGraph\n"
+	items := []string{}
+	vertices := graph.vertices.Iterator()
+	for vertices.Next() {
+		items = append(items, fmt.Sprintf("%v-%v", vertices.Key(), vertices.Value().(*linkedhashmap.Map).Keys()))
+	}
+	str += strings.Join(items, ", ")
+	return str
+}
+
+func (graph *Graph) putEdge(from interface{}, to interface{}, weight float64) {
+	if !graph.ContainsEdge(from, to) {
+		graph.edges++
+	}
+	graph.adjacency(from).Put(to, weight)
+	graph.adjacency(to).Put(from, weight)
+}
+
+// lookup returns the edges of the vertex, if it is present
+func (graph *Graph) lookup(vertex interface{}) (*linkedhashmap.Map, bool) {
+	if a, found := graph.vertices.Get(vertex); found {
+		return a.(*linkedhashmap.Map), true
+	}
+	return nil, false
+}
+
+// adjacency returns the edges of the vertex, adding the vertex if it is not present
+func (graph *Graph) adjacency(vertex interface{}) *linkedhashmap.Map {
+	a, found := graph.lookup(vertex)
+	if !found {
+		a = linkedhashmap.New()
+		graph.vertices.Put(vertex, a)
+	}
+	return a
+}
@@ -0,0 +1,211 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package undirectedgraph
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestGraphDegree(t *testing.T) {
+	graph := New()
+	graph.AddEdge("a", "b")
+	graph.AddEdge("c", "b")
+	graph.AddEdge("b", "d")
+	graph.AddEdge("b", "b")
+	graph.AddEdge("b", "a") // same as a-b
+
+	tests := [][]interface{}{
+		{"a", 1},
+		{"b", 4},
+		{"c", 1},
+		{"d", 1},
+		{"x", 0},
+	}
+	for _, test := range tests {
+		if actualValue := graph.Degree(test[0]); actualValue != test[1] {
+			t.Errorf("Got %v expected %v for %v", actualValue, test[1], test[0])
+		}
+	}
+	if actualValue, expectedValue := graph.EdgeCount(), 4; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", graph.Edges()), "[{a b 1} {b c 1} {b d 1} {b b 1}]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	graph.RemoveEdge("d", "b")
+	graph.RemoveVertex("a")
+	if actualValue, expectedValue := graph.Degree("b"), 2; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := graph.EdgeCount(), 2; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestGraphNeighborIterator(t *testing.T) {
+	graph := NewWeighted()
+	it := graph.NeighborIterator("a")
+	for it.Next() {
+		t.Errorf("Shouldn't iterate on missing vertex")
+	}
+
+	graph.AddWeightedEdge("a", "c", 3)
+	graph.AddWeightedEdge("b", "a", 2)
+
+	it = graph.NeighborIterator("a")
+	items := []string{}
+	for it.Next() {
+		items = append(items, fmt.Sprintf("%v:%v", it.Key(), it.Value()))
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", items), "[c:3 b:2]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	items = []string{}
+	for it.Prev() {
+		items = append(items, fmt.Sprintf("%v:%v", it.Key(), it.Value()))
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", items), "[b:2 c:3]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := it.First(), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := it.Key(), "c"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	it.End()
+	if actualValue, expectedValue := it.PrevTo(func(key interface{}, value interface{}) bool { return value.(float64) > 2 }), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := it.Key(), "c"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestGraphSerialization(t *testing.T) {
+	graph := NewWeighted()
+	graph.AddVertex("x")
+	graph.AddWeightedEdge("a", "b", 2)
+	graph.AddEdge("b", "b")
+	serialized, err := json.Marshal(graph)
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := string(serialized), `{"vertices":["x","a","b"],"edges":[{"from":"a","to":"b","weight":2},{"from":"b","to":"b","weight":1}]}`; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	unweighted := New()
+	err = unweighted.FromJSON(serialized)
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	serialized, err = unweighted.ToJSON()
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := string(serialized), `{"vertices":["x","a","b"],"edges":[{"from":"a","to":"b"},{"from":"b","to":"b"}]}`; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestGraphString(t *testing.T) {
+	graph := New()
+	graph.AddEdge("a", "b")
+	if !strings.HasPrefix(graph.String(), "UndirectedGraph") {
+		t.Errorf("String should start with container name")
+	}
+	if actualValue, expectedValue := graph.String(), "UndirectedGraph\na-[b], b-[a]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func benchmarkContainsEdge(b *testing.B, graph *Graph, size int) {
+	for i := 0; i < b.N; i++ {
+		for n := 0; n < size; n++ {
+			graph.ContainsEdge(n+1, n)
+		}
+	}
+}
+
+func benchmarkAddEdge(b *testing.B, graph *Graph, size int) {
+	for i := 0; i < b.N; i++ {
+		for n := 0; n < size; n++ {
+			graph.AddEdge(n, n+1)
+		}
+	}
+}
+
+func benchmarkRemoveVertex(b *testing.B, graph *Graph, size int) {
+	for i := 0; i < b.N; i++ {
+		for n := 0; n < size; n++ {
+			graph.RemoveVertex(n)
+		}
+	}
+}
+
+func BenchmarkUndirectedGraphContainsEdge100(b *testing.B) {
+	b.StopTimer()
+	size := 100
+	graph := New()
+	for n := 0; n < size; n++ {
+		graph.AddEdge(n, n+1)
+	}
+	b.StartTimer()
+	benchmarkContainsEdge(b, graph, size)
+}
+
+func BenchmarkUndirectedGraphContainsEdge10000(b *testing.B) {
+	b.StopTimer()
+	size := 10000
+	graph := New()
+	for n := 0; n < size; n++ {
+		graph.AddEdge(n, n+1)
+	}
+	b.StartTimer()
+	benchmarkContainsEdge(b, graph, size)
+}
+
+func BenchmarkUndirectedGraphAddEdge100(b *testing.B) {
+	b.StopTimer()
+	size := 100
+	graph := New()
+	b.StartTimer()
+	benchmarkAddEdge(b, graph, size)
+}
+
+func BenchmarkUndirectedGraphAddEdge10000(b *testing.B) {
+	b.StopTimer()
+	size := 10000
+	graph := New()
+	b.StartTimer()
+	benchmarkAddEdge(b, graph, size)
+}
+
+func BenchmarkUndirectedGraphRemoveVertex100(b *testing.B) {
+	b.StopTimer()
+	size := 100
+	graph := New()
+	for n := 0; n < size; n++ {
+		graph.AddEdge(n, n+1)
+	}
+	b.StartTimer()
+	benchmarkRemoveVertex(b, graph, size)
+}
+
+func BenchmarkUndirectedGraphRemoveVertex10000(b *testing.B) {
+	b.StopTimer()
+	size := 10000
+	graph := New()
+	for n := 0; n < size; n++ {
+		graph.AddEdge(n, n+1)
+	}
+	b.StartTimer()
+	benchmarkRemoveVertex(b, graph, size)
+}
@@ -7,6 +7,7 @@ package arraylist
 import (
 	"encoding/json"
 	"fmt"
+	"github.com/emirpasic/gods/containers"
 	"github.com/emirpasic/gods/utils"
 	"strings"
 	"testing"
@@ -257,7 +258,7 @@ func TestListMap(t *testing.T) {
 	list.Add("a", "b", "c")
 	mappedList := list.Map(func(index int, value interface{}) interface{} {
 		return "mapped: " + value.(string)
-	})
+	}).(*List)
 	if actualValue, _ := mappedList.Get(0); actualValue != "mapped: a" {
 		t.Errorf("Got %v expected %v", actualValue, "mapped: a")
 	}
@@ -277,7 +278,7 @@ func TestListSelect(t *testing.T) {
 	list.Add("a", "b", "c")
 	selectedList := list.Select(func(index int, value interface{}) bool {
 		return value.(string) >= "a" && value.(string) <= "b"
-	})
+	}).(*List)
 	if actualValue, _ := selectedList.Get(0); actualValue != "a" {
 		t.Errorf("Got %v expected %v", actualValue, "value: a")
 	}
@@ -337,6 +338,22 @@ func TestListFind(t *testing.T) {
 		t.Errorf("Got %v at %v expected %v at %v", foundValue, foundIndex, nil, nil)
 	}
 }
+
+func TestListEnumerableInterface(t *testing.T) {
+	var enumerable containers.EnumerableContainerWithIndex = New("a", "b", "c")
+	result := enumerable.Select(func(index int, value interface{}) bool {
+		return value.(string) > "a"
+	}).Map(func(index int, value interface{}) interface{} {
+		return value.(string) + value.(string)
+	})
+	if _, ok := result.(*List); !ok {
+		t.Errorf("Got %T expected %T", result, &List{})
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%s%s", result.Values()...), "bbcc"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
 func TestListChaining(t *testing.T) {
 	list := New()
 	list.Add("a", "b", "c")
@@ -344,7 +361,7 @@ func TestListChaining(t *testing.T) {
 		return value.(string) > "a"
 	}).Map(func(index int, value interface{}) interface{} {
 		return value.(string) + value.(string)
-	})
+	}).(*List)
 	if chainedList.Size() != 2 {
 		t.Errorf("Got %v expected %v", chainedList.Size(), 2)
 	}
@@ -19,7 +19,7 @@ func (list *List) Each(f func(index int, value interface{})) {
 
 // Map invokes the given function once for each element and returns a
 // container containing the values returned by the given function.
-func (list *List) Map(f func(index int, value interface{}) interface{}) *List {
+func (list *List) Map(f func(index int, value interface{}) interface{}) containers.EnumerableContainerWithIndex {
 	newList := &List{}
 	iterator := list.Iterator()
 	for iterator.Next() {
@@ -29,7 +29,7 @@ func (list *List) Map(f func(index int, value interface{}) interface{}) *List {
 }
 
 // Select returns a new container containing all elements for which the given function returns a true value.
-func (list *List) Select(f func(index int, value interface{}) bool) *List {
+func (list *List) Select(f func(index int, value interface{}) bool) containers.EnumerableContainerWithIndex {
 	newList := &List{}
 	iterator := list.Iterator()
 	for iterator.Next() {
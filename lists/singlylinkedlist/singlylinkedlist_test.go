@@ -277,7 +277,7 @@ func TestListMap(t *testing.T) {
 	list.Add("a", "b", "c")
 	mappedList := list.Map(func(index int, value interface{}) interface{} {
 		return "mapped: " + value.(string)
-	})
+	}).(*List)
 	if actualValue, _ := mappedList.Get(0); actualValue != "mapped: a" {
 		t.Errorf("Got %v expected %v", actualValue, "mapped: a")
 	}
@@ -297,7 +297,7 @@ func TestListSelect(t *testing.T) {
 	list.Add("a", "b", "c")
 	selectedList := list.Select(func(index int, value interface{}) bool {
 		return value.(string) >= "a" && value.(string) <= "b"
-	})
+	}).(*List)
 	if actualValue, _ := selectedList.Get(0); actualValue != "a" {
 		t.Errorf("Got %v expected %v", actualValue, "value: a")
 	}
@@ -364,7 +364,7 @@ func TestListChaining(t *testing.T) {
 		return value.(string) > "a"
 	}).Map(func(index int, value interface{}) interface{} {
 		return value.(string) + value.(string)
-	})
+	}).(*List)
 	if chainedList.Size() != 2 {
 		t.Errorf("Got %v expected %v", chainedList.Size(), 2)
 	}
@@ -0,0 +1,355 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package concurrenthashmap implements a map backed by hash tables that is safe for concurrent use.
+//
+// Keys are partitioned by their hashes across a fixed number of shards, each of which is a hash table guarded by a
+// read-write lock of its own, so that goroutines working on keys of different shards do not contend for a lock.
+// Operations on a single key are atomic, including the compound ones like PutIfAbsent, Compute and CompareAndSwap.
+//
+// Operations on the whole map, like Size, Keys and iteration, visit the shards one at a time and are weakly
+// consistent: they never fail or block writers for longer than it takes to copy one shard, but they might not reflect
+// modifications made while they run.
+//
+// Elements are unordered in the map.
+//
+// Structure is thread safe.
+//
+// Reference: https://en.wikipedia.org/wiki/Lock_(computer_science)#Granularity
+package concurrenthashmap
+
+import (
+	"fmt"
+	"github.com/emirpasic/gods/maps"
+	"math"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// Assert Map implementation
+var _ maps.Map = (*Map)(nil)
+
+// defaultShards is the number of shards of a map instantiated by New
+const defaultShards = 32
+
+// Map holds the elements in shards, each of which is a go's native map guarded by a lock
+type Map struct {
+	shards []*shard
+}
+
+type shard struct {
+	sync.RWMutex
+	items map[interface{}]interface{}
+}
+
+// New instantiates a concurrent hash map with the default number of shards.
+func New() *Map {
+	return NewWith(defaultShards)
+}
+
+// NewWith instantiates a concurrent hash map with the given number of shards.
+// More shards reduce contention between goroutines, at the expense of memory and of operations on the whole map.
+func NewWith(shards int) *Map {
+	if shards < 1 {
+		panic("Invalid number of shards, should be at least 1")
+	}
+	m := &Map{shards: make([]*shard, shards)}
+	for i := range m.shards {
+		m.shards[i] = &shard{items: make(map[interface{}]interface{})}
+	}
+	return m
+}
+
+// Put inserts element into the map.
+func (m *Map) Put(key interface{}, value interface{}) {
+	s := m.shard(key)
+	s.Lock()
+	defer s.Unlock()
+	s.items[key] = value
+}
+
+// Get searches the element in the map by key and returns its value or nil if key is not found in map.
+// Second return parameter is true if key was found, otherwise false.
+func (m *Map) Get(key interface{}) (value interface{}, found bool) {
+	s := m.shard(key)
+	s.RLock()
+	defer s.RUnlock()
+	value, found = s.items[key]
+	return
+}
+
+// Remove removes the element from the map by key.
+func (m *Map) Remove(key interface{}) {
+	s := m.shard(key)
+	s.Lock()
+	defer s.Unlock()
+	delete(s.items, key)
+}
+
+// PutIfAbsent inserts element into the map only if the key is not found in map.
+// Returns the value that the key is associated with after the call, i.e. the found value or the inserted one.
+// Second return parameter is true if key was found, otherwise false.
+func (m *Map) PutIfAbsent(key interface{}, value interface{}) (actual interface{}, found bool) {
+	s := m.shard(key)
+	s.Lock()
+	defer s.Unlock()
+	if actual, found = s.items[key]; found {
+		return actual, true
+	}
+	s.items[key] = value
+	return value, false
+}
+
+// ComputeIfAbsent inserts the value computed by f into the map only if the key is not found in map, and returns the
+// value that the key is associated with after the call, i.e. the found value or the computed one.
+// The function is called at most once and only if the key is not found. It is called while the shard of the key is
+// locked, so it must not use the map.
+func (m *Map) ComputeIfAbsent(key interface{}, f func(key interface{}) interface{}) interface{} {
+	s := m.shard(key)
+	s.Lock()
+	defer s.Unlock()
+	if value, found := s.items[key]; found {
+		return value
+	}
+	value := f(key)
+	s.items[key] = value
+	return value
+}
+
+// Compute replaces the element of the key by the result of f, which is called with the current value of the key and
+// whether it was found. If f returns false as its second return parameter, the element is removed instead.
+// Returns the value that the key is associated with after the call and true, or nil and false if it was removed.
+// The function is called while the shard of the key is locked, so it must not use the map.
+func (m *Map) Compute(key interface{}, f func(value interface{}, found bool) (interface{}, bool)) (value interface{}, found bool) {
+	s := m.shard(key)
+	s.Lock()
+	defer s.Unlock()
+	value, found = s.items[key]
+	if value, found = f(value, found); found {
+		s.items[key] = value
+		return value, true
+	}
+	delete(s.items, key)
+	return nil, false
+}
+
+// CompareAndSwap replaces the value of the key by the new value only if the key is found in map and its value is
+// equal to the old value, and returns true if it replaced the value.
+// The old value must be of a comparable type.
+func (m *Map) CompareAndSwap(key interface{}, old interface{}, new interface{}) bool {
+	s := m.shard(key)
+	s.Lock()
+	defer s.Unlock()
+	if value, found := s.items[key]; !found || value != old {
+		return false
+	}
+	s.items[key] = new
+	return true
+}
+
+// Empty returns true if map does not contain any elements
+func (m *Map) Empty() bool {
+	return m.Size() == 0
+}
+
+// Size returns number of elements in the map.
+// Weakly consistent, see package description.
+func (m *Map) Size() int {
+	size := 0
+	for _, s := range m.shards {
+		s.RLock()
+		size += len(s.items)
+		s.RUnlock()
+	}
+	return size
+}
+
+// Keys returns all keys (random order).
+// Weakly consistent, see package description.
+func (m *Map) Keys() []interface{} {
+	keys := make([]interface{}, 0, m.Size())
+	for _, s := range m.shards {
+		s.RLock()
+		for key := range s.items {
+			keys = append(keys, key)
+		}
+		s.RUnlock()
+	}
+	return keys
+}
+
+// Values returns all values (random order).
+// Weakly consistent, see package description.
+func (m *Map) Values() []interface{} {
+	values := make([]interface{}, 0, m.Size())
+	for _, s := range m.shards {
+		s.RLock()
+		for _, value := range s.items {
+			values = append(values, value)
+		}
+		s.RUnlock()
+	}
+	return values
+}
+
+// Clear removes all elements from the map.
+// The shards are cleared one at a time, so elements put while clearing might remain.
+func (m *Map) Clear() {
+	for _, s := range m.shards {
+		s.Lock()
+		s.items = make(map[interface{}]interface{})
+		s.Unlock()
+	}
+}
+
+// String returns a string representation of container
+func (m *Map) String() string {
+	str := "ConcurrentHashMap\nmap["
+	it := m.Iterator()
+	for it.Next() {
+		str += fmt.Sprintf("%v:%v ", it.Key(), it.Value())
+	}
+	return strings.TrimRight(str, " ") + "]"
+}
+
+// shard returns the shard that the key belongs to
+func (m *Map) shard(key interface{}) *shard {
+	return m.shards[hash(key)%uint64(len(m.shards))]
+}
+
+// snapshot returns copies of the keys and values of the shard
+func (s *shard) snapshot() (keys []interface{}, values []interface{}) {
+	s.RLock()
+	defer s.RUnlock()
+	keys = make([]interface{}, 0, len(s.items))
+	values = make([]interface{}, 0, len(s.items))
+	for key, value := range s.items {
+		keys = append(keys, key)
+		values = append(values, value)
+	}
+	return keys, values
+}
+
+// hash returns the hash of the key, such that equal keys have equal hashes.
+// Strings, numbers and booleans of the builtin types are hashed directly, all other keys by reflection.
+func hash(key interface{}) uint64 {
+	switch k := key.(type) {
+	case string:
+		return hashString(k)
+	case int:
+		return mix(uint64(k))
+	case int8:
+		return mix(uint64(k))
+	case int16:
+		return mix(uint64(k))
+	case int32:
+		return mix(uint64(k))
+	case int64:
+		return mix(uint64(k))
+	case uint:
+		return mix(uint64(k))
+	case uint8:
+		return mix(uint64(k))
+	case uint16:
+		return mix(uint64(k))
+	case uint32:
+		return mix(uint64(k))
+	case uint64:
+		return mix(k)
+	case uintptr:
+		return mix(uint64(k))
+	case float32:
+		return hashFloat(float64(k))
+	case float64:
+		return hashFloat(k)
+	case bool:
+		if k {
+			return mix(1)
+		}
+		return mix(0)
+	default:
+		return hashValue(reflect.ValueOf(key))
+	}
+}
+
+// hashValue returns the hash of the value consistently with ==, i.e. by content for strings, numbers and booleans,
+// by identity for pointers, channels and functions, and by their elements for arrays, structs and interfaces.
+func hashValue(v reflect.Value) uint64 {
+	switch v.Kind() {
+	case reflect.String:
+		return hashString(v.String())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return mix(uint64(v.Int()))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return mix(v.Uint())
+	case reflect.Float32, reflect.Float64:
+		return hashFloat(v.Float())
+	case reflect.Complex64, reflect.Complex128:
+		c := v.Complex()
+		return combine(hashFloat(real(c)), hashFloat(imag(c)))
+	case reflect.Bool:
+		if v.Bool() {
+			return mix(1)
+		}
+		return mix(0)
+	case reflect.Ptr, reflect.Chan, reflect.Func, reflect.UnsafePointer:
+		return mix(uint64(v.Pointer()))
+	case reflect.Interface:
+		if v.IsNil() {
+			return 0
+		}
+		return hashValue(v.Elem())
+	case reflect.Array:
+		h := uint64(0)
+		for i := 0; i < v.Len(); i++ {
+			h = combine(h, hashValue(v.Index(i)))
+		}
+		return h
+	case reflect.Struct:
+		h := uint64(0)
+		for i := 0; i < v.NumField(); i++ {
+			// blank fields are ignored by ==
+			if v.Type().Field(i).Name != "_" {
+				h = combine(h, hashValue(v.Field(i)))
+			}
+		}
+		return h
+	default:
+		// nil, slices and maps, which cannot be keys of go's native map anyway
+		return 0
+	}
+}
+
+// hashString returns the FNV-1a hash of the string
+func hashString(s string) uint64 {
+	h := uint64(14695981039346656037)
+	for i := 0; i < len(s); i++ {
+		h ^= uint64(s[i])
+		h *= 1099511628211
+	}
+	return h
+}
+
+func hashFloat(f float64) uint64 {
+	if f == 0 {
+		f = 0 // negative zero equals positive zero
+	}
+	return mix(math.Float64bits(f))
+}
+
+// combine returns the hash of a sequence from the hash of its prefix and the hash of its next element
+func combine(h uint64, x uint64) uint64 {
+	return mix(h*31 + x)
+}
+
+// mix spreads the bits of the integer over the whole hash, so that consecutive integers fall into different shards
+func mix(x uint64) uint64 {
+	x ^= x >> 30
+	x *= 0xbf58476d1ce4e5b9
+	x ^= x >> 27
+	x *= 0x94d049bb133111eb
+	x ^= x >> 31
+	return x
+}
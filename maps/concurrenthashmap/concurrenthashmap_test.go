@@ -0,0 +1,533 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package concurrenthashmap
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/emirpasic/gods/concurrent"
+	"github.com/emirpasic/gods/maps"
+	"github.com/emirpasic/gods/maps/hashmap"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+// parallel runs f concurrently in as many goroutines as there are workers and waits for all of them
+func parallel(workers int, f func(worker int)) {
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func(worker int) {
+			defer wg.Done()
+			f(worker)
+		}(w)
+	}
+	wg.Wait()
+}
+
+func TestMapPut(t *testing.T) {
+	m := NewWith(4)
+	m.Put(5, "e")
+	m.Put(6, "f")
+	m.Put(7, "g")
+	m.Put(3, "c")
+	m.Put(4, "d")
+	m.Put(1, "x")
+	m.Put(2, "b")
+	m.Put(1, "a") //overwrite
+
+	if actualValue := m.Size(); actualValue != 7 {
+		t.Errorf("Got %v expected %v", actualValue, 7)
+	}
+	if actualValue, expectedValue := sortedStrings(m.Keys()), "[1 2 3 4 5 6 7]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := sortedStrings(m.Values()), "[a b c d e f g]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	tests := [][]interface{}{
+		{1, "a", true},
+		{2, "b", true},
+		{7, "g", true},
+		{8, nil, false},
+		{"1", nil, false},
+	}
+	for _, test := range tests {
+		actualValue, actualFound := m.Get(test[0])
+		if actualValue != test[1] || actualFound != test[2] {
+			t.Errorf("Got %v expected %v", actualValue, test[1])
+		}
+	}
+
+	m.Remove(1)
+	m.Remove(8) // ignored
+	if actualValue, expectedValue := m.Size(), 6; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	m.Clear()
+	if actualValue, expectedValue := m.Empty(), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestMapKeyTypes(t *testing.T) {
+	type point struct{ x, y int }
+	m := New()
+	keys := []interface{}{"a", 1, int8(1), int16(1), int32(1), int64(1), uint(1), uint8(1), uint16(1), uint32(1),
+		uint64(1), uintptr(1), float32(1.5), 1.5, true, false, point{1, 2}, [2]string{"a", "b"}}
+	for i, key := range keys {
+		m.Put(key, i)
+	}
+	for i, key := range keys {
+		if actualValue, actualFound := m.Get(key); actualValue != i || !actualFound {
+			t.Errorf("Got %v,%v expected %v,%v for %#v", actualValue, actualFound, i, true, key)
+		}
+	}
+	if actualValue, expectedValue := m.Size(), len(keys); actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, _ := m.Get(point{1, 2}); actualValue != 16 {
+		t.Errorf("Got %v expected %v", actualValue, 16)
+	}
+
+	m.Put(0.0, "zero")
+	if actualValue, actualFound := m.Get(math.Copysign(0, -1)); actualValue != "zero" || !actualFound {
+		t.Errorf("Got %v,%v expected %v,%v", actualValue, actualFound, "zero", true)
+	}
+}
+
+func TestMapIdentityKeys(t *testing.T) {
+	type point struct{ x, y int }
+	m := New()
+	key := &point{1, 2}
+	m.Put(key, "a")
+	// pointers are hashed by identity, not by what they point to
+	for n := 0; n < 100; n++ {
+		key.x = n
+		if actualValue, actualFound := m.Get(key); actualValue != "a" || !actualFound {
+			t.Fatalf("Got %v,%v expected %v,%v", actualValue, actualFound, "a", true)
+		}
+	}
+	m.Put(key, "b")
+	if actualValue, expectedValue := m.Size(), 1; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, actualFound := m.Get(&point{99, 2}); actualValue != nil || actualFound {
+		t.Errorf("Got %v,%v expected %v,%v", actualValue, actualFound, nil, false)
+	}
+
+	type named int
+	type composite struct {
+		name    named
+		pointer *point
+		any     interface{}
+	}
+	ch := make(chan int)
+	m.Put(ch, "c")
+	m.Put(composite{1, key, "x"}, "d")
+	key.y = 3
+	if actualValue, _ := m.Get(ch); actualValue != "c" {
+		t.Errorf("Got %v expected %v", actualValue, "c")
+	}
+	if actualValue, _ := m.Get(composite{1, key, "x"}); actualValue != "d" {
+		t.Errorf("Got %v expected %v", actualValue, "d")
+	}
+	if actualValue, expectedValue := m.Size(), 3; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestMapAtomics(t *testing.T) {
+	m := New()
+	if actualValue, actualFound := m.PutIfAbsent("a", 1); actualValue != 1 || actualFound {
+		t.Errorf("Got %v,%v expected %v,%v", actualValue, actualFound, 1, false)
+	}
+	if actualValue, actualFound := m.PutIfAbsent("a", 2); actualValue != 1 || !actualFound {
+		t.Errorf("Got %v,%v expected %v,%v", actualValue, actualFound, 1, true)
+	}
+
+	calls := 0
+	compute := func(key interface{}) interface{} {
+		calls++
+		return key.(string) + key.(string)
+	}
+	if actualValue := m.ComputeIfAbsent("b", compute); actualValue != "bb" {
+		t.Errorf("Got %v expected %v", actualValue, "bb")
+	}
+	if actualValue := m.ComputeIfAbsent("b", compute); actualValue != "bb" {
+		t.Errorf("Got %v expected %v", actualValue, "bb")
+	}
+	if actualValue := m.ComputeIfAbsent("a", compute); actualValue != 1 {
+		t.Errorf("Got %v expected %v", actualValue, 1)
+	}
+	if actualValue, expectedValue := calls, 1; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	increment := func(value interface{}, found bool) (interface{}, bool) {
+		if !found {
+			return 1, true
+		}
+		return value.(int) + 1, true
+	}
+	if actualValue, actualFound := m.Compute("a", increment); actualValue != 2 || !actualFound {
+		t.Errorf("Got %v,%v expected %v,%v", actualValue, actualFound, 2, true)
+	}
+	if actualValue, actualFound := m.Compute("c", increment); actualValue != 1 || !actualFound {
+		t.Errorf("Got %v,%v expected %v,%v", actualValue, actualFound, 1, true)
+	}
+	remove := func(value interface{}, found bool) (interface{}, bool) { return nil, false }
+	if actualValue, actualFound := m.Compute("c", remove); actualValue != nil || actualFound {
+		t.Errorf("Got %v,%v expected %v,%v", actualValue, actualFound, nil, false)
+	}
+	if _, actualFound := m.Get("c"); actualFound {
+		t.Errorf("Got %v expected %v", actualFound, false)
+	}
+
+	if actualValue, expectedValue := m.CompareAndSwap("a", 2, 3), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := m.CompareAndSwap("a", 2, 4), false; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := m.CompareAndSwap("x", nil, 4), false; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, _ := m.Get("a"); actualValue != 3 {
+		t.Errorf("Got %v expected %v", actualValue, 3)
+	}
+}
+
+func TestMapConcurrentUse(t *testing.T) {
+	m := New()
+	workers, size := 8, 1000
+	parallel(workers, func(worker int) {
+		for n := 0; n < size; n++ {
+			m.Put(fmt.Sprintf("%d-%d", worker, n), n)
+			m.Get(fmt.Sprintf("%d-%d", (worker+1)%workers, n))
+			m.Compute("counter", func(value interface{}, found bool) (interface{}, bool) {
+				if !found {
+					return 1, true
+				}
+				return value.(int) + 1, true
+			})
+			m.PutIfAbsent(n, worker)
+			if n%10 == 0 {
+				m.Size()
+				m.Remove(fmt.Sprintf("%d-%d", worker, n))
+			}
+		}
+	})
+	if actualValue, _ := m.Get("counter"); actualValue != workers*size {
+		t.Errorf("Got %v expected %v", actualValue, workers*size)
+	}
+	if actualValue, expectedValue := m.Size(), workers*size*9/10+size+1; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestMapCompareAndSwapConcurrentUse(t *testing.T) {
+	m := New()
+	m.Put("counter", 0)
+	workers, size := 8, 1000
+	parallel(workers, func(worker int) {
+		for n := 0; n < size; n++ {
+			for {
+				value, _ := m.Get("counter")
+				if m.CompareAndSwap("counter", value, value.(int)+1) {
+					break
+				}
+			}
+		}
+	})
+	if actualValue, _ := m.Get("counter"); actualValue != workers*size {
+		t.Errorf("Got %v expected %v", actualValue, workers*size)
+	}
+}
+
+func TestMapIterator(t *testing.T) {
+	m := NewWith(4)
+	it := m.Iterator()
+	for it.Next() {
+		t.Errorf("Shouldn't iterate on empty map")
+	}
+
+	for n := 0; n < 100; n++ {
+		m.Put(n, n*n)
+	}
+	it = m.Iterator()
+	count := 0
+	for it.Next() {
+		if it.Value() != it.Key().(int)*it.Key().(int) {
+			t.Errorf("Got %v expected %v", it.Value(), it.Key().(int)*it.Key().(int))
+		}
+		m.Remove(it.Key()) // does not deadlock
+		count++
+	}
+	if actualValue, expectedValue := count, 100; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := it.Next(), false; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	m.Put("a", 1)
+	if actualValue, expectedValue := it.First(), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := it.Key(), "a"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	m.Put("b", 2)
+	m.Put("c", 3)
+	it.Begin()
+	if actualValue, expectedValue := it.NextTo(func(key interface{}, value interface{}) bool { return value == 2 }), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := it.Key(), "b"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestMapIteratorConcurrentUse(t *testing.T) {
+	m := New()
+	for n := 0; n < 1000; n++ {
+		m.Put(n, n)
+	}
+	parallel(4, func(worker int) {
+		if worker%2 == 0 {
+			for n := 0; n < 1000; n++ {
+				m.Put(n+1000, n)
+				m.Remove(n)
+			}
+			return
+		}
+		it := m.Iterator()
+		for it.Next() {
+			if it.Key().(int)%1000 != it.Value().(int) {
+				t.Errorf("Got %v expected %v", it.Value(), it.Key().(int)%1000)
+			}
+		}
+	})
+}
+
+func TestMapSerialization(t *testing.T) {
+	m := New()
+	m.Put("a", 1.0)
+	m.Put("b", 2.0)
+	m.Put(3, "c")
+
+	serialized, err := m.ToJSON()
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := string(serialized), `{"3":"c","a":1,"b":2}`; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	deserialized := NewWith(2)
+	err = json.Unmarshal(serialized, deserialized)
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := sortedStrings(deserialized.Keys()), "[3 a b]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	_, err = json.Marshal([]interface{}{"a", "b", "c", m})
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+
+	err = m.FromJSON([]byte(`[1,2]`))
+	if err == nil {
+		t.Errorf("Expected an error")
+	}
+}
+
+func TestMapInvalidShards(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("Should panic on less than 1 shard")
+		}
+	}()
+	NewWith(0)
+}
+
+func TestMapString(t *testing.T) {
+	c := New()
+	c.Put("a", 1)
+	if !strings.HasPrefix(c.String(), "ConcurrentHashMap") {
+		t.Errorf("String should start with container name")
+	}
+	if actualValue, expectedValue := c.String(), "ConcurrentHashMap\nmap[a:1]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func sortedStrings(values []interface{}) string {
+	strs := make([]string, len(values))
+	for i, value := range values {
+		strs[i] = fmt.Sprintf("%v", value)
+	}
+	sort.Strings(strs)
+	return fmt.Sprintf("%v", strs)
+}
+
+func benchmarkGet(b *testing.B, m maps.Map, size int) {
+	for i := 0; i < b.N; i++ {
+		for n := 0; n < size; n++ {
+			m.Get(n)
+		}
+	}
+}
+
+func benchmarkPut(b *testing.B, m maps.Map, size int) {
+	for i := 0; i < b.N; i++ {
+		for n := 0; n < size; n++ {
+			m.Put(n, struct{}{})
+		}
+	}
+}
+
+func benchmarkRemove(b *testing.B, m maps.Map, size int) {
+	for i := 0; i < b.N; i++ {
+		for n := 0; n < size; n++ {
+			m.Remove(n)
+		}
+	}
+}
+
+// benchmarkParallelMixed puts one key for every nine gets, from as many goroutines as GOMAXPROCS, each of which starts
+// at another key
+func benchmarkParallelMixed(b *testing.B, m maps.Map, size int) {
+	var goroutines int64
+	b.RunParallel(func(pb *testing.PB) {
+		n := int(atomic.AddInt64(&goroutines, 1)) * 7919
+		for pb.Next() {
+			if n%10 == 0 {
+				m.Put(n%size, struct{}{})
+			} else {
+				m.Get(n % size)
+			}
+			n++
+		}
+	})
+}
+
+// benchmarkParallelPut puts keys from as many goroutines as GOMAXPROCS, each of which starts at another key
+func benchmarkParallelPut(b *testing.B, m maps.Map, size int) {
+	var goroutines int64
+	b.RunParallel(func(pb *testing.PB) {
+		n := int(atomic.AddInt64(&goroutines, 1)) * 7919
+		for pb.Next() {
+			m.Put(n%size, struct{}{})
+			n++
+		}
+	})
+}
+
+func BenchmarkConcurrentHashMapGet100(b *testing.B) {
+	b.StopTimer()
+	size := 100
+	m := New()
+	for n := 0; n < size; n++ {
+		m.Put(n, struct{}{})
+	}
+	b.StartTimer()
+	benchmarkGet(b, m, size)
+}
+
+func BenchmarkConcurrentHashMapGet10000(b *testing.B) {
+	b.StopTimer()
+	size := 10000
+	m := New()
+	for n := 0; n < size; n++ {
+		m.Put(n, struct{}{})
+	}
+	b.StartTimer()
+	benchmarkGet(b, m, size)
+}
+
+func BenchmarkConcurrentHashMapPut100(b *testing.B) {
+	b.StopTimer()
+	size := 100
+	m := New()
+	b.StartTimer()
+	benchmarkPut(b, m, size)
+}
+
+func BenchmarkConcurrentHashMapPut10000(b *testing.B) {
+	b.StopTimer()
+	size := 10000
+	m := New()
+	b.StartTimer()
+	benchmarkPut(b, m, size)
+}
+
+func BenchmarkConcurrentHashMapRemove100(b *testing.B) {
+	b.StopTimer()
+	size := 100
+	m := New()
+	for n := 0; n < size; n++ {
+		m.Put(n, struct{}{})
+	}
+	b.StartTimer()
+	benchmarkRemove(b, m, size)
+}
+
+func BenchmarkConcurrentHashMapRemove10000(b *testing.B) {
+	b.StopTimer()
+	size := 10000
+	m := New()
+	for n := 0; n < size; n++ {
+		m.Put(n, struct{}{})
+	}
+	b.StartTimer()
+	benchmarkRemove(b, m, size)
+}
+
+func BenchmarkConcurrentHashMapParallelMixed10000(b *testing.B) {
+	b.StopTimer()
+	size := 10000
+	m := New()
+	for n := 0; n < size; n++ {
+		m.Put(n, struct{}{})
+	}
+	b.StartTimer()
+	benchmarkParallelMixed(b, m, size)
+}
+
+func BenchmarkLockedHashMapParallelMixed10000(b *testing.B) {
+	b.StopTimer()
+	size := 10000
+	m := concurrent.NewMap(hashmap.New())
+	for n := 0; n < size; n++ {
+		m.Put(n, struct{}{})
+	}
+	b.StartTimer()
+	benchmarkParallelMixed(b, m, size)
+}
+
+func BenchmarkConcurrentHashMapParallelPut10000(b *testing.B) {
+	b.StopTimer()
+	size := 10000
+	m := New()
+	b.StartTimer()
+	benchmarkParallelPut(b, m, size)
+}
+
+func BenchmarkLockedHashMapParallelPut10000(b *testing.B) {
+	b.StopTimer()
+	size := 10000
+	m := concurrent.NewMap(hashmap.New())
+	b.StartTimer()
+	benchmarkParallelPut(b, m, size)
+}
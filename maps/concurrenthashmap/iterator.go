@@ -0,0 +1,88 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package concurrenthashmap
+
+import "github.com/emirpasic/gods/containers"
+
+// Assert Iterator implementation
+var _ containers.IteratorWithKey = (*Iterator)(nil)
+
+// Iterator holding the iterator's state
+type Iterator struct {
+	m      *Map
+	shard  int           // index of the shard whose snapshot is iterated
+	keys   []interface{} // snapshot of the keys of the shard
+	values []interface{} // snapshot of the values of the shard
+	index  int           // index of the current element in the snapshot
+}
+
+// Iterator returns a stateful iterator whose elements are key/value pairs (random order).
+// The iterator copies one shard at a time when it reaches it, so it is weakly consistent: it returns every element
+// that was in the map when the iteration started and not removed before its shard was reached, and it might or might
+// not return elements put after the iteration started. It never blocks writers for longer than a copy of one shard.
+func (m *Map) Iterator() Iterator {
+	return Iterator{m: m, shard: -1, index: -1}
+}
+
+// Next moves the iterator to the next element and returns true if there was a next element in the container.
+// If Next() returns true, then next element's key and value can be retrieved by Key() and Value().
+// If Next() was called for the first time, then it will point the iterator to the first element if it exists.
+// Modifies the state of the iterator.
+func (iterator *Iterator) Next() bool {
+	iterator.index++
+	for iterator.index >= len(iterator.keys) {
+		if iterator.shard+1 >= len(iterator.m.shards) {
+			iterator.shard = len(iterator.m.shards)
+			iterator.keys, iterator.values = nil, nil
+			return false
+		}
+		iterator.shard++
+		iterator.keys, iterator.values = iterator.m.shards[iterator.shard].snapshot()
+		iterator.index = 0
+	}
+	return true
+}
+
+// Value returns the current element's value.
+// Does not modify the state of the iterator.
+func (iterator *Iterator) Value() interface{} {
+	return iterator.values[iterator.index]
+}
+
+// Key returns the current element's key.
+// Does not modify the state of the iterator.
+func (iterator *Iterator) Key() interface{} {
+	return iterator.keys[iterator.index]
+}
+
+// Begin resets the iterator to its initial state (one-before-first)
+// Call Next() to fetch the first element if any.
+func (iterator *Iterator) Begin() {
+	iterator.shard = -1
+	iterator.keys, iterator.values = nil, nil
+	iterator.index = -1
+}
+
+// First moves the iterator to the first element and returns true if there was a first element in the container.
+// If First() returns true, then first element's key and value can be retrieved by Key() and Value().
+// Modifies the state of the iterator.
+func (iterator *Iterator) First() bool {
+	iterator.Begin()
+	return iterator.Next()
+}
+
+// NextTo moves the iterator to the next element from current position that satisfies the condition given by the
+// passed function, and returns true if there was a next element in the container.
+// If NextTo() returns true, then next element's key and value can be retrieved by Key() and Value().
+// Modifies the state of the iterator.
+func (iterator *Iterator) NextTo(f func(key interface{}, value interface{}) bool) bool {
+	for iterator.Next() {
+		key, value := iterator.Key(), iterator.Value()
+		if f(key, value) {
+			return true
+		}
+	}
+	return false
+}
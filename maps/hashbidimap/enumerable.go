@@ -0,0 +1,58 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package hashbidimap
+
+import "github.com/emirpasic/gods/containers"
+
+// Assert Enumerable implementation
+var _ containers.EnumerableWithKey = (*Map)(nil)
+
+// Each calls the given function once for each element, passing that element's key and value.
+// Elements are visited in random order.
+func (m *Map) Each(f func(key interface{}, value interface{})) {
+	m.forwardMap.Each(f)
+}
+
+// Map invokes the given function once for each element and returns a container
+// containing the values returned by the given function as key/value pairs.
+// As in Put, a mapped pair replaces any earlier pair sharing its key or its value.
+func (m *Map) Map(f func(key1 interface{}, value1 interface{}) (interface{}, interface{})) containers.EnumerableContainerWithKey {
+	newMap := New()
+	m.forwardMap.Each(func(key interface{}, value interface{}) {
+		key2, value2 := f(key, value)
+		newMap.Put(key2, value2)
+	})
+	return newMap
+}
+
+// Select returns a new container containing all elements for which the given function returns a true value.
+func (m *Map) Select(f func(key interface{}, value interface{}) bool) containers.EnumerableContainerWithKey {
+	newMap := New()
+	m.forwardMap.Each(func(key interface{}, value interface{}) {
+		if f(key, value) {
+			newMap.Put(key, value)
+		}
+	})
+	return newMap
+}
+
+// Any passes each element of the container to the given function and
+// returns true if the function ever returns true for any element.
+func (m *Map) Any(f func(key interface{}, value interface{}) bool) bool {
+	return m.forwardMap.Any(f)
+}
+
+// All passes each element of the container to the given function and
+// returns true if the function returns true for all elements.
+func (m *Map) All(f func(key interface{}, value interface{}) bool) bool {
+	return m.forwardMap.All(f)
+}
+
+// Find passes each element of the container to the given function and returns
+// the first (key,value) for which the function is true or nil,nil otherwise if no element
+// matches the criteria.
+func (m *Map) Find(f func(key interface{}, value interface{}) bool) (interface{}, interface{}) {
+	return m.forwardMap.Find(f)
+}
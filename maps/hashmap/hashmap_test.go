@@ -7,6 +7,7 @@ package hashmap
 import (
 	"encoding/json"
 	"fmt"
+	"github.com/emirpasic/gods/containers"
 	"strings"
 	"testing"
 )
@@ -169,6 +170,60 @@ func TestMapString(t *testing.T) {
 	}
 }
 
+func TestMapEnumerable(t *testing.T) {
+	m := New()
+	m.Put("a", 1)
+	m.Put("b", 2)
+	m.Put("c", 3)
+	var enumerable containers.EnumerableContainerWithKey = m
+
+	count := 0
+	enumerable.Each(func(key interface{}, value interface{}) {
+		if expectedValue, _ := m.Get(key); value != expectedValue {
+			t.Errorf("Got %v expected %v", value, expectedValue)
+		}
+		count++
+	})
+	if count != 3 {
+		t.Errorf("Got %v expected %v", count, 3)
+	}
+
+	mapped := enumerable.Map(func(key1 interface{}, value1 interface{}) (key2 interface{}, value2 interface{}) {
+		return key1.(string) + key1.(string), value1.(int) * value1.(int)
+	}).(*Map)
+	if actualValue, found := mapped.Get("cc"); actualValue != 9 || !found {
+		t.Errorf("Got %v expected %v", actualValue, 9)
+	}
+	if actualValue := mapped.Size(); actualValue != 3 {
+		t.Errorf("Got %v expected %v", actualValue, 3)
+	}
+	selected := enumerable.Select(func(key interface{}, value interface{}) bool {
+		return key.(string) >= "b"
+	}).(*Map)
+	if actualValue, expectedValue := selected.Keys(), []interface{}{"b", "c"}; !sameElements(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	if actualValue := enumerable.Any(func(key interface{}, value interface{}) bool { return value.(int) == 3 }); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := enumerable.Any(func(key interface{}, value interface{}) bool { return value.(int) == 4 }); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	if actualValue := enumerable.All(func(key interface{}, value interface{}) bool { return value.(int) > 0 }); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := enumerable.All(func(key interface{}, value interface{}) bool { return value.(int) > 1 }); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	if key, value := enumerable.Find(func(key interface{}, value interface{}) bool { return value.(int) == 2 }); key != "b" || value != 2 {
+		t.Errorf("Got %v->%v expected %v->%v", key, value, "b", 2)
+	}
+	if key, value := enumerable.Find(func(key interface{}, value interface{}) bool { return value.(int) == 4 }); key != nil || value != nil {
+		t.Errorf("Got %v->%v expected %v->%v", key, value, nil, nil)
+	}
+}
+
 func sameElements(a []interface{}, b []interface{}) bool {
 	if len(a) != len(b) {
 		return false
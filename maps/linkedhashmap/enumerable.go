@@ -19,7 +19,7 @@ func (m *Map) Each(f func(key interface{}, value interface{})) {
 
 // Map invokes the given function once for each element and returns a container
 // containing the values returned by the given function as key/value pairs.
-func (m *Map) Map(f func(key1 interface{}, value1 interface{}) (interface{}, interface{})) *Map {
+func (m *Map) Map(f func(key1 interface{}, value1 interface{}) (interface{}, interface{})) containers.EnumerableContainerWithKey {
 	newMap := New()
 	iterator := m.Iterator()
 	for iterator.Next() {
@@ -30,7 +30,7 @@ func (m *Map) Map(f func(key1 interface{}, value1 interface{}) (interface{}, int
 }
 
 // Select returns a new container containing all elements for which the given function returns a true value.
-func (m *Map) Select(f func(key interface{}, value interface{}) bool) *Map {
+func (m *Map) Select(f func(key interface{}, value interface{}) bool) containers.EnumerableContainerWithKey {
 	newMap := New()
 	iterator := m.Iterator()
 	for iterator.Next() {
@@ -4,25 +4,28 @@
 
 package linkedhashmap
 
-import (
-	"github.com/emirpasic/gods/containers"
-	"github.com/emirpasic/gods/lists/doublylinkedlist"
-)
+import "github.com/emirpasic/gods/containers"
 
 // Assert Iterator implementation
 var _ containers.ReverseIteratorWithKey = (*Iterator)(nil)
 
 // Iterator holding the iterator's state
 type Iterator struct {
-	iterator doublylinkedlist.Iterator
-	table    map[interface{}]interface{}
+	m        *Map
+	entry    *entry
+	position position
 }
 
+type position byte
+
+const (
+	begin, between, end position = 0, 1, 2
+)
+
 // Iterator returns a stateful iterator whose elements are key/value pairs.
+// Iterating does not count as access, i.e. it does not change the ordering of a map ordered by access.
 func (m *Map) Iterator() Iterator {
-	return Iterator{
-		iterator: m.ordering.Iterator(),
-		table:    m.table}
+	return Iterator{m: m, entry: nil, position: begin}
 }
 
 // Next moves the iterator to the next element and returns true if there was a next element in the container.
@@ -30,53 +33,82 @@ func (m *Map) Iterator() Iterator {
 // If Next() was called for the first time, then it will point the iterator to the first element if it exists.
 // Modifies the state of the iterator.
 func (iterator *Iterator) Next() bool {
-	return iterator.iterator.Next()
+	switch iterator.position {
+	case end:
+		return false
+	case begin:
+		iterator.entry = iterator.m.head
+	default:
+		iterator.entry = iterator.entry.next
+	}
+	if iterator.entry == nil {
+		iterator.position = end
+		return false
+	}
+	iterator.position = between
+	return true
 }
 
 // Prev moves the iterator to the previous element and returns true if there was a previous element in the container.
 // If Prev() returns true, then previous element's key and value can be retrieved by Key() and Value().
 // Modifies the state of the iterator.
 func (iterator *Iterator) Prev() bool {
-	return iterator.iterator.Prev()
+	switch iterator.position {
+	case begin:
+		return false
+	case end:
+		iterator.entry = iterator.m.tail
+	default:
+		iterator.entry = iterator.entry.prev
+	}
+	if iterator.entry == nil {
+		iterator.position = begin
+		return false
+	}
+	iterator.position = between
+	return true
 }
 
 // Value returns the current element's value.
 // Does not modify the state of the iterator.
 func (iterator *Iterator) Value() interface{} {
-	key := iterator.iterator.Value()
-	return iterator.table[key]
+	return iterator.entry.value
 }
 
 // Key returns the current element's key.
 // Does not modify the state of the iterator.
 func (iterator *Iterator) Key() interface{} {
-	return iterator.iterator.Value()
+	return iterator.entry.key
 }
 
 // Begin resets the iterator to its initial state (one-before-first)
 // Call Next() to fetch the first element if any.
 func (iterator *Iterator) Begin() {
-	iterator.iterator.Begin()
+	iterator.entry = nil
+	iterator.position = begin
 }
 
 // End moves the iterator past the last element (one-past-the-end).
 // Call Prev() to fetch the last element if any.
 func (iterator *Iterator) End() {
-	iterator.iterator.End()
+	iterator.entry = nil
+	iterator.position = end
 }
 
 // First moves the iterator to the first element and returns true if there was a first element in the container.
 // If First() returns true, then first element's key and value can be retrieved by Key() and Value().
 // Modifies the state of the iterator
 func (iterator *Iterator) First() bool {
-	return iterator.iterator.First()
+	iterator.Begin()
+	return iterator.Next()
 }
 
 // Last moves the iterator to the last element and returns true if there was a last element in the container.
 // If Last() returns true, then last element's key and value can be retrieved by Key() and Value().
 // Modifies the state of the iterator.
 func (iterator *Iterator) Last() bool {
-	return iterator.iterator.Last()
+	iterator.End()
+	return iterator.Prev()
 }
 
 // NextTo moves the iterator to the next element from current position that satisfies the condition given by the
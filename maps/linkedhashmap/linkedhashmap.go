@@ -5,6 +5,11 @@
 // Package linkedhashmap is a map that preserves insertion-order.
 //
 // It is backed by a hash table to store values and doubly-linked list to store ordering.
+// Every entry of the hash table is itself a node of the doubly-linked list, so that entries can be removed and
+// reordered in constant time.
+//
+// Optionally, the map can be ordered by access instead, i.e. from the least recently to the most recently accessed
+// entry, which makes it a suitable basis for recency-ordered structures such as caches.
 //
 // Structure is not thread safe.
 //
@@ -13,7 +18,6 @@ package linkedhashmap
 
 import (
 	"fmt"
-	"github.com/emirpasic/gods/lists/doublylinkedlist"
 	"github.com/emirpasic/gods/maps"
 	"strings"
 )
@@ -21,46 +25,112 @@ import (
 // Assert Map implementation
 var _ maps.Map = (*Map)(nil)
 
-// Map holds the elements in a regular hash table, and uses doubly-linked list to store key ordering.
+// Map holds the elements in a regular hash table, whose entries are linked in a doubly-linked list to store key ordering.
 type Map struct {
-	table    map[interface{}]interface{}
-	ordering *doublylinkedlist.List
+	table       map[interface{}]*entry
+	head        *entry // first entry in the ordering
+	tail        *entry // last entry in the ordering
+	accessOrder bool
+}
+
+type entry struct {
+	key   interface{}
+	value interface{}
+	prev  *entry
+	next  *entry
 }
 
-// New instantiates a linked-hash-map.
+// New instantiates a linked-hash-map ordered by insertion.
 func New() *Map {
-	return &Map{
-		table:    make(map[interface{}]interface{}),
-		ordering: doublylinkedlist.New(),
-	}
+	return &Map{table: make(map[interface{}]*entry)}
+}
+
+// NewWithAccessOrder instantiates a linked-hash-map ordered by access, i.e. Get and Put of an existing key move
+// its entry to the back, so that entries are ordered from the least recently to the most recently accessed.
+func NewWithAccessOrder() *Map {
+	return &Map{table: make(map[interface{}]*entry), accessOrder: true}
 }
 
 // Put inserts key-value pair into the map.
+// In insertion order, updating the value of an existing key does not change its position.
+// In access order, the entry is moved to the back.
 // Key should adhere to the comparator's type assertion, otherwise method panics.
 func (m *Map) Put(key interface{}, value interface{}) {
-	if _, contains := m.table[key]; !contains {
-		m.ordering.Append(key)
+	if e, contains := m.table[key]; contains {
+		e.value = value
+		if m.accessOrder {
+			m.moveToBack(e)
+		}
+		return
 	}
-	m.table[key] = value
+	e := &entry{key: key, value: value}
+	m.table[key] = e
+	m.linkLast(e)
 }
 
-// Get searches the element in the map by key and returns its value or nil if key is not found in tree.
-// Second return parameter is true if key was found, otherwise false.
+// Get searches the element in the map by key and returns its value or nil if key is not found in map.
+// Second return parameter is true if key was found, even if it is mapped to nil, otherwise false.
+// In access order, the entry is moved to the back.
 // Key should adhere to the comparator's type assertion, otherwise method panics.
 func (m *Map) Get(key interface{}) (value interface{}, found bool) {
-	value = m.table[key]
-	found = value != nil
-	return
+	e, found := m.table[key]
+	if !found {
+		return nil, false
+	}
+	if m.accessOrder {
+		m.moveToBack(e)
+	}
+	return e.value, true
 }
 
 // Remove removes the element from the map by key.
 // Key should adhere to the comparator's type assertion, otherwise method panics.
 func (m *Map) Remove(key interface{}) {
-	if _, contains := m.table[key]; contains {
+	if e, contains := m.table[key]; contains {
 		delete(m.table, key)
-		index := m.ordering.IndexOf(key)
-		m.ordering.Remove(index)
+		m.unlink(e)
+	}
+}
+
+// MoveToFront moves the element with the given key to the front of the ordering, if the key is found.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (m *Map) MoveToFront(key interface{}) {
+	if e, contains := m.table[key]; contains && e != m.head {
+		m.unlink(e)
+		m.linkFirst(e)
+	}
+}
+
+// MoveToBack moves the element with the given key to the back of the ordering, if the key is found.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (m *Map) MoveToBack(key interface{}) {
+	if e, contains := m.table[key]; contains {
+		m.moveToBack(e)
+	}
+}
+
+// PollFirst removes the first key in the ordering and its value from the map and returns them.
+// Returns nil, nil if map is empty.
+func (m *Map) PollFirst() (key interface{}, value interface{}) {
+	if m.head == nil {
+		return nil, nil
+	}
+	e := m.head
+	delete(m.table, e.key)
+	m.unlink(e)
+	return e.key, e.value
+}
+
+// PollLast removes the last key in the ordering and its value from the map and returns them.
+// Returns nil, nil if map is empty.
+func (m *Map) PollLast() (key interface{}, value interface{}) {
+	if m.tail == nil {
+		return nil, nil
 	}
+	e := m.tail
+	delete(m.table, e.key)
+	m.unlink(e)
+	return e.key, e.value
 }
 
 // Empty returns true if map does not contain any elements
@@ -70,12 +140,19 @@ func (m *Map) Empty() bool {
 
 // Size returns number of elements in the map.
 func (m *Map) Size() int {
-	return m.ordering.Size()
+	return len(m.table)
 }
 
 // Keys returns all keys in-order
 func (m *Map) Keys() []interface{} {
-	return m.ordering.Values()
+	keys := make([]interface{}, m.Size())
+	count := 0
+	it := m.Iterator()
+	for it.Next() {
+		keys[count] = it.Key()
+		count++
+	}
+	return keys
 }
 
 // Values returns all values in-order based on the key.
@@ -92,8 +169,9 @@ func (m *Map) Values() []interface{} {
 
 // Clear removes all elements from the map.
 func (m *Map) Clear() {
-	m.table = make(map[interface{}]interface{})
-	m.ordering.Clear()
+	m.table = make(map[interface{}]*entry)
+	m.head = nil
+	m.tail = nil
 }
 
 // String returns a string representation of container
@@ -106,3 +184,47 @@ func (m *Map) String() string {
 	return strings.TrimRight(str, " ") + "]"
 
 }
+
+func (m *Map) moveToBack(e *entry) {
+	if e != m.tail {
+		m.unlink(e)
+		m.linkLast(e)
+	}
+}
+
+func (m *Map) linkFirst(e *entry) {
+	e.prev = nil
+	e.next = m.head
+	if m.head != nil {
+		m.head.prev = e
+	} else {
+		m.tail = e
+	}
+	m.head = e
+}
+
+func (m *Map) linkLast(e *entry) {
+	e.prev = m.tail
+	e.next = nil
+	if m.tail != nil {
+		m.tail.next = e
+	} else {
+		m.head = e
+	}
+	m.tail = e
+}
+
+func (m *Map) unlink(e *entry) {
+	if e.prev != nil {
+		e.prev.next = e.next
+	} else {
+		m.head = e.next
+	}
+	if e.next != nil {
+		e.next.prev = e.prev
+	} else {
+		m.tail = e.prev
+	}
+	e.prev = nil
+	e.next = nil
+}
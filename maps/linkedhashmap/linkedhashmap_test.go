@@ -120,6 +120,137 @@ func TestMapRemove(t *testing.T) {
 	}
 }
 
+func TestMapRemoveKeepsOrdering(t *testing.T) {
+	m := New()
+	for i := 0; i < 10; i++ {
+		m.Put(i, i)
+	}
+	m.Remove(0) // first
+	m.Remove(9) // last
+	m.Remove(5) // middle
+	m.Put(0, 0)
+	if actualValue, expectedValue := fmt.Sprintf("%v", m.Keys()), "[1 2 3 4 6 7 8 0]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	it := m.Iterator()
+	if actualValue, expectedValue := it.Last(), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := it.Key(), 0; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestMapAccessOrder(t *testing.T) {
+	m := NewWithAccessOrder()
+	m.Put("a", 1)
+	m.Put("b", 2)
+	m.Put("c", 3)
+	m.Put("d", 4)
+
+	m.Get("a")     // a moves to the back
+	m.Put("b", 20) // b moves to the back
+	m.Get("x")     // not found, nothing moves
+	if actualValue, expectedValue := fmt.Sprintf("%v", m.Keys()), "[c d a b]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", m.Values()), "[3 4 1 20]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	// iterating is not an access
+	it := m.Iterator()
+	for it.Next() {
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", m.Keys()), "[c d a b]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	// insertion order is not affected by Get and Put of existing keys
+	m = New()
+	m.Put("a", 1)
+	m.Put("b", 2)
+	m.Get("a")
+	m.Put("a", 10)
+	if actualValue, expectedValue := fmt.Sprintf("%v", m.Keys()), "[a b]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestMapMoveToFrontAndBack(t *testing.T) {
+	m := New()
+	m.Put("a", 1)
+	m.Put("b", 2)
+	m.Put("c", 3)
+
+	m.MoveToFront("c")
+	if actualValue, expectedValue := fmt.Sprintf("%v", m.Keys()), "[c a b]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	m.MoveToBack("c")
+	m.MoveToBack("c")
+	if actualValue, expectedValue := fmt.Sprintf("%v", m.Keys()), "[a b c]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	m.MoveToFront("b")
+	m.MoveToFront("b")
+	m.MoveToFront("x")
+	m.MoveToBack("x")
+	if actualValue, expectedValue := fmt.Sprintf("%v", m.Keys()), "[b a c]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	it := m.Iterator()
+	keys := []interface{}{}
+	for it.End(); it.Prev(); {
+		keys = append(keys, it.Key())
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", keys), "[c a b]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue := m.Size(); actualValue != 3 {
+		t.Errorf("Got %v expected %v", actualValue, 3)
+	}
+}
+
+func TestMapPollFirstAndPollLast(t *testing.T) {
+	m := New()
+
+	if k, v := m.PollFirst(); k != nil || v != nil {
+		t.Errorf("Got %v->%v expected %v->%v", k, v, nil, nil)
+	}
+	if k, v := m.PollLast(); k != nil || v != nil {
+		t.Errorf("Got %v->%v expected %v->%v", k, v, nil, nil)
+	}
+
+	m.Put("b", 2)
+	m.Put("a", 1)
+	m.Put("c", 3)
+
+	if k, v := m.PollFirst(); k != "b" || v != 2 {
+		t.Errorf("Got %v->%v expected %v->%v", k, v, "b", 2)
+	}
+	if k, v := m.PollLast(); k != "c" || v != 3 {
+		t.Errorf("Got %v->%v expected %v->%v", k, v, "c", 3)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", m.Keys()), "[a]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if _, found := m.Get("b"); found {
+		t.Errorf("Got %v expected %v", found, false)
+	}
+	if k, v := m.PollLast(); k != "a" || v != 1 {
+		t.Errorf("Got %v->%v expected %v->%v", k, v, "a", 1)
+	}
+	if actualValue := m.Empty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	it := m.Iterator()
+	if it.Next() || it.Last() {
+		t.Errorf("Shouldn't iterate on empty map")
+	}
+}
+
 func sameElements(a []interface{}, b []interface{}) bool {
 	// If one is nil, the other must also be nil.
 	if (a == nil) != (b == nil) {
@@ -176,7 +307,7 @@ func TestMapMap(t *testing.T) {
 	m.Put("b", 2)
 	mappedMap := m.Map(func(key1 interface{}, value1 interface{}) (key2 interface{}, value2 interface{}) {
 		return key1, value1.(int) * value1.(int)
-	})
+	}).(*Map)
 	if actualValue, _ := mappedMap.Get("c"); actualValue != 9 {
 		t.Errorf("Got %v expected %v", actualValue, "mapped: c")
 	}
@@ -198,7 +329,7 @@ func TestMapSelect(t *testing.T) {
 	m.Put("a", 2)
 	selectedMap := m.Select(func(key interface{}, value interface{}) bool {
 		return key.(string) >= "a" && key.(string) <= "b"
-	})
+	}).(*Map)
 	if actualValue, _ := selectedMap.Get("b"); actualValue != 1 {
 		t.Errorf("Got %v expected %v", actualValue, "value: a")
 	}
@@ -276,7 +407,7 @@ func TestMapChaining(t *testing.T) {
 		return value.(int) > 1
 	}).Map(func(key interface{}, value interface{}) (interface{}, interface{}) {
 		return key.(string) + key.(string), value.(int) * value.(int)
-	})
+	}).(*Map)
 	if actualValue := chainedMap.Size(); actualValue != 2 {
 		t.Errorf("Got %v expected %v", actualValue, 2)
 	}
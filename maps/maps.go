@@ -17,7 +17,8 @@ package maps
 
 import "github.com/emirpasic/gods/containers"
 
-// Map interface that all maps implement
+// Map interface that all maps implement.
+// Get reports whether the key is present in the map independently of its value, i.e. a key may be mapped to nil.
 type Map interface {
 	Put(key interface{}, value interface{})
 	Get(key interface{}) (value interface{}, found bool)
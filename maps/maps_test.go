@@ -0,0 +1,207 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package maps_test
+
+import (
+	"fmt"
+	"github.com/emirpasic/gods/containers"
+	"github.com/emirpasic/gods/maps"
+	"github.com/emirpasic/gods/maps/concurrenthashmap"
+	"github.com/emirpasic/gods/maps/hashbidimap"
+	"github.com/emirpasic/gods/maps/hashmap"
+	"github.com/emirpasic/gods/maps/linkedhashmap"
+	"github.com/emirpasic/gods/maps/radixtree"
+	"github.com/emirpasic/gods/maps/skiplist"
+	"github.com/emirpasic/gods/maps/treebidimap"
+	"github.com/emirpasic/gods/maps/treemap"
+	"github.com/emirpasic/gods/maps/ttlmap"
+	"sort"
+	"testing"
+	"time"
+)
+
+// implementations returns a fresh empty instance of every map, all of them with string keys and values
+func implementations() map[string]func() maps.Map {
+	return map[string]func() maps.Map{
+		"HashMap":           func() maps.Map { return hashmap.New() },
+		"TreeMap":           func() maps.Map { return treemap.NewWithStringComparator() },
+		"LinkedHashMap":     func() maps.Map { return linkedhashmap.New() },
+		"HashBidiMap":       func() maps.Map { return hashbidimap.New() },
+		"TreeBidiMap":       func() maps.Map { return treebidimap.NewWithStringComparators() },
+		"SkipListMap":       func() maps.Map { return skiplist.NewWithStringComparator() },
+		"RadixTree":         func() maps.Map { return radixtree.New() },
+		"TTLMap":            func() maps.Map { return ttlmap.New(time.Hour) },
+		"ConcurrentHashMap": func() maps.Map { return concurrenthashmap.New() },
+	}
+}
+
+func TestMapNilValues(t *testing.T) {
+	for name, newMap := range implementations() {
+		m := newMap()
+		m.Put("a", nil)
+		m.Put("b", "x")
+
+		if actualValue, actualFound := m.Get("a"); actualValue != nil || !actualFound {
+			t.Errorf("[%s] Got %v,%v expected %v,%v", name, actualValue, actualFound, nil, true)
+		}
+		if actualValue, actualFound := m.Get("c"); actualValue != nil || actualFound {
+			t.Errorf("[%s] Got %v,%v expected %v,%v", name, actualValue, actualFound, nil, false)
+		}
+		if actualValue, expectedValue := m.Size(), 2; actualValue != expectedValue {
+			t.Errorf("[%s] Got %v expected %v", name, actualValue, expectedValue)
+		}
+		if actualValue, expectedValue := sortedStrings(m.Keys()), "[a b]"; actualValue != expectedValue {
+			t.Errorf("[%s] Got %v expected %v", name, actualValue, expectedValue)
+		}
+		if actualValue, expectedValue := sortedStrings(m.Values()), "[<nil> x]"; actualValue != expectedValue {
+			t.Errorf("[%s] Got %v expected %v", name, actualValue, expectedValue)
+		}
+
+		// overwrite nil with a value and back
+		m.Put("a", "y")
+		if actualValue, actualFound := m.Get("a"); actualValue != "y" || !actualFound {
+			t.Errorf("[%s] Got %v,%v expected %v,%v", name, actualValue, actualFound, "y", true)
+		}
+		m.Put("a", nil)
+		if actualValue, actualFound := m.Get("a"); actualValue != nil || !actualFound {
+			t.Errorf("[%s] Got %v,%v expected %v,%v", name, actualValue, actualFound, nil, true)
+		}
+		if actualValue, expectedValue := m.Size(), 2; actualValue != expectedValue {
+			t.Errorf("[%s] Got %v expected %v", name, actualValue, expectedValue)
+		}
+
+		m.Remove("a")
+		if actualValue, actualFound := m.Get("a"); actualValue != nil || actualFound {
+			t.Errorf("[%s] Got %v,%v expected %v,%v", name, actualValue, actualFound, nil, false)
+		}
+		if actualValue, expectedValue := m.Size(), 1; actualValue != expectedValue {
+			t.Errorf("[%s] Got %v expected %v", name, actualValue, expectedValue)
+		}
+
+		m.Put("a", nil)
+		m.Clear()
+		if actualValue, actualFound := m.Get("a"); actualValue != nil || actualFound {
+			t.Errorf("[%s] Got %v,%v expected %v,%v", name, actualValue, actualFound, nil, false)
+		}
+	}
+}
+
+func TestMapNilValuesEnumerable(t *testing.T) {
+	for name, newMap := range implementations() {
+		m := newMap()
+		m.Put("a", nil)
+		m.Put("b", "x")
+
+		enumerable, ok := m.(containers.EnumerableWithKey)
+		if !ok {
+			continue
+		}
+		count := 0
+		enumerable.Each(func(key interface{}, value interface{}) {
+			if key == "a" {
+				count++
+				if value != nil {
+					t.Errorf("[%s] Got %v expected %v", name, value, nil)
+				}
+			}
+		})
+		if actualValue, expectedValue := count, 1; actualValue != expectedValue {
+			t.Errorf("[%s] Got %v expected %v", name, actualValue, expectedValue)
+		}
+
+		selected := enumerable.Select(func(key interface{}, value interface{}) bool {
+			return value == nil
+		}).(maps.Map)
+		if actualValue, actualFound := selected.Get("a"); actualValue != nil || !actualFound {
+			t.Errorf("[%s] Got %v,%v expected %v,%v", name, actualValue, actualFound, nil, true)
+		}
+		if actualValue, expectedValue := selected.Size(), 1; actualValue != expectedValue {
+			t.Errorf("[%s] Got %v expected %v", name, actualValue, expectedValue)
+		}
+	}
+}
+
+func TestMapNilValuesSerialization(t *testing.T) {
+	for name, newMap := range implementations() {
+		original := newMap()
+		original.Put("a", nil)
+		original.Put("b", "x")
+
+		serialized, err := original.(containers.JSONSerializer).ToJSON()
+		if err != nil {
+			t.Errorf("[%s] Got error %v", name, err)
+		}
+
+		deserialized := newMap()
+		err = deserialized.(containers.JSONDeserializer).FromJSON(serialized)
+		if err != nil {
+			t.Errorf("[%s] Got error %v", name, err)
+		}
+		if actualValue, actualFound := deserialized.Get("a"); actualValue != nil || !actualFound {
+			t.Errorf("[%s] Got %v,%v expected %v,%v", name, actualValue, actualFound, nil, true)
+		}
+		if actualValue, actualFound := deserialized.Get("b"); actualValue != "x" || !actualFound {
+			t.Errorf("[%s] Got %v,%v expected %v,%v", name, actualValue, actualFound, "x", true)
+		}
+		if actualValue, expectedValue := deserialized.Size(), 2; actualValue != expectedValue {
+			t.Errorf("[%s] Got %v expected %v", name, actualValue, expectedValue)
+		}
+	}
+}
+
+func TestBidiMapNilValues(t *testing.T) {
+	for name, newMap := range implementations() {
+		m, ok := newMap().(maps.BidiMap)
+		if !ok {
+			continue
+		}
+		m.Put("a", nil)
+		m.Put("b", "x")
+
+		if actualValue, actualFound := m.GetKey(nil); actualValue != "a" || !actualFound {
+			t.Errorf("[%s] Got %v,%v expected %v,%v", name, actualValue, actualFound, "a", true)
+		}
+
+		// values are unique, so mapping another key to nil replaces the previous pair
+		m.Put("c", nil)
+		if actualValue, actualFound := m.GetKey(nil); actualValue != "c" || !actualFound {
+			t.Errorf("[%s] Got %v,%v expected %v,%v", name, actualValue, actualFound, "c", true)
+		}
+		if actualValue, actualFound := m.Get("a"); actualValue != nil || actualFound {
+			t.Errorf("[%s] Got %v,%v expected %v,%v", name, actualValue, actualFound, nil, false)
+		}
+		if actualValue, expectedValue := sortedStrings(m.Keys()), "[b c]"; actualValue != expectedValue {
+			t.Errorf("[%s] Got %v expected %v", name, actualValue, expectedValue)
+		}
+
+		// mapping the key to another value frees nil
+		m.Put("c", "y")
+		if actualValue, actualFound := m.GetKey(nil); actualValue != nil || actualFound {
+			t.Errorf("[%s] Got %v,%v expected %v,%v", name, actualValue, actualFound, nil, false)
+		}
+		if actualValue, expectedValue := sortedStrings(m.Values()), "[x y]"; actualValue != expectedValue {
+			t.Errorf("[%s] Got %v expected %v", name, actualValue, expectedValue)
+		}
+
+		m.Put("a", nil)
+		m.Remove("a")
+		if actualValue, actualFound := m.GetKey(nil); actualValue != nil || actualFound {
+			t.Errorf("[%s] Got %v,%v expected %v,%v", name, actualValue, actualFound, nil, false)
+		}
+		if actualValue, expectedValue := m.Size(), 2; actualValue != expectedValue {
+			t.Errorf("[%s] Got %v expected %v", name, actualValue, expectedValue)
+		}
+	}
+}
+
+// sortedStrings formats the elements in sorted order, so that maps with different orderings can be compared
+func sortedStrings(values []interface{}) string {
+	strings := make([]string, len(values))
+	for i, value := range values {
+		strings[i] = fmt.Sprintf("%v", value)
+	}
+	sort.Strings(strings)
+	return fmt.Sprintf("%v", strings)
+}
@@ -0,0 +1,113 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package radixtree
+
+import "github.com/emirpasic/gods/containers"
+
+// Assert Iterator implementation
+var _ containers.IteratorWithKey = (*Iterator)(nil)
+
+// Iterator holding the iterator's state
+type Iterator struct {
+	root     *node   // root of the iterated subtree, nil if there is nothing to iterate
+	rootKey  string  // key of the root of the iterated subtree
+	stack    []frame // path from the root to the current node
+	position position
+}
+
+// frame is a node on the iterator's path together with its key and the index of its next child to visit
+type frame struct {
+	node  *node
+	key   string
+	child int
+}
+
+type position byte
+
+const (
+	begin, between, end position = 0, 1, 2
+)
+
+// Iterator returns a stateful iterator whose elements are key/value pairs in lexicographic order.
+func (m *Map) Iterator() Iterator {
+	return Iterator{root: m.root, rootKey: "", position: begin}
+}
+
+// Next moves the iterator to the next element and returns true if there was a next element in the container.
+// If Next() returns true, then next element's key and value can be retrieved by Key() and Value().
+// If Next() was called for the first time, then it will point the iterator to the first element if it exists.
+// Modifies the state of the iterator.
+func (iterator *Iterator) Next() bool {
+	switch iterator.position {
+	case end:
+		return false
+	case begin:
+		iterator.position = between
+		if iterator.root != nil {
+			// a fresh stack, since copies of the iterator may still be walking the previous one
+			iterator.stack = []frame{{node: iterator.root, key: iterator.rootKey}}
+			if iterator.root.hasValue {
+				return true
+			}
+		}
+	}
+	// pre-order traversal with children visited in order of their labels yields keys in lexicographic order
+	for len(iterator.stack) > 0 {
+		top := &iterator.stack[len(iterator.stack)-1]
+		if top.child == len(top.node.children) {
+			iterator.stack = iterator.stack[:len(iterator.stack)-1]
+			continue
+		}
+		child := top.node.children[top.child]
+		top.child++
+		iterator.stack = append(iterator.stack, frame{node: child, key: top.key + child.prefix})
+		if child.hasValue {
+			return true
+		}
+	}
+	iterator.position = end
+	return false
+}
+
+// Value returns the current element's value.
+// Does not modify the state of the iterator.
+func (iterator *Iterator) Value() interface{} {
+	return iterator.stack[len(iterator.stack)-1].node.value
+}
+
+// Key returns the current element's key.
+// Does not modify the state of the iterator.
+func (iterator *Iterator) Key() interface{} {
+	return iterator.stack[len(iterator.stack)-1].key
+}
+
+// Begin resets the iterator to its initial state (one-before-first)
+// Call Next() to fetch the first element if any.
+func (iterator *Iterator) Begin() {
+	iterator.stack = nil
+	iterator.position = begin
+}
+
+// First moves the iterator to the first element and returns true if there was a first element in the container.
+// If First() returns true, then first element's key and value can be retrieved by Key() and Value().
+// Modifies the state of the iterator
+func (iterator *Iterator) First() bool {
+	iterator.Begin()
+	return iterator.Next()
+}
+
+// NextTo moves the iterator to the next element from current position that satisfies the condition given by the
+// passed function, and returns true if there was a next element in the container.
+// If NextTo() returns true, then next element's key and value can be retrieved by Key() and Value().
+// Modifies the state of the iterator.
+func (iterator *Iterator) NextTo(f func(key interface{}, value interface{}) bool) bool {
+	for iterator.Next() {
+		key, value := iterator.Key(), iterator.Value()
+		if f(key, value) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,270 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package radixtree implements a map with string keys backed by a radix tree (compressed trie).
+//
+// Elements are ordered lexicographically (byte-wise) by key in the map.
+//
+// Every edge of the tree is labeled with a non-empty string and the key of an element is the concatenation of the
+// labels on the path from the root to its node. Chains of nodes with a single child and no value are merged into one
+// edge, so the tree has at most 2n nodes. Lookups, insertions and removals take O(k) time, where k is the length of the
+// key, independently of the number of elements in the map, and all keys sharing a prefix are found in one subtree.
+//
+// Structure is not thread safe.
+//
+// Reference: https://en.wikipedia.org/wiki/Radix_tree
+package radixtree
+
+import (
+	"fmt"
+	"github.com/emirpasic/gods/maps"
+	"sort"
+	"strings"
+)
+
+// Assert Map implementation
+var _ maps.Map = (*Map)(nil)
+
+// Map holds the elements in a radix tree
+type Map struct {
+	root *node
+	size int
+}
+
+type node struct {
+	prefix   string // label of the edge leading to this node, empty only for the root
+	value    interface{}
+	hasValue bool
+	children []*node // ordered by the first byte of their prefix, which is unique among siblings
+}
+
+// New instantiates a radix tree map.
+func New() *Map {
+	return &Map{root: &node{}}
+}
+
+// Put inserts key-value pair into the map.
+// Key should be of type string, otherwise method panics.
+func (m *Map) Put(key interface{}, value interface{}) {
+	n, k := m.root, key.(string)
+	for k != "" {
+		i, child := n.child(k[0])
+		if child == nil {
+			n.children = append(n.children, nil)
+			copy(n.children[i+1:], n.children[i:])
+			n.children[i] = &node{prefix: k, value: value, hasValue: true}
+			m.size++
+			return
+		}
+		common := commonPrefixLength(child.prefix, k)
+		if common < len(child.prefix) {
+			// split the edge so that the common part of the labels leads to an intermediate node
+			split := &node{prefix: child.prefix[:common], children: []*node{child}}
+			child.prefix = child.prefix[common:]
+			n.children[i] = split
+			child = split
+		}
+		n, k = child, k[common:]
+	}
+	if !n.hasValue {
+		m.size++
+	}
+	n.value = value
+	n.hasValue = true
+}
+
+// Get searches the element in the map by key and returns its value or nil if key is not found in map.
+// Second return parameter is true if key was found, otherwise false.
+// Key should be of type string, otherwise method panics.
+func (m *Map) Get(key interface{}) (value interface{}, found bool) {
+	if n := m.lookup(key.(string)); n != nil && n.hasValue {
+		return n.value, true
+	}
+	return nil, false
+}
+
+// Remove removes the element from the map by key.
+// Key should be of type string, otherwise method panics.
+func (m *Map) Remove(key interface{}) {
+	if m.remove(m.root, key.(string)) {
+		m.size--
+	}
+}
+
+// Empty returns true if map does not contain any elements
+func (m *Map) Empty() bool {
+	return m.size == 0
+}
+
+// Size returns number of elements in the map.
+func (m *Map) Size() int {
+	return m.size
+}
+
+// Keys returns all keys in lexicographic order.
+func (m *Map) Keys() []interface{} {
+	keys := make([]interface{}, m.size)
+	it := m.Iterator()
+	for i := 0; it.Next(); i++ {
+		keys[i] = it.Key()
+	}
+	return keys
+}
+
+// Values returns all values in lexicographic order based on the key.
+func (m *Map) Values() []interface{} {
+	values := make([]interface{}, m.size)
+	it := m.Iterator()
+	for i := 0; it.Next(); i++ {
+		values[i] = it.Value()
+	}
+	return values
+}
+
+// Clear removes all elements from the map.
+func (m *Map) Clear() {
+	m.root = &node{}
+	m.size = 0
+}
+
+// PrefixSearch returns a stateful iterator over the key/value pairs whose key starts with the given prefix,
+// in lexicographic order. An empty prefix matches every key.
+func (m *Map) PrefixSearch(prefix string) Iterator {
+	n, key := m.subtree(prefix)
+	return Iterator{root: n, rootKey: key, position: begin}
+}
+
+// KeysWithPrefix returns all keys that start with the given prefix in lexicographic order.
+func (m *Map) KeysWithPrefix(prefix string) []interface{} {
+	keys := make([]interface{}, 0)
+	it := m.PrefixSearch(prefix)
+	for it.Next() {
+		keys = append(keys, it.Key())
+	}
+	return keys
+}
+
+// LongestPrefixMatch finds the longest key in the map that is a prefix of the given key, and returns that key and
+// its value. In case that no such key is found, then both returned values will be nil.
+// It's generally enough to check the first value (key) for nil, which determines if a match was found.
+//
+// This is the lookup performed by routing tables, e.g. the key "/api/users/1" matches "/api/users" before "/api".
+func (m *Map) LongestPrefixMatch(key string) (foundKey interface{}, foundValue interface{}) {
+	n, k, matched := m.root, key, 0
+	if n.hasValue {
+		foundKey, foundValue = "", n.value
+	}
+	for k != "" {
+		_, child := n.child(k[0])
+		if child == nil || !strings.HasPrefix(k, child.prefix) {
+			break
+		}
+		n, k, matched = child, k[len(child.prefix):], matched+len(child.prefix)
+		if n.hasValue {
+			foundKey, foundValue = key[:matched], n.value
+		}
+	}
+	return foundKey, foundValue
+}
+
+// Walk calls the given function for every key/value pair whose key starts with the given prefix, in lexicographic
+// order, until the function returns false. An empty prefix matches every key.
+func (m *Map) Walk(prefix string, f func(key interface{}, value interface{}) bool) {
+	it := m.PrefixSearch(prefix)
+	for it.Next() {
+		if !f(it.Key(), it.Value()) {
+			return
+		}
+	}
+}
+
+// String returns a string representation of container
+func (m *Map) String() string {
+	str := "RadixTree\nmap["
+	it := m.Iterator()
+	for it.Next() {
+		str += fmt.Sprintf("%v:%v ", it.Key(), it.Value())
+	}
+	return strings.TrimRight(str, " ") + "]"
+}
+
+// child returns the child of the node whose prefix starts with the given byte, or nil and the index at which such
+// a child would have to be inserted.
+func (n *node) child(b byte) (int, *node) {
+	i := sort.Search(len(n.children), func(i int) bool { return n.children[i].prefix[0] >= b })
+	if i < len(n.children) && n.children[i].prefix[0] == b {
+		return i, n.children[i]
+	}
+	return i, nil
+}
+
+// lookup returns the node whose key is exactly the given key, or nil if there is no such node.
+func (m *Map) lookup(key string) *node {
+	n := m.root
+	for key != "" {
+		_, child := n.child(key[0])
+		if child == nil || !strings.HasPrefix(key, child.prefix) {
+			return nil
+		}
+		n, key = child, key[len(child.prefix):]
+	}
+	return n
+}
+
+// subtree returns the topmost node whose key starts with the given prefix together with its key, i.e. the root of
+// the subtree holding all keys with that prefix, or nil if there is no such key.
+func (m *Map) subtree(prefix string) (*node, string) {
+	n, k := m.root, prefix
+	for k != "" {
+		_, child := n.child(k[0])
+		if child == nil {
+			return nil, ""
+		}
+		if strings.HasPrefix(child.prefix, k) {
+			// the prefix ends inside (or at the end of) the edge leading to the child
+			return child, prefix + child.prefix[len(k):]
+		}
+		if !strings.HasPrefix(k, child.prefix) {
+			return nil, ""
+		}
+		n, k = child, k[len(child.prefix):]
+	}
+	return n, prefix
+}
+
+// remove removes the key from the subtree of the node and returns true if it was found.
+// Nodes left without a value are pruned or merged with their only child, so that the tree stays compressed.
+func (m *Map) remove(n *node, key string) bool {
+	if key == "" {
+		if !n.hasValue {
+			return false
+		}
+		n.value = nil
+		n.hasValue = false
+		return true
+	}
+	i, child := n.child(key[0])
+	if child == nil || !strings.HasPrefix(key, child.prefix) || !m.remove(child, key[len(child.prefix):]) {
+		return false
+	}
+	if !child.hasValue {
+		switch len(child.children) {
+		case 0:
+			n.children = append(n.children[:i], n.children[i+1:]...)
+		case 1:
+			grandchild := child.children[0]
+			grandchild.prefix = child.prefix + grandchild.prefix
+			n.children[i] = grandchild
+		}
+	}
+	return true
+}
+
+func commonPrefixLength(a, b string) int {
+	i := 0
+	for i < len(a) && i < len(b) && a[i] == b[i] {
+		i++
+	}
+	return i
+}
@@ -0,0 +1,620 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package radixtree
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestMapPut(t *testing.T) {
+	m := New()
+	m.Put("romane", 1)
+	m.Put("romanus", 2)
+	m.Put("romulus", 3)
+	m.Put("rubens", 4)
+	m.Put("ruber", 5)
+	m.Put("rubicon", 6)
+	m.Put("rubicundus", 7)
+	m.Put("rom", 8)
+	m.Put("romane", 0) //overwrite
+	m.Put("", 9)
+
+	if actualValue := m.Size(); actualValue != 9 {
+		t.Errorf("Got %v expected %v", actualValue, 9)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", m.Keys()), "[ rom romane romanus romulus rubens ruber rubicon rubicundus]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", m.Values()), "[9 8 0 2 3 4 5 6 7]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	// key,expectedValue,expectedFound
+	tests1 := [][]interface{}{
+		{"", 9, true},
+		{"rom", 8, true},
+		{"romane", 0, true},
+		{"romanus", 2, true},
+		{"rubicundus", 7, true},
+		{"r", nil, false},
+		{"roman", nil, false},
+		{"romanes", nil, false},
+		{"x", nil, false},
+	}
+
+	for _, test := range tests1 {
+		// retrievals
+		actualValue, actualFound := m.Get(test[0])
+		if actualValue != test[1] || actualFound != test[2] {
+			t.Errorf("Got %v expected %v", actualValue, test[1])
+		}
+	}
+}
+
+func TestMapClear(t *testing.T) {
+	m := New()
+	m.Put("a", 1)
+	m.Put("ab", 2)
+	m.Put("b", 3)
+	if actualValue, expectedValue := m.Size(), 3; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	m.Clear()
+	if actualValue, expectedValue := m.Size(), 0; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := m.Empty(), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if _, found := m.Get("a"); found {
+		t.Errorf("Got %v expected %v", found, false)
+	}
+}
+
+func TestMapRemove(t *testing.T) {
+	m := New()
+	m.Put("test", 1)
+	m.Put("team", 2)
+	m.Put("toast", 3)
+	m.Put("te", 4)
+	m.Put("tea", 5)
+
+	m.Remove("t") // not present, but a prefix of all keys
+	m.Remove("teams")
+	m.Remove("tea")
+	m.Remove("tea")
+	m.Remove("toast")
+
+	if actualValue, expectedValue := fmt.Sprintf("%v", m.Keys()), "[te team test]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := m.Size(), 3; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	assertCompressed(m, t)
+
+	m.Remove("te")
+	m.Remove("team")
+	if actualValue, expectedValue := fmt.Sprintf("%v", m.Keys()), "[test]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := m.root.children[0].prefix, "test"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	assertCompressed(m, t)
+
+	m.Remove("test")
+	if actualValue, expectedValue := m.Empty(), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := len(m.root.children), 0; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestMapPrefixSearch(t *testing.T) {
+	m := New()
+	m.Put("apple", 1)
+	m.Put("application", 2)
+	m.Put("apply", 3)
+	m.Put("apt", 4)
+	m.Put("banana", 5)
+
+	tests := []struct {
+		prefix   string
+		expected string
+	}{
+		{"", "[apple application apply apt banana]"},
+		{"a", "[apple application apply apt]"},
+		{"ap", "[apple application apply apt]"},
+		{"appl", "[apple application apply]"},
+		{"appli", "[application]"},
+		{"apple", "[apple]"},
+		{"apples", "[]"},
+		{"b", "[banana]"},
+		{"c", "[]"},
+	}
+	for _, test := range tests {
+		if actualValue := fmt.Sprintf("%v", m.KeysWithPrefix(test.prefix)); actualValue != test.expected {
+			t.Errorf("Got %v expected %v for prefix %q", actualValue, test.expected, test.prefix)
+		}
+	}
+
+	it := m.PrefixSearch("appl")
+	count := 0
+	for it.Next() {
+		count++
+		if value, _ := m.Get(it.Key()); value != it.Value() {
+			t.Errorf("Got %v expected %v", it.Value(), value)
+		}
+	}
+	if actualValue, expectedValue := count, 3; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := it.First(), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := it.Key(), "apple"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	it = m.PrefixSearch("c")
+	if it.Next() || it.First() {
+		t.Errorf("Shouldn't iterate on a prefix without keys")
+	}
+}
+
+func TestMapLongestPrefixMatch(t *testing.T) {
+	m := New()
+
+	if k, v := m.LongestPrefixMatch("/api"); k != nil || v != nil {
+		t.Errorf("Got %v->%v expected %v->%v", k, v, nil, nil)
+	}
+
+	m.Put("/api", "api")
+	m.Put("/api/users", "users")
+	m.Put("/api/users/admin", "admin")
+	m.Put("/static", "static")
+
+	// key,expectedKey,expectedValue
+	tests := [][]interface{}{
+		{"/api", "/api", "api"},
+		{"/api/", "/api", "api"},
+		{"/api/users/1", "/api/users", "users"},
+		{"/api/users/admin/2", "/api/users/admin", "admin"},
+		{"/api/user", "/api", "api"},
+		{"/static/img.png", "/static", "static"},
+		{"/stat", nil, nil},
+		{"/", nil, nil},
+		{"", nil, nil},
+	}
+	for _, test := range tests {
+		actualKey, actualValue := m.LongestPrefixMatch(test[0].(string))
+		if actualKey != test[1] || actualValue != test[2] {
+			t.Errorf("Got %v->%v expected %v->%v", actualKey, actualValue, test[1], test[2])
+		}
+	}
+
+	m.Put("", "root")
+	if k, v := m.LongestPrefixMatch("/stat"); k != "" || v != "root" {
+		t.Errorf("Got %v->%v expected %v->%v", k, v, "", "root")
+	}
+}
+
+func TestMapWalk(t *testing.T) {
+	m := New()
+	m.Put("car", 1)
+	m.Put("cart", 2)
+	m.Put("carton", 3)
+	m.Put("cat", 4)
+	m.Put("dog", 5)
+
+	keys := []interface{}{}
+	m.Walk("car", func(key interface{}, value interface{}) bool {
+		keys = append(keys, key)
+		return true
+	})
+	if actualValue, expectedValue := fmt.Sprintf("%v", keys), "[car cart carton]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	keys = []interface{}{}
+	m.Walk("", func(key interface{}, value interface{}) bool {
+		keys = append(keys, key)
+		return value.(int) < 3
+	})
+	if actualValue, expectedValue := fmt.Sprintf("%v", keys), "[car cart carton]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestMapRandomOperations(t *testing.T) {
+	m := New()
+	random := rand.New(rand.NewSource(1))
+	expected := map[string]int{}
+
+	for i := 0; i < 5000; i++ {
+		key := strconv.FormatInt(int64(random.Intn(2000)), 3)
+		if random.Intn(3) == 0 {
+			m.Remove(key)
+			delete(expected, key)
+		} else {
+			m.Put(key, i)
+			expected[key] = i
+		}
+	}
+
+	keys := []string{}
+	for key := range expected {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	if actualValue, expectedValue := m.Size(), len(keys); actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	it := m.Iterator()
+	for _, key := range keys {
+		if !it.Next() || it.Key() != key || it.Value() != expected[key] {
+			t.Fatalf("Got %v->%v expected %v->%v", it.Key(), it.Value(), key, expected[key])
+		}
+	}
+	if it.Next() {
+		t.Errorf("Shouldn't iterate past the last key")
+	}
+	for _, prefix := range []string{"1", "12", "201", "2222"} {
+		actualKeys := m.KeysWithPrefix(prefix)
+		expectedKeys := []interface{}{}
+		for _, key := range keys {
+			if strings.HasPrefix(key, prefix) {
+				expectedKeys = append(expectedKeys, key)
+			}
+		}
+		if actualValue, expectedValue := fmt.Sprintf("%v", actualKeys), fmt.Sprintf("%v", expectedKeys); actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+	assertCompressed(m, t)
+}
+
+// assertCompressed checks that no node other than the root is without a value and has less than two children.
+func assertCompressed(m *Map, t *testing.T) {
+	var check func(n *node)
+	check = func(n *node) {
+		for i, child := range n.children {
+			if child.prefix == "" || (i > 0 && n.children[i-1].prefix[0] >= child.prefix[0]) {
+				t.Errorf("Children of node %q are not ordered by distinct first bytes", n.prefix)
+			}
+			if !child.hasValue && len(child.children) < 2 {
+				t.Errorf("Node %q should have been merged or pruned", child.prefix)
+			}
+			check(child)
+		}
+	}
+	check(m.root)
+}
+
+func TestMapEnumerable(t *testing.T) {
+	m := New()
+	m.Put("c", 3)
+	m.Put("a", 1)
+	m.Put("b", 2)
+
+	count := 0
+	m.Each(func(key interface{}, value interface{}) {
+		count++
+		if actualValue, expectedValue := count, value; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	})
+
+	mapped := m.Map(func(key interface{}, value interface{}) (interface{}, interface{}) {
+		return "key" + key.(string), value.(int) * value.(int)
+	})
+	if actualValue, expectedValue := fmt.Sprintf("%v", mapped.(*Map).Values()), "[1 4 9]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	selected := m.Select(func(key interface{}, value interface{}) bool {
+		return value.(int) >= 2
+	})
+	if actualValue, expectedValue := fmt.Sprintf("%v", selected.(*Map).Keys()), "[b c]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	if actualValue, expectedValue := m.Any(func(key interface{}, value interface{}) bool { return value.(int) > 2 }), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := m.All(func(key interface{}, value interface{}) bool { return value.(int) > 1 }), false; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if key, value := m.Find(func(key interface{}, value interface{}) bool { return value.(int) > 1 }); key != "b" || value != 2 {
+		t.Errorf("Got %v->%v expected %v->%v", key, value, "b", 2)
+	}
+}
+
+func TestMapIteratorNextOnEmpty(t *testing.T) {
+	m := New()
+	it := m.Iterator()
+	for it.Next() {
+		t.Errorf("Shouldn't iterate on empty map")
+	}
+}
+
+func TestMapIteratorBegin(t *testing.T) {
+	m := New()
+	it := m.Iterator()
+	it.Begin()
+	m.Put("b", 2)
+	m.Put("a", 1)
+	m.Put("c", 3)
+	for it.Next() {
+	}
+	it.Begin()
+	it.Next()
+	if key, value := it.Key(), it.Value(); key != "a" || value != 1 {
+		t.Errorf("Got %v,%v expected %v,%v", key, value, "a", 1)
+	}
+}
+
+func TestMapIteratorFirst(t *testing.T) {
+	m := New()
+	m.Put("c", 3)
+	m.Put("a", 1)
+	m.Put("b", 2)
+	it := m.Iterator()
+	if actualValue, expectedValue := it.First(), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if key, value := it.Key(), it.Value(); key != "a" || value != 1 {
+		t.Errorf("Got %v,%v expected %v,%v", key, value, "a", 1)
+	}
+}
+
+func TestMapIteratorCopy(t *testing.T) {
+	m := New()
+	m.Put("a", 1)
+	m.Put("ab", 2)
+	m.Put("abc", 3)
+	m.Put("b", 4)
+	it := m.Iterator()
+	it.Next()
+	it.Next()
+
+	// restarting a copy must not disturb the original
+	copied := it
+	keys := ""
+	for copied.First(); copied.Key() != "b"; copied.Next() {
+		keys += copied.Key().(string) + " "
+	}
+	if actualValue, expectedValue := keys, "a ab abc "; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	keys = it.Key().(string)
+	for it.Next() {
+		keys += " " + it.Key().(string)
+	}
+	if actualValue, expectedValue := keys, "ab abc b"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestMapIteratorNextTo(t *testing.T) {
+	// Sample seek function, i.e. string starting with "b"
+	seek := func(index interface{}, value interface{}) bool {
+		return strings.HasPrefix(value.(string), "b")
+	}
+
+	// NextTo (empty)
+	{
+		m := New()
+		it := m.Iterator()
+		for it.NextTo(seek) {
+			t.Errorf("Shouldn't iterate on empty map")
+		}
+	}
+
+	// NextTo (not found)
+	{
+		m := New()
+		m.Put("0", "xx")
+		m.Put("1", "yy")
+		it := m.Iterator()
+		for it.NextTo(seek) {
+			t.Errorf("Shouldn't iterate on empty map")
+		}
+	}
+
+	// NextTo (found)
+	{
+		m := New()
+		m.Put("0", "aa")
+		m.Put("1", "bb")
+		m.Put("2", "cc")
+		it := m.Iterator()
+		it.Begin()
+		if !it.NextTo(seek) {
+			t.Errorf("Shouldn't iterate on empty map")
+		}
+		if index, value := it.Key(), it.Value(); index != "1" || value.(string) != "bb" {
+			t.Errorf("Got %v,%v expected %v,%v", index, value, "1", "bb")
+		}
+		if !it.Next() {
+			t.Errorf("Should go to first element")
+		}
+		if index, value := it.Key(), it.Value(); index != "2" || value.(string) != "cc" {
+			t.Errorf("Got %v,%v expected %v,%v", index, value, "2", "cc")
+		}
+		if it.Next() {
+			t.Errorf("Should not go past last element")
+		}
+	}
+}
+
+func TestMapSerialization(t *testing.T) {
+	for i := 0; i < 10; i++ {
+		original := New()
+		original.Put("d", "4")
+		original.Put("e", "5")
+		original.Put("c", "3")
+		original.Put("b", "2")
+		original.Put("a", "1")
+
+		assertSerialization(original, "A", t)
+
+		serialized, err := original.ToJSON()
+		if err != nil {
+			t.Errorf("Got error %v", err)
+		}
+		assertSerialization(original, "B", t)
+
+		deserialized := New()
+		err = deserialized.FromJSON(serialized)
+		if err != nil {
+			t.Errorf("Got error %v", err)
+		}
+		assertSerialization(deserialized, "C", t)
+	}
+
+	m := New()
+	m.Put("a", 1.0)
+	m.Put("b", 2.0)
+	m.Put("c", 3.0)
+
+	_, err := json.Marshal([]interface{}{"a", "b", "c", m})
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+
+	err = json.Unmarshal([]byte(`{"a":1,"b":2}`), &m)
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+}
+
+func TestMapString(t *testing.T) {
+	c := New()
+	c.Put("a", 1)
+	if !strings.HasPrefix(c.String(), "RadixTree") {
+		t.Errorf("String should start with container name")
+	}
+}
+
+//noinspection GoBoolExpressions
+func assertSerialization(m *Map, txt string, t *testing.T) {
+	if actualValue := m.Keys(); false ||
+		actualValue[0].(string) != "a" ||
+		actualValue[1].(string) != "b" ||
+		actualValue[2].(string) != "c" ||
+		actualValue[3].(string) != "d" ||
+		actualValue[4].(string) != "e" {
+		t.Errorf("[%s] Got %v expected %v", txt, actualValue, "[a,b,c,d,e]")
+	}
+	if actualValue := m.Values(); false ||
+		actualValue[0].(string) != "1" ||
+		actualValue[1].(string) != "2" ||
+		actualValue[2].(string) != "3" ||
+		actualValue[3].(string) != "4" ||
+		actualValue[4].(string) != "5" {
+		t.Errorf("[%s] Got %v expected %v", txt, actualValue, "[1,2,3,4,5]")
+	}
+	if actualValue, expectedValue := m.Size(), 5; actualValue != expectedValue {
+		t.Errorf("[%s] Got %v expected %v", txt, actualValue, expectedValue)
+	}
+}
+
+func benchmarkGet(b *testing.B, m *Map, size int) {
+	for i := 0; i < b.N; i++ {
+		for n := 0; n < size; n++ {
+			m.Get(strconv.Itoa(n))
+		}
+	}
+}
+
+func benchmarkPut(b *testing.B, m *Map, size int) {
+	for i := 0; i < b.N; i++ {
+		for n := 0; n < size; n++ {
+			m.Put(strconv.Itoa(n), struct{}{})
+		}
+	}
+}
+
+func benchmarkRemove(b *testing.B, m *Map, size int) {
+	for i := 0; i < b.N; i++ {
+		for n := 0; n < size; n++ {
+			m.Remove(strconv.Itoa(n))
+		}
+	}
+}
+
+func BenchmarkRadixTreeGet100(b *testing.B) {
+	b.StopTimer()
+	size := 100
+	m := New()
+	for n := 0; n < size; n++ {
+		m.Put(strconv.Itoa(n), struct{}{})
+	}
+	b.StartTimer()
+	benchmarkGet(b, m, size)
+}
+
+func BenchmarkRadixTreeGet10000(b *testing.B) {
+	b.StopTimer()
+	size := 10000
+	m := New()
+	for n := 0; n < size; n++ {
+		m.Put(strconv.Itoa(n), struct{}{})
+	}
+	b.StartTimer()
+	benchmarkGet(b, m, size)
+}
+
+func BenchmarkRadixTreePut100(b *testing.B) {
+	b.StopTimer()
+	size := 100
+	m := New()
+	b.StartTimer()
+	benchmarkPut(b, m, size)
+}
+
+func BenchmarkRadixTreePut10000(b *testing.B) {
+	b.StopTimer()
+	size := 10000
+	m := New()
+	for n := 0; n < size; n++ {
+		m.Put(strconv.Itoa(n), struct{}{})
+	}
+	b.StartTimer()
+	benchmarkPut(b, m, size)
+}
+
+func BenchmarkRadixTreeRemove100(b *testing.B) {
+	b.StopTimer()
+	size := 100
+	m := New()
+	for n := 0; n < size; n++ {
+		m.Put(strconv.Itoa(n), struct{}{})
+	}
+	b.StartTimer()
+	benchmarkRemove(b, m, size)
+}
+
+func BenchmarkRadixTreeRemove10000(b *testing.B) {
+	b.StopTimer()
+	size := 10000
+	m := New()
+	for n := 0; n < size; n++ {
+		m.Put(strconv.Itoa(n), struct{}{})
+	}
+	b.StartTimer()
+	benchmarkRemove(b, m, size)
+}
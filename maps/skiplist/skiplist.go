@@ -0,0 +1,274 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package skiplist implements a map backed by a skip list.
+//
+// Elements are ordered by key in the map.
+//
+// A skip list is a probabilistic alternative to balanced trees. It keeps its elements in a sorted linked list with
+// additional express lanes of forward links, so that searches, insertions and removals take O(log n) expected time,
+// and in-order scans from any position simply follow the bottom list.
+//
+// Structure is not thread safe.
+//
+// Reference: https://en.wikipedia.org/wiki/Skip_list
+package skiplist
+
+import (
+	"fmt"
+	"github.com/emirpasic/gods/maps"
+	"github.com/emirpasic/gods/utils"
+	"math/rand"
+	"strings"
+	"time"
+)
+
+// Assert Map implementation
+var _ maps.Map = (*Map)(nil)
+
+const (
+	maxLevel    = 32 // enough for 4^32 elements
+	probability = 4  // inverse of the probability that a node is promoted to the next level
+)
+
+// Map holds the elements in a skip list
+type Map struct {
+	header     *node // sentinel whose forward links point to the first node on each level
+	tail       *node // last node on the bottom level
+	level      int   // number of levels currently in use
+	size       int
+	random     *rand.Rand
+	Comparator utils.Comparator
+}
+
+type node struct {
+	key   interface{}
+	value interface{}
+	next  []*node // forward links, one per level of the node
+	prev  *node   // backward link on the bottom level, nil for the first node
+}
+
+// NewWith instantiates a skip list map with the custom comparator.
+func NewWith(comparator utils.Comparator) *Map {
+	return NewWithSeed(comparator, time.Now().UnixNano())
+}
+
+// NewWithIntComparator instantiates a skip list map with the IntComparator, i.e. keys are of type int.
+func NewWithIntComparator() *Map {
+	return NewWith(utils.IntComparator)
+}
+
+// NewWithStringComparator instantiates a skip list map with the StringComparator, i.e. keys are of type string.
+func NewWithStringComparator() *Map {
+	return NewWith(utils.StringComparator)
+}
+
+// NewWithSeed instantiates a skip list map with the custom comparator, whose node levels are drawn from a random
+// generator with the given seed, i.e. the same sequence of operations always produces the same structure.
+func NewWithSeed(comparator utils.Comparator, seed int64) *Map {
+	return &Map{
+		header:     &node{next: make([]*node, maxLevel)},
+		random:     rand.New(rand.NewSource(seed)),
+		Comparator: comparator,
+	}
+}
+
+// Put inserts key-value pair into the map.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (m *Map) Put(key interface{}, value interface{}) {
+	if m.size == 0 {
+		// Assert key is of comparator's type for initial map
+		m.Comparator(key, key)
+	}
+
+	var update [maxLevel]*node
+	x := m.predecessor(key, &update)
+	if x = x.next[0]; x != nil && m.Comparator(x.key, key) == 0 {
+		x.key = key
+		x.value = value
+		return
+	}
+
+	level := m.randomLevel()
+	for i := m.level; i < level; i++ {
+		update[i] = m.header
+	}
+	if level > m.level {
+		m.level = level
+	}
+
+	inserted := &node{key: key, value: value, next: make([]*node, level)}
+	for i := 0; i < level; i++ {
+		inserted.next[i] = update[i].next[i]
+		update[i].next[i] = inserted
+	}
+	if update[0] != m.header {
+		inserted.prev = update[0]
+	}
+	if inserted.next[0] != nil {
+		inserted.next[0].prev = inserted
+	} else {
+		m.tail = inserted
+	}
+	m.size++
+}
+
+// Get searches the element in the map by key and returns its value or nil if key is not found in map.
+// Second return parameter is true if key was found, otherwise false.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (m *Map) Get(key interface{}) (value interface{}, found bool) {
+	if x := m.predecessor(key, nil).next[0]; x != nil && m.Comparator(x.key, key) == 0 {
+		return x.value, true
+	}
+	return nil, false
+}
+
+// Remove removes the element from the map by key.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (m *Map) Remove(key interface{}) {
+	var update [maxLevel]*node
+	x := m.predecessor(key, &update).next[0]
+	if x == nil || m.Comparator(x.key, key) != 0 {
+		return
+	}
+
+	for i := 0; i < len(x.next); i++ {
+		update[i].next[i] = x.next[i]
+	}
+	if x.next[0] != nil {
+		x.next[0].prev = x.prev
+	} else {
+		m.tail = x.prev
+	}
+	for m.level > 0 && m.header.next[m.level-1] == nil {
+		m.level--
+	}
+	m.size--
+}
+
+// Empty returns true if map does not contain any elements
+func (m *Map) Empty() bool {
+	return m.size == 0
+}
+
+// Size returns number of elements in the map.
+func (m *Map) Size() int {
+	return m.size
+}
+
+// Keys returns all keys in-order
+func (m *Map) Keys() []interface{} {
+	keys := make([]interface{}, m.size)
+	it := m.Iterator()
+	for i := 0; it.Next(); i++ {
+		keys[i] = it.Key()
+	}
+	return keys
+}
+
+// Values returns all values in-order based on the key.
+func (m *Map) Values() []interface{} {
+	values := make([]interface{}, m.size)
+	it := m.Iterator()
+	for i := 0; it.Next(); i++ {
+		values[i] = it.Value()
+	}
+	return values
+}
+
+// Clear removes all elements from the map.
+func (m *Map) Clear() {
+	m.header = &node{next: make([]*node, maxLevel)}
+	m.tail = nil
+	m.level = 0
+	m.size = 0
+}
+
+// Min returns the minimum key and its value from the map.
+// Returns nil, nil if map is empty.
+func (m *Map) Min() (key interface{}, value interface{}) {
+	if first := m.header.next[0]; first != nil {
+		return first.key, first.value
+	}
+	return nil, nil
+}
+
+// Max returns the maximum key and its value from the map.
+// Returns nil, nil if map is empty.
+func (m *Map) Max() (key interface{}, value interface{}) {
+	if m.tail != nil {
+		return m.tail.key, m.tail.value
+	}
+	return nil, nil
+}
+
+// Floor finds the floor key-value pair for the input key.
+// In case that no floor is found, then both returned values will be nil.
+// It's generally enough to check the first value (key) for nil, which determines if floor was found.
+//
+// Floor key is defined as the largest key that is smaller than or equal to the given key.
+// A floor key may not be found, either because the map is empty, or because
+// all keys in the map are larger than the given key.
+//
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (m *Map) Floor(key interface{}) (foundKey interface{}, foundValue interface{}) {
+	x := m.predecessor(key, nil)
+	if next := x.next[0]; next != nil && m.Comparator(next.key, key) == 0 {
+		return next.key, next.value
+	}
+	if x != m.header {
+		return x.key, x.value
+	}
+	return nil, nil
+}
+
+// Ceiling finds the ceiling key-value pair for the input key.
+// In case that no ceiling is found, then both returned values will be nil.
+// It's generally enough to check the first value (key) for nil, which determines if ceiling was found.
+//
+// Ceiling key is defined as the smallest key that is larger than or equal to the given key.
+// A ceiling key may not be found, either because the map is empty, or because
+// all keys in the map are smaller than the given key.
+//
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (m *Map) Ceiling(key interface{}) (foundKey interface{}, foundValue interface{}) {
+	if next := m.predecessor(key, nil).next[0]; next != nil {
+		return next.key, next.value
+	}
+	return nil, nil
+}
+
+// String returns a string representation of container
+func (m *Map) String() string {
+	str := "SkipListMap\nmap["
+	it := m.Iterator()
+	for it.Next() {
+		str += fmt.Sprintf("%v:%v ", it.Key(), it.Value())
+	}
+	return strings.TrimRight(str, " ") + "]"
+}
+
+// predecessor returns the last node whose key is strictly smaller than the given key, or the header if there is none.
+// If update is not nil, it is filled with the last such node on every level in use.
+func (m *Map) predecessor(key interface{}, update *[maxLevel]*node) *node {
+	x := m.header
+	for i := m.level - 1; i >= 0; i-- {
+		for x.next[i] != nil && m.Comparator(x.next[i].key, key) < 0 {
+			x = x.next[i]
+		}
+		if update != nil {
+			update[i] = x
+		}
+	}
+	return x
+}
+
+// randomLevel returns the number of levels for a new node, i.e. 1 with probability 3/4, 2 with probability 3/16, etc.
+func (m *Map) randomLevel() int {
+	level := 1
+	for level < maxLevel && m.random.Intn(probability) == 0 {
+		level++
+	}
+	return level
+}
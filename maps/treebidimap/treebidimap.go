@@ -5,6 +5,7 @@
 // Package treebidimap implements a bidirectional map backed by two red-black tree.
 //
 // This structure guarantees that the map will be in both ascending key and value order.
+// A nil value is kept aside from the values tree, since value comparators cannot order it, and comes first in value order.
 //
 // Other than key and value ordering, the goal with this structure is to avoid duplication of elements, which can be significant if contained elements are large.
 //
@@ -32,6 +33,7 @@ var _ maps.BidiMap = (*Map)(nil)
 type Map struct {
 	forwardMap      redblacktree.Tree
 	inverseMap      redblacktree.Tree
+	nilValue        *data // element whose value is nil, if any
 	keyComparator   utils.Comparator
 	valueComparator utils.Comparator
 }
@@ -64,14 +66,14 @@ func NewWithStringComparators() *Map {
 // Put inserts element into the map.
 func (m *Map) Put(key interface{}, value interface{}) {
 	if d, ok := m.forwardMap.Get(key); ok {
-		m.inverseMap.Remove(d.(*data).value)
+		m.removeInverse(d.(*data).value)
 	}
-	if d, ok := m.inverseMap.Get(value); ok {
-		m.forwardMap.Remove(d.(*data).key)
+	if d, ok := m.getInverse(value); ok {
+		m.forwardMap.Remove(d.key)
 	}
 	d := &data{key: key, value: value}
 	m.forwardMap.Put(key, d)
-	m.inverseMap.Put(value, d)
+	m.putInverse(d)
 }
 
 // Get searches the element in the map by key and returns its value or nil if key is not found in map.
@@ -86,8 +88,8 @@ func (m *Map) Get(key interface{}) (value interface{}, found bool) {
 // GetKey searches the element in the map by value and returns its key or nil if value is not found in map.
 // Second return parameter is true if value was found, otherwise false.
 func (m *Map) GetKey(value interface{}) (key interface{}, found bool) {
-	if d, ok := m.inverseMap.Get(value); ok {
-		return d.(*data).key, true
+	if d, ok := m.getInverse(value); ok {
+		return d.key, true
 	}
 	return nil, false
 }
@@ -96,7 +98,7 @@ func (m *Map) GetKey(value interface{}) (key interface{}, found bool) {
 func (m *Map) Remove(key interface{}) {
 	if d, found := m.forwardMap.Get(key); found {
 		m.forwardMap.Remove(key)
-		m.inverseMap.Remove(d.(*data).value)
+		m.removeInverse(d.(*data).value)
 	}
 }
 
@@ -117,13 +119,17 @@ func (m *Map) Keys() []interface{} {
 
 // Values returns all values (ordered).
 func (m *Map) Values() []interface{} {
-	return m.inverseMap.Keys()
+	if m.nilValue == nil {
+		return m.inverseMap.Keys()
+	}
+	return append([]interface{}{nil}, m.inverseMap.Keys()...)
 }
 
 // Clear removes all elements from the map.
 func (m *Map) Clear() {
 	m.forwardMap.Clear()
 	m.inverseMap.Clear()
+	m.nilValue = nil
 }
 
 // String returns a string representation of container
@@ -135,3 +141,29 @@ func (m *Map) String() string {
 	}
 	return strings.TrimRight(str, " ") + "]"
 }
+
+func (m *Map) getInverse(value interface{}) (*data, bool) {
+	if value == nil {
+		return m.nilValue, m.nilValue != nil
+	}
+	if d, ok := m.inverseMap.Get(value); ok {
+		return d.(*data), true
+	}
+	return nil, false
+}
+
+func (m *Map) putInverse(d *data) {
+	if d.value == nil {
+		m.nilValue = d
+		return
+	}
+	m.inverseMap.Put(d.value, d)
+}
+
+func (m *Map) removeInverse(value interface{}) {
+	if value == nil {
+		m.nilValue = nil
+		return
+	}
+	m.inverseMap.Remove(value)
+}
@@ -209,7 +209,7 @@ func TestMapMap(t *testing.T) {
 	m.Put("b", 2)
 	mappedMap := m.Map(func(key1 interface{}, value1 interface{}) (key2 interface{}, value2 interface{}) {
 		return key1, value1.(int) * value1.(int)
-	})
+	}).(*Map)
 	if actualValue, _ := mappedMap.Get("a"); actualValue != 1 {
 		t.Errorf("Got %v expected %v", actualValue, "mapped: a")
 	}
@@ -231,7 +231,7 @@ func TestMapSelect(t *testing.T) {
 	m.Put("b", 2)
 	selectedMap := m.Select(func(key interface{}, value interface{}) bool {
 		return key.(string) >= "a" && key.(string) <= "b"
-	})
+	}).(*Map)
 	if actualValue, _ := selectedMap.Get("a"); actualValue != 1 {
 		t.Errorf("Got %v expected %v", actualValue, "value: a")
 	}
@@ -309,7 +309,7 @@ func TestMapChaining(t *testing.T) {
 		return value.(int) > 1
 	}).Map(func(key interface{}, value interface{}) (interface{}, interface{}) {
 		return key.(string) + key.(string), value.(int) * value.(int)
-	})
+	}).(*Map)
 	if actualValue := chainedMap.Size(); actualValue != 2 {
 		t.Errorf("Got %v expected %v", actualValue, 2)
 	}
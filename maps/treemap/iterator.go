@@ -102,3 +102,146 @@ func (iterator *Iterator) PrevTo(f func(key interface{}, value interface{}) bool
 	}
 	return false
 }
+
+// Assert Iterator implementation
+var _ containers.ReverseIteratorWithKey = (*ViewIterator)(nil)
+
+// ViewIterator holding the iterator's state over a view
+type ViewIterator struct {
+	view     *View
+	iterator rbt.Iterator
+	position position
+}
+
+type position byte
+
+const (
+	begin, between, end position = 0, 1, 2
+)
+
+// Iterator returns a stateful iterator whose elements are key/value pairs of the view, in the view's order.
+func (view *View) Iterator() ViewIterator {
+	return ViewIterator{view: view, position: begin}
+}
+
+// Next moves the iterator to the next element and returns true if there was a next element in the view.
+// If Next() returns true, then next element's key and value can be retrieved by Key() and Value().
+// If Next() was called for the first time, then it will point the iterator to the first element if it exists.
+// Modifies the state of the iterator.
+func (iterator *ViewIterator) Next() bool {
+	switch iterator.position {
+	case end:
+		return false
+	case begin:
+		return iterator.moveTo(iterator.view.first(), end)
+	}
+	if !iterator.step(!iterator.view.descending) || !iterator.view.bounds.Contains(iterator.iterator.Key()) {
+		iterator.position = end
+		return false
+	}
+	return true
+}
+
+// Prev moves the iterator to the previous element and returns true if there was a previous element in the view.
+// If Prev() returns true, then previous element's key and value can be retrieved by Key() and Value().
+// Modifies the state of the iterator.
+func (iterator *ViewIterator) Prev() bool {
+	switch iterator.position {
+	case begin:
+		return false
+	case end:
+		return iterator.moveTo(iterator.view.last(), begin)
+	}
+	if !iterator.step(iterator.view.descending) || !iterator.view.bounds.Contains(iterator.iterator.Key()) {
+		iterator.position = begin
+		return false
+	}
+	return true
+}
+
+// Value returns the current element's value.
+// Does not modify the state of the iterator.
+func (iterator *ViewIterator) Value() interface{} {
+	return iterator.iterator.Value()
+}
+
+// Key returns the current element's key.
+// Does not modify the state of the iterator.
+func (iterator *ViewIterator) Key() interface{} {
+	return iterator.iterator.Key()
+}
+
+// Begin resets the iterator to its initial state (one-before-first)
+// Call Next() to fetch the first element if any.
+func (iterator *ViewIterator) Begin() {
+	iterator.position = begin
+}
+
+// End moves the iterator past the last element (one-past-the-end).
+// Call Prev() to fetch the last element if any.
+func (iterator *ViewIterator) End() {
+	iterator.position = end
+}
+
+// First moves the iterator to the first element and returns true if there was a first element in the view.
+// If First() returns true, then first element's key and value can be retrieved by Key() and Value().
+// Modifies the state of the iterator
+func (iterator *ViewIterator) First() bool {
+	iterator.Begin()
+	return iterator.Next()
+}
+
+// Last moves the iterator to the last element and returns true if there was a last element in the view.
+// If Last() returns true, then last element's key and value can be retrieved by Key() and Value().
+// Modifies the state of the iterator.
+func (iterator *ViewIterator) Last() bool {
+	iterator.End()
+	return iterator.Prev()
+}
+
+// NextTo moves the iterator to the next element from current position that satisfies the condition given by the
+// passed function, and returns true if there was a next element in the view.
+// If NextTo() returns true, then next element's key and value can be retrieved by Key() and Value().
+// Modifies the state of the iterator.
+func (iterator *ViewIterator) NextTo(f func(key interface{}, value interface{}) bool) bool {
+	for iterator.Next() {
+		key, value := iterator.Key(), iterator.Value()
+		if f(key, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// PrevTo moves the iterator to the previous element from current position that satisfies the condition given by the
+// passed function, and returns true if there was a next element in the view.
+// If PrevTo() returns true, then next element's key and value can be retrieved by Key() and Value().
+// Modifies the state of the iterator.
+func (iterator *ViewIterator) PrevTo(f func(key interface{}, value interface{}) bool) bool {
+	for iterator.Prev() {
+		key, value := iterator.Key(), iterator.Value()
+		if f(key, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// moveTo points the iterator to node, or to the given out-of-range position if node is nil.
+func (iterator *ViewIterator) moveTo(node *rbt.Node, otherwise position) bool {
+	if node == nil {
+		iterator.position = otherwise
+		return false
+	}
+	iterator.iterator = iterator.view.m.tree.IteratorAt(node)
+	iterator.position = between
+	return true
+}
+
+// step moves the underlying tree iterator one element up (in ascending key order) or down.
+func (iterator *ViewIterator) step(up bool) bool {
+	if up {
+		return iterator.iterator.Next()
+	}
+	return iterator.iterator.Prev()
+}
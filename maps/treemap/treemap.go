@@ -138,6 +138,115 @@ func (m *Map) Ceiling(key interface{}) (foundKey interface{}, foundValue interfa
 	return nil, nil
 }
 
+// GetByIndex returns the key and value at the given (zero-based) index in the key order of the map.
+// Third return parameter is true if index is within bounds, otherwise false.
+// Runs in O(log n) time.
+func (m *Map) GetByIndex(index int) (key interface{}, value interface{}, found bool) {
+	if node := m.tree.Select(index); node != nil {
+		return node.Key, node.Value, true
+	}
+	return nil, nil, false
+}
+
+// IndexOf returns the (zero-based) index of the key in the key order of the map, or -1 if key is not found.
+// Runs in O(log n) time.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (m *Map) IndexOf(key interface{}) int {
+	if m.tree.GetNode(key) == nil {
+		return -1
+	}
+	return m.tree.Rank(key)
+}
+
+// Lower finds the lower key-value pair for the input key.
+// In case that no lower is found, then both returned values will be nil.
+// It's generally enough to check the first value (key) for nil, which determines if lower was found.
+//
+// Lower key is defined as the largest key that is strictly smaller than the given key.
+// A lower key may not be found, either because the map is empty, or because
+// all keys in the map are larger than or equal to the given key.
+//
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (m *Map) Lower(key interface{}) (foundKey interface{}, foundValue interface{}) {
+	node, found := m.tree.Lower(key)
+	if found {
+		return node.Key, node.Value
+	}
+	return nil, nil
+}
+
+// Higher finds the higher key-value pair for the input key.
+// In case that no higher is found, then both returned values will be nil.
+// It's generally enough to check the first value (key) for nil, which determines if higher was found.
+//
+// Higher key is defined as the smallest key that is strictly larger than the given key.
+// A higher key may not be found, either because the map is empty, or because
+// all keys in the map are smaller than or equal to the given key.
+//
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (m *Map) Higher(key interface{}) (foundKey interface{}, foundValue interface{}) {
+	node, found := m.tree.Higher(key)
+	if found {
+		return node.Key, node.Value
+	}
+	return nil, nil
+}
+
+// PollFirst removes the minimum key and its value from the tree map and returns them.
+// Returns nil, nil if map is empty.
+func (m *Map) PollFirst() (key interface{}, value interface{}) {
+	node := m.tree.Left()
+	if node == nil {
+		return nil, nil
+	}
+	key, value = node.Key, node.Value
+	m.tree.Remove(key)
+	return key, value
+}
+
+// PollLast removes the maximum key and its value from the tree map and returns them.
+// Returns nil, nil if map is empty.
+func (m *Map) PollLast() (key interface{}, value interface{}) {
+	node := m.tree.Right()
+	if node == nil {
+		return nil, nil
+	}
+	key, value = node.Key, node.Value
+	m.tree.Remove(key)
+	return key, value
+}
+
+// SubMap returns a view of the portion of the map whose keys range from fromKey to toKey.
+// Each bound is included in the view if its inclusive flag is set.
+// The view is backed by the map, so changes in the map are reflected in the view.
+//
+// Keys should adhere to the comparator's type assertion, otherwise method panics.
+func (m *Map) SubMap(fromKey interface{}, fromInclusive bool, toKey interface{}, toInclusive bool) *View {
+	return &View{m: m, bounds: m.tree.Range().From(fromKey, fromInclusive).To(toKey, toInclusive)}
+}
+
+// HeadMap returns a view of the portion of the map whose keys are smaller than (or equal to, if inclusive is set) toKey.
+// The view is backed by the map, so changes in the map are reflected in the view.
+//
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (m *Map) HeadMap(toKey interface{}, inclusive bool) *View {
+	return &View{m: m, bounds: m.tree.Range().To(toKey, inclusive)}
+}
+
+// TailMap returns a view of the portion of the map whose keys are larger than (or equal to, if inclusive is set) fromKey.
+// The view is backed by the map, so changes in the map are reflected in the view.
+//
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (m *Map) TailMap(fromKey interface{}, inclusive bool) *View {
+	return &View{m: m, bounds: m.tree.Range().From(fromKey, inclusive)}
+}
+
+// DescendingMap returns a view of the whole map with its keys in reverse order.
+// The view is backed by the map, so changes in the map are reflected in the view.
+func (m *Map) DescendingMap() *View {
+	return &View{m: m, bounds: m.tree.Range(), descending: true}
+}
+
 // String returns a string representation of container
 func (m *Map) String() string {
 	str := "TreeMap\nmap["
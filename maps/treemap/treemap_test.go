@@ -7,6 +7,7 @@ package treemap
 import (
 	"encoding/json"
 	"fmt"
+	"github.com/emirpasic/gods/containers"
 	"github.com/emirpasic/gods/utils"
 	"strings"
 	"testing"
@@ -263,6 +264,240 @@ func sameElements(a []interface{}, b []interface{}) bool {
 	return true
 }
 
+func TestMapGetByIndexAndIndexOf(t *testing.T) {
+	m := NewWithStringComparator()
+
+	if actualKey, actualValue, found := m.GetByIndex(0); actualKey != nil || actualValue != nil || found {
+		t.Errorf("Got %v->%v expected %v->%v", actualKey, actualValue, nil, nil)
+	}
+	if actualValue := m.IndexOf("a"); actualValue != -1 {
+		t.Errorf("Got %v expected %v", actualValue, -1)
+	}
+
+	m.Put("c", 3)
+	m.Put("a", 1)
+	m.Put("b", 2)
+
+	for index, key := range []string{"a", "b", "c"} {
+		if actualKey, actualValue, found := m.GetByIndex(index); actualKey != key || actualValue != index+1 || !found {
+			t.Errorf("Got %v->%v expected %v->%v", actualKey, actualValue, key, index+1)
+		}
+		if actualValue := m.IndexOf(key); actualValue != index {
+			t.Errorf("Got %v expected %v", actualValue, index)
+		}
+	}
+	if actualKey, actualValue, found := m.GetByIndex(3); actualKey != nil || actualValue != nil || found {
+		t.Errorf("Got %v->%v expected %v->%v", actualKey, actualValue, nil, nil)
+	}
+	if actualValue := m.IndexOf("d"); actualValue != -1 {
+		t.Errorf("Got %v expected %v", actualValue, -1)
+	}
+}
+
+func TestMapLower(t *testing.T) {
+	m := NewWithIntComparator()
+	m.Put(7, "g")
+	m.Put(3, "c")
+	m.Put(1, "a")
+
+	// key,expectedKey,expectedValue,expectedFound
+	tests1 := [][]interface{}{
+		{-1, nil, nil, false},
+		{1, nil, nil, false},
+		{2, 1, "a", true},
+		{3, 1, "a", true},
+		{4, 3, "c", true},
+		{7, 3, "c", true},
+		{8, 7, "g", true},
+	}
+
+	for _, test := range tests1 {
+		// retrievals
+		actualKey, actualValue := m.Lower(test[0])
+		actualFound := actualKey != nil && actualValue != nil
+		if actualKey != test[1] || actualValue != test[2] || actualFound != test[3] {
+			t.Errorf("Got %v, %v, %v, expected %v, %v, %v", actualKey, actualValue, actualFound, test[1], test[2], test[3])
+		}
+	}
+}
+
+func TestMapHigher(t *testing.T) {
+	m := NewWithIntComparator()
+	m.Put(7, "g")
+	m.Put(3, "c")
+	m.Put(1, "a")
+
+	// key,expectedKey,expectedValue,expectedFound
+	tests1 := [][]interface{}{
+		{-1, 1, "a", true},
+		{1, 3, "c", true},
+		{2, 3, "c", true},
+		{3, 7, "g", true},
+		{6, 7, "g", true},
+		{7, nil, nil, false},
+		{8, nil, nil, false},
+	}
+
+	for _, test := range tests1 {
+		// retrievals
+		actualKey, actualValue := m.Higher(test[0])
+		actualFound := actualKey != nil && actualValue != nil
+		if actualKey != test[1] || actualValue != test[2] || actualFound != test[3] {
+			t.Errorf("Got %v, %v, %v, expected %v, %v, %v", actualKey, actualValue, actualFound, test[1], test[2], test[3])
+		}
+	}
+}
+
+func TestMapPollFirstAndPollLast(t *testing.T) {
+	m := NewWithIntComparator()
+
+	if actualKey, actualValue := m.PollFirst(); actualKey != nil || actualValue != nil {
+		t.Errorf("Got %v->%v expected %v->%v", actualKey, actualValue, nil, nil)
+	}
+	if actualKey, actualValue := m.PollLast(); actualKey != nil || actualValue != nil {
+		t.Errorf("Got %v->%v expected %v->%v", actualKey, actualValue, nil, nil)
+	}
+
+	m.Put(5, "e")
+	m.Put(1, "a")
+	m.Put(3, "c")
+
+	if actualKey, actualValue := m.PollFirst(); actualKey != 1 || actualValue != "a" {
+		t.Errorf("Got %v->%v expected %v->%v", actualKey, actualValue, 1, "a")
+	}
+	if actualKey, actualValue := m.PollLast(); actualKey != 5 || actualValue != "e" {
+		t.Errorf("Got %v->%v expected %v->%v", actualKey, actualValue, 5, "e")
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", m.Keys()), "[3]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestMapSubMap(t *testing.T) {
+	m := NewWithIntComparator()
+	for i := 1; i <= 9; i++ {
+		m.Put(i, i*10)
+	}
+
+	tests := []struct {
+		view     *View
+		expected string
+	}{
+		{m.SubMap(3, true, 6, true), "[3 4 5 6]"},
+		{m.SubMap(3, false, 6, false), "[4 5]"},
+		{m.SubMap(0, true, 100, true), "[1 2 3 4 5 6 7 8 9]"},
+		{m.SubMap(5, false, 5, true), "[]"},
+		{m.SubMap(7, true, 3, true), "[]"},
+		{m.HeadMap(3, true), "[1 2 3]"},
+		{m.HeadMap(3, false), "[1 2]"},
+		{m.HeadMap(1, false), "[]"},
+		{m.TailMap(7, true), "[7 8 9]"},
+		{m.TailMap(7, false), "[8 9]"},
+		{m.TailMap(9, false), "[]"},
+		{m.DescendingMap(), "[9 8 7 6 5 4 3 2 1]"},
+		{m.SubMap(3, true, 6, false).DescendingMap(), "[5 4 3]"},
+		{m.HeadMap(3, true).DescendingMap().DescendingMap(), "[1 2 3]"},
+	}
+
+	for _, test := range tests {
+		if actualValue := fmt.Sprintf("%v", test.view.Keys()); actualValue != test.expected {
+			t.Errorf("Got %v expected %v", actualValue, test.expected)
+		}
+		if actualValue, expectedValue := test.view.Size(), len(test.view.Values()); actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		if actualValue, expectedValue := test.view.Empty(), test.expected == "[]"; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+
+	view := m.SubMap(3, true, 6, false)
+	if actualValue, found := view.Get(4); actualValue != 40 || !found {
+		t.Errorf("Got %v expected %v", actualValue, 40)
+	}
+	if actualValue, found := view.Get(6); actualValue != nil || found {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+	if actualKey, actualValue := view.Min(); actualKey != 3 || actualValue != 30 {
+		t.Errorf("Got %v->%v expected %v->%v", actualKey, actualValue, 3, 30)
+	}
+	if actualKey, actualValue := view.Max(); actualKey != 5 || actualValue != 50 {
+		t.Errorf("Got %v->%v expected %v->%v", actualKey, actualValue, 5, 50)
+	}
+	if actualKey, actualValue := m.SubMap(5, false, 6, false).Min(); actualKey != nil || actualValue != nil {
+		t.Errorf("Got %v->%v expected %v->%v", actualKey, actualValue, nil, nil)
+	}
+
+	// views are live
+	m.Remove(4)
+	m.Put(6, 60)
+	m.Put(100, 1000)
+	if actualValue, expectedValue := fmt.Sprintf("%v", view.Keys()), "[3 5]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	view.Remove(7)
+	view.Remove(5)
+	if actualValue, expectedValue := fmt.Sprintf("%v", m.Keys()), "[1 2 3 6 7 8 9 100]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	m.TailMap(6, true).Clear()
+	if actualValue, expectedValue := fmt.Sprintf("%v", m.Keys()), "[1 2 3]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := view.String(), "TreeMapView\nmap[3:30]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestMapViewIterator(t *testing.T) {
+	m := NewWithIntComparator()
+	for i := 1; i <= 9; i++ {
+		m.Put(i, i*10)
+	}
+
+	for _, view := range []*View{m.SubMap(3, false, 7, true), m.SubMap(3, false, 7, true).DescendingMap()} {
+		expected := []interface{}{4, 5, 6, 7}
+		if view.descending {
+			expected = []interface{}{7, 6, 5, 4}
+		}
+
+		it := view.Iterator()
+		for i := 0; it.Next(); i++ {
+			if actualValue, expectedValue := it.Key(), expected[i]; actualValue != expectedValue {
+				t.Errorf("Got %v expected %v", actualValue, expectedValue)
+			}
+			if actualValue, expectedValue := it.Value(), expected[i].(int)*10; actualValue != expectedValue {
+				t.Errorf("Got %v expected %v", actualValue, expectedValue)
+			}
+		}
+		if actualValue := it.Next(); actualValue != false {
+			t.Errorf("Got %v expected %v", actualValue, false)
+		}
+		for i := len(expected) - 1; it.Prev(); i-- {
+			if actualValue, expectedValue := it.Key(), expected[i]; actualValue != expectedValue {
+				t.Errorf("Got %v expected %v", actualValue, expectedValue)
+			}
+		}
+		if actualValue := it.Prev(); actualValue != false {
+			t.Errorf("Got %v expected %v", actualValue, false)
+		}
+		if actualValue := it.Last(); actualValue != true || it.Key() != expected[3] {
+			t.Errorf("Got %v expected %v", it.Key(), expected[3])
+		}
+		if actualValue := it.First(); actualValue != true || it.Key() != expected[0] {
+			t.Errorf("Got %v expected %v", it.Key(), expected[0])
+		}
+		if actualValue := it.NextTo(func(key interface{}, value interface{}) bool { return key.(int)%2 == 0 }); actualValue != true || it.Key() != 6 {
+			t.Errorf("Got %v expected %v", it.Key(), 6)
+		}
+	}
+
+	empty := m.SubMap(5, false, 5, false).Iterator()
+	if empty.Next() || empty.Prev() || empty.First() || empty.Last() {
+		t.Errorf("Shouldn't iterate on empty view")
+	}
+}
+
 func TestMapEach(t *testing.T) {
 	m := NewWithStringComparator()
 	m.Put("c", 3)
@@ -300,7 +535,7 @@ func TestMapMap(t *testing.T) {
 	m.Put("b", 2)
 	mappedMap := m.Map(func(key1 interface{}, value1 interface{}) (key2 interface{}, value2 interface{}) {
 		return key1, value1.(int) * value1.(int)
-	})
+	}).(*Map)
 	if actualValue, _ := mappedMap.Get("a"); actualValue != 1 {
 		t.Errorf("Got %v expected %v", actualValue, "mapped: a")
 	}
@@ -315,6 +550,25 @@ func TestMapMap(t *testing.T) {
 	}
 }
 
+func TestMapEnumerableInterface(t *testing.T) {
+	m := NewWithStringComparator()
+	m.Put("c", 3)
+	m.Put("a", 1)
+	m.Put("b", 2)
+	var enumerable containers.EnumerableContainerWithKey = m
+	result := enumerable.Select(func(key interface{}, value interface{}) bool {
+		return value.(int) > 1
+	}).Map(func(key interface{}, value interface{}) (interface{}, interface{}) {
+		return key.(string) + key.(string), value.(int) * value.(int)
+	})
+	if _, ok := result.(*Map); !ok {
+		t.Errorf("Got %T expected %T", result, &Map{})
+	}
+	if actualValue, expectedValue := fmt.Sprint(result.Values()), "[4 9]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
 func TestMapSelect(t *testing.T) {
 	m := NewWithStringComparator()
 	m.Put("c", 3)
@@ -322,7 +576,7 @@ func TestMapSelect(t *testing.T) {
 	m.Put("b", 2)
 	selectedMap := m.Select(func(key interface{}, value interface{}) bool {
 		return key.(string) >= "a" && key.(string) <= "b"
-	})
+	}).(*Map)
 	if actualValue, _ := selectedMap.Get("a"); actualValue != 1 {
 		t.Errorf("Got %v expected %v", actualValue, "value: a")
 	}
@@ -400,7 +654,7 @@ func TestMapChaining(t *testing.T) {
 		return value.(int) > 1
 	}).Map(func(key interface{}, value interface{}) (interface{}, interface{}) {
 		return key.(string) + key.(string), value.(int) * value.(int)
-	})
+	}).(*Map)
 	if actualValue := chainedMap.Size(); actualValue != 2 {
 		t.Errorf("Got %v expected %v", actualValue, 2)
 	}
@@ -0,0 +1,128 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package treemap
+
+import (
+	"fmt"
+	"github.com/emirpasic/gods/containers"
+	rbt "github.com/emirpasic/gods/trees/redblacktree"
+	"strings"
+)
+
+// Assert Container implementation
+var _ containers.Container = (*View)(nil)
+
+// View is a live view of a range of keys of a tree map, optionally in descending order.
+// Nothing is copied, all operations are performed against the map's underlying red-black tree.
+type View struct {
+	m          *Map
+	bounds     rbt.Range
+	descending bool
+}
+
+// Get searches the element in the view by key and returns its value or nil if key is not found in the view.
+// Second return parameter is true if key was found, otherwise false.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (view *View) Get(key interface{}) (value interface{}, found bool) {
+	if !view.bounds.Contains(key) {
+		return nil, false
+	}
+	return view.m.tree.Get(key)
+}
+
+// Remove removes the element from the underlying map by key, if the key is within the view's range.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (view *View) Remove(key interface{}) {
+	if view.bounds.Contains(key) {
+		view.m.tree.Remove(key)
+	}
+}
+
+// Empty returns true if the view does not contain any elements.
+func (view *View) Empty() bool {
+	return view.first() == nil
+}
+
+// Size returns number of elements in the view.
+// Computed from the ranks of the view's bounds in the underlying tree, i.e. runs in O(log n) time.
+func (view *View) Size() int {
+	return view.bounds.Size()
+}
+
+// Keys returns all keys of the view in the view's order.
+func (view *View) Keys() []interface{} {
+	keys := []interface{}{}
+	for it := view.Iterator(); it.Next(); {
+		keys = append(keys, it.Key())
+	}
+	return keys
+}
+
+// Values returns all values of the view in the view's order based on the key.
+func (view *View) Values() []interface{} {
+	values := []interface{}{}
+	for it := view.Iterator(); it.Next(); {
+		values = append(values, it.Value())
+	}
+	return values
+}
+
+// Clear removes all elements of the view from the underlying map.
+func (view *View) Clear() {
+	for _, key := range view.Keys() {
+		view.m.tree.Remove(key)
+	}
+}
+
+// Min returns the minimum key and its value from the view.
+// Returns nil, nil if view is empty.
+func (view *View) Min() (key interface{}, value interface{}) {
+	if node := view.bounds.Lowest(); node != nil {
+		return node.Key, node.Value
+	}
+	return nil, nil
+}
+
+// Max returns the maximum key and its value from the view.
+// Returns nil, nil if view is empty.
+func (view *View) Max() (key interface{}, value interface{}) {
+	if node := view.bounds.Highest(); node != nil {
+		return node.Key, node.Value
+	}
+	return nil, nil
+}
+
+// DescendingMap returns a view of the same range with its keys in reverse order.
+func (view *View) DescendingMap() *View {
+	descending := *view
+	descending.descending = !view.descending
+	return &descending
+}
+
+// String returns a string representation of container
+func (view *View) String() string {
+	str := "TreeMapView\nmap["
+	it := view.Iterator()
+	for it.Next() {
+		str += fmt.Sprintf("%v:%v ", it.Key(), it.Value())
+	}
+	return strings.TrimRight(str, " ") + "]"
+}
+
+// first returns the first node in the view's order or nil if view is empty.
+func (view *View) first() *rbt.Node {
+	if view.descending {
+		return view.bounds.Highest()
+	}
+	return view.bounds.Lowest()
+}
+
+// last returns the last node in the view's order or nil if view is empty.
+func (view *View) last() *rbt.Node {
+	if view.descending {
+		return view.bounds.Lowest()
+	}
+	return view.bounds.Highest()
+}
@@ -0,0 +1,44 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ttlmap
+
+import "time"
+
+// Clock tells the map the current time, against which expirations are checked.
+type Clock interface {
+	Now() time.Time
+}
+
+// systemClock is the clock used by default, reading the system time
+type systemClock struct{}
+
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+// ManualClock is a clock that only moves when told to, so that expiration can be tested deterministically.
+type ManualClock struct {
+	now time.Time
+}
+
+// NewManualClock instantiates a clock stopped at the given time.
+func NewManualClock(now time.Time) *ManualClock {
+	return &ManualClock{now: now}
+}
+
+// Now returns the time the clock is stopped at.
+func (clock *ManualClock) Now() time.Time {
+	return clock.now
+}
+
+// Advance moves the clock forward by the given duration.
+func (clock *ManualClock) Advance(d time.Duration) {
+	clock.now = clock.now.Add(d)
+}
+
+// Set stops the clock at the given time.
+func (clock *ManualClock) Set(now time.Time) {
+	clock.now = now
+}
@@ -0,0 +1,50 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ttlmap
+
+import (
+	"github.com/emirpasic/gods/containers"
+	"github.com/emirpasic/gods/maps/linkedhashmap"
+	"github.com/emirpasic/gods/utils"
+)
+
+// Assert Serialization implementation
+var _ containers.JSONSerializer = (*Map)(nil)
+var _ containers.JSONDeserializer = (*Map)(nil)
+
+// ToJSON outputs the JSON representation of the unexpired elements of the map in insertion order.
+// Expiration times are not part of the representation.
+func (m *Map) ToJSON() ([]byte, error) {
+	elements := linkedhashmap.New()
+	it := m.Iterator()
+	for it.Next() {
+		elements.Put(utils.ToString(it.Key()), it.Value())
+	}
+	return elements.ToJSON()
+}
+
+// FromJSON populates the map from the input JSON representation, giving all elements the default time to live.
+func (m *Map) FromJSON(data []byte) error {
+	elements := linkedhashmap.New()
+	if err := elements.FromJSON(data); err != nil {
+		return err
+	}
+	m.Clear()
+	it := elements.Iterator()
+	for it.Next() {
+		m.Put(it.Key(), it.Value())
+	}
+	return nil
+}
+
+// UnmarshalJSON @implements json.Unmarshaler
+func (m *Map) UnmarshalJSON(bytes []byte) error {
+	return m.FromJSON(bytes)
+}
+
+// MarshalJSON @implements json.Marshaler
+func (m *Map) MarshalJSON() ([]byte, error) {
+	return m.ToJSON()
+}
@@ -0,0 +1,199 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package ttlmap implements a map whose entries expire after a time to live.
+//
+// Every entry is given a time to live when it is put, either its own or the default one of the map, after which it
+// expires. Expired entries are never returned: they are removed lazily when their key is accessed, or in bulk by
+// Sweep, and are reported to an optional callback when removed.
+//
+// The current time is read from a clock, which can be replaced to control time in tests.
+//
+// Elements are ordered by insertion in the map, as in a linked hash map.
+//
+// Structure is not thread safe.
+//
+// Reference: http://en.wikipedia.org/wiki/Associative_array
+package ttlmap
+
+import (
+	"fmt"
+	"github.com/emirpasic/gods/maps"
+	"github.com/emirpasic/gods/maps/linkedhashmap"
+	"strings"
+	"time"
+)
+
+// Assert Map implementation
+var _ maps.Map = (*Map)(nil)
+
+// Map holds the entries in a linked hash map together with their expiration time.
+type Map struct {
+	m          *linkedhashmap.Map
+	defaultTTL time.Duration
+	clock      Clock
+	onExpire   func(key interface{}, value interface{})
+}
+
+type entry struct {
+	value      interface{}
+	expiration time.Time // zero if the entry never expires
+}
+
+// New instantiates a map whose entries expire after the given default time to live.
+// A time to live of zero or less means that entries never expire unless put with their own time to live.
+func New(defaultTTL time.Duration) *Map {
+	return NewWith(defaultTTL, nil, nil)
+}
+
+// NewWith instantiates a map whose entries expire after the given default time to live, reading the time from the
+// given clock and calling onExpire with every expired entry when it is removed.
+// A nil clock reads the system time and a nil callback is not called.
+func NewWith(defaultTTL time.Duration, clock Clock, onExpire func(key interface{}, value interface{})) *Map {
+	if clock == nil {
+		clock = systemClock{}
+	}
+	return &Map{m: linkedhashmap.New(), defaultTTL: defaultTTL, clock: clock, onExpire: onExpire}
+}
+
+// Put inserts key-value pair into the map with the default time to live of the map.
+// Key should adhere to the comparable data type constraints from Go spec.
+func (m *Map) Put(key interface{}, value interface{}) {
+	m.PutWithTTL(key, value, m.defaultTTL)
+}
+
+// PutWithTTL inserts key-value pair into the map with the given time to live.
+// A time to live of zero or less means that the entry never expires.
+// Putting a key that exists replaces its value and its expiration.
+func (m *Map) PutWithTTL(key interface{}, value interface{}, ttl time.Duration) {
+	e := &entry{value: value}
+	if ttl > 0 {
+		e.expiration = m.clock.Now().Add(ttl)
+	}
+	m.expire(key)
+	m.m.Put(key, e)
+}
+
+// Get searches the element in the map by key and returns its value or nil if key is not found or has expired.
+// Second return parameter is true if key was found and has not expired, otherwise false.
+// An expired entry is removed from the map.
+// Key should adhere to the comparable data type constraints from Go spec.
+func (m *Map) Get(key interface{}) (value interface{}, found bool) {
+	if m.expire(key) {
+		return nil, false
+	}
+	if e, found := m.m.Get(key); found {
+		return e.(*entry).value, true
+	}
+	return nil, false
+}
+
+// Expiration returns the time at which the entry expires, or the zero time if it never expires.
+// Second return parameter is true if key was found and has not expired, otherwise false.
+// An expired entry is removed from the map.
+func (m *Map) Expiration(key interface{}) (expiration time.Time, found bool) {
+	if m.expire(key) {
+		return time.Time{}, false
+	}
+	if e, found := m.m.Get(key); found {
+		return e.(*entry).expiration, true
+	}
+	return time.Time{}, false
+}
+
+// Remove removes the element from the map by key.
+// The expiration callback is not called, even if the entry has expired.
+// Key should adhere to the comparable data type constraints from Go spec.
+func (m *Map) Remove(key interface{}) {
+	m.m.Remove(key)
+}
+
+// Sweep removes all expired entries from the map and returns their number.
+func (m *Map) Sweep() int {
+	now := m.clock.Now()
+	var expired []interface{}
+	it := m.m.Iterator()
+	for it.Next() {
+		if it.Value().(*entry).expiredAt(now) {
+			expired = append(expired, it.Key())
+		}
+	}
+	for _, key := range expired {
+		m.removeExpired(key)
+	}
+	return len(expired)
+}
+
+// Empty returns true if map does not contain any unexpired elements
+func (m *Map) Empty() bool {
+	return m.Size() == 0
+}
+
+// Size returns number of unexpired elements in the map.
+// Unlike in other maps, it takes linear time, as expired entries that were not removed yet have to be skipped.
+func (m *Map) Size() int {
+	size := 0
+	it := m.Iterator()
+	for it.Next() {
+		size++
+	}
+	return size
+}
+
+// Keys returns all keys of unexpired elements in insertion order.
+func (m *Map) Keys() []interface{} {
+	keys := []interface{}{}
+	it := m.Iterator()
+	for it.Next() {
+		keys = append(keys, it.Key())
+	}
+	return keys
+}
+
+// Values returns all values of unexpired elements in insertion order.
+func (m *Map) Values() []interface{} {
+	values := []interface{}{}
+	it := m.Iterator()
+	for it.Next() {
+		values = append(values, it.Value())
+	}
+	return values
+}
+
+// Clear removes all elements from the map.
+// The expiration callback is not called.
+func (m *Map) Clear() {
+	m.m.Clear()
+}
+
+// String returns a string representation of container
+func (m *Map) String() string {
+	str := "TTLMap\nmap["
+	it := m.Iterator()
+	for it.Next() {
+		str += fmt.Sprintf("%v:%v ", it.Key(), it.Value())
+	}
+	return strings.TrimRight(str, " ") + "]"
+}
+
+// expire removes the entry by key if it has expired and returns true if it did
+func (m *Map) expire(key interface{}) bool {
+	if e, found := m.m.Get(key); found && e.(*entry).expiredAt(m.clock.Now()) {
+		m.removeExpired(key)
+		return true
+	}
+	return false
+}
+
+func (m *Map) removeExpired(key interface{}) {
+	e, _ := m.m.Get(key)
+	m.m.Remove(key)
+	if m.onExpire != nil {
+		m.onExpire(key, e.(*entry).value)
+	}
+}
+
+func (e *entry) expiredAt(now time.Time) bool {
+	return !e.expiration.IsZero() && !now.Before(e.expiration)
+}
@@ -0,0 +1,410 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ttlmap
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+)
+
+var epoch = time.Date(2015, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+func TestMapPut(t *testing.T) {
+	m := New(0)
+	m.Put(5, "e")
+	m.Put(6, "f")
+	m.Put(7, "g")
+	m.Put(3, "c")
+	m.Put(4, "d")
+	m.Put(1, "x")
+	m.Put(2, "b")
+	m.Put(1, "a") //overwrite
+
+	if actualValue := m.Size(); actualValue != 7 {
+		t.Errorf("Got %v expected %v", actualValue, 7)
+	}
+	if actualValue, expectedValue := m.Keys(), []interface{}{5, 6, 7, 3, 4, 1, 2}; !sameElements(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := m.Values(), []interface{}{"e", "f", "g", "c", "d", "a", "b"}; !sameElements(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	// key,expectedValue,expectedFound
+	tests1 := [][]interface{}{
+		{1, "a", true},
+		{2, "b", true},
+		{3, "c", true},
+		{4, "d", true},
+		{5, "e", true},
+		{6, "f", true},
+		{7, "g", true},
+		{8, nil, false},
+	}
+
+	for _, test := range tests1 {
+		// retrievals
+		actualValue, actualFound := m.Get(test[0])
+		if actualValue != test[1] || actualFound != test[2] {
+			t.Errorf("Got %v expected %v", actualValue, test[1])
+		}
+	}
+}
+
+func TestMapExpiration(t *testing.T) {
+	clock := NewManualClock(epoch)
+	expired := []string{}
+	m := NewWith(time.Minute, clock, func(key interface{}, value interface{}) {
+		expired = append(expired, fmt.Sprintf("%v:%v", key, value))
+	})
+	m.Put("a", 1)
+	m.PutWithTTL("b", 2, time.Hour)
+	m.PutWithTTL("c", 3, 0) // never expires
+	clock.Advance(30 * time.Second)
+	m.Put("d", 4)
+
+	if actualValue, expectedValue := fmt.Sprintf("%v", m.Keys()), "[a b c d]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	clock.Advance(30 * time.Second) // a expires
+	if actualValue, expectedValue := fmt.Sprintf("%v", m.Keys()), "[b c d]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", m.Values()), "[2 3 4]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := m.Size(), 3; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := len(expired), 0; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	// accessing an expired key removes it
+	if actualValue, actualFound := m.Get("a"); actualValue != nil || actualFound {
+		t.Errorf("Got %v,%v expected %v,%v", actualValue, actualFound, nil, false)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", expired), "[a:1]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, actualFound := m.Get("a"); actualValue != nil || actualFound {
+		t.Errorf("Got %v,%v expected %v,%v", actualValue, actualFound, nil, false)
+	}
+	if actualValue, expectedValue := len(expired), 1; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	clock.Advance(time.Hour) // b and d expire
+	if actualValue, expectedValue := m.Sweep(), 2; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", expired), "[a:1 b:2 d:4]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := m.Sweep(), 0; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, actualFound := m.Get("c"); actualValue != 3 || !actualFound {
+		t.Errorf("Got %v,%v expected %v,%v", actualValue, actualFound, 3, true)
+	}
+}
+
+func TestMapPutRenewsExpiration(t *testing.T) {
+	clock := NewManualClock(epoch)
+	expired := []interface{}{}
+	m := NewWith(time.Minute, clock, func(key interface{}, value interface{}) {
+		expired = append(expired, value)
+	})
+	m.Put("a", 1)
+	m.Put("b", 2)
+	clock.Advance(45 * time.Second)
+	m.Put("a", 10) // renews a before it expires
+	if actualValue, actualFound := m.Expiration("a"); !actualValue.Equal(epoch.Add(105*time.Second)) || !actualFound {
+		t.Errorf("Got %v,%v expected %v,%v", actualValue, actualFound, epoch.Add(105*time.Second), true)
+	}
+
+	clock.Advance(45 * time.Second)
+	if actualValue, actualFound := m.Get("a"); actualValue != 10 || !actualFound {
+		t.Errorf("Got %v,%v expected %v,%v", actualValue, actualFound, 10, true)
+	}
+	m.Put("b", 20) // replaces b after it expired, which reports the old value
+	if actualValue, expectedValue := fmt.Sprintf("%v", expired), "[2]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", m.Keys()), "[a b]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	m.PutWithTTL("a", 100, -time.Second) // never expires
+	if actualValue, actualFound := m.Expiration("a"); !actualValue.IsZero() || !actualFound {
+		t.Errorf("Got %v,%v expected %v,%v", actualValue, actualFound, time.Time{}, true)
+	}
+	if actualValue, actualFound := m.Expiration("x"); !actualValue.IsZero() || actualFound {
+		t.Errorf("Got %v,%v expected %v,%v", actualValue, actualFound, time.Time{}, false)
+	}
+}
+
+func TestMapRemoveAndClear(t *testing.T) {
+	clock := NewManualClock(epoch)
+	expired := []interface{}{}
+	m := NewWith(time.Minute, clock, func(key interface{}, value interface{}) {
+		expired = append(expired, key)
+	})
+	m.Put("a", 1)
+	m.Put("b", 2)
+	m.Put("c", 3)
+	m.Remove("b")
+	m.Remove("x")
+	if actualValue, expectedValue := fmt.Sprintf("%v", m.Keys()), "[a c]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	clock.Advance(time.Minute)
+	m.Remove("a") // expired, but removed explicitly
+	if actualValue, expectedValue := m.Empty(), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	m.Clear()
+	if actualValue, expectedValue := m.Sweep(), 0; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := len(expired), 0; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestMapSystemClock(t *testing.T) {
+	m := New(time.Hour)
+	m.Put("a", 1)
+	m.PutWithTTL("b", 2, time.Nanosecond)
+	time.Sleep(time.Millisecond)
+	if actualValue, expectedValue := fmt.Sprintf("%v", m.Keys()), "[a]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestMapIterator(t *testing.T) {
+	clock := NewManualClock(epoch)
+	m := NewWith(time.Minute, clock, nil)
+	it := m.Iterator()
+	for it.Next() {
+		t.Errorf("Shouldn't iterate on empty map")
+	}
+
+	m.PutWithTTL("a", 1, time.Second)
+	m.Put("b", 2)
+	m.PutWithTTL("c", 3, time.Second)
+	m.Put("d", 4)
+	m.PutWithTTL("e", 5, time.Second)
+	clock.Advance(time.Second) // a, c and e expire
+
+	it = m.Iterator()
+	keys := []interface{}{}
+	for it.Next() {
+		keys = append(keys, it.Key())
+		if value, _ := m.Get(it.Key()); value != it.Value() {
+			t.Errorf("Got %v expected %v", it.Value(), value)
+		}
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", keys), "[b d]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	keys = []interface{}{}
+	for it.Prev() {
+		keys = append(keys, it.Key())
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", keys), "[d b]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	if actualValue, expectedValue := it.First(), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := it.Key(), "b"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := it.Last(), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := it.Key(), "d"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	it.Begin()
+	if actualValue, expectedValue := it.NextTo(func(key interface{}, value interface{}) bool { return value == 4 }), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := it.PrevTo(func(key interface{}, value interface{}) bool { return value == 1 }), false; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	// iterating does not remove expired entries
+	if actualValue, expectedValue := m.Sweep(), 3; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestMapSerialization(t *testing.T) {
+	clock := NewManualClock(epoch)
+	m := NewWith(time.Minute, clock, nil)
+	m.Put("a", 1.0)
+	m.PutWithTTL("b", 2.0, time.Second)
+	m.Put("c", 3.0)
+	clock.Advance(time.Second)
+
+	serialized, err := m.ToJSON()
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := string(serialized), `{"a":1,"c":3}`; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	deserialized := NewWith(time.Minute, clock, nil)
+	err = deserialized.FromJSON(serialized)
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", deserialized.Keys()), "[a c]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, actualFound := deserialized.Expiration("a"); !actualValue.Equal(epoch.Add(61*time.Second)) || !actualFound {
+		t.Errorf("Got %v,%v expected %v,%v", actualValue, actualFound, epoch.Add(61*time.Second), true)
+	}
+
+	numbers := New(0)
+	numbers.Put(1, "a")
+	serialized, err = numbers.ToJSON()
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := string(serialized), `{"1":"a"}`; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	_, err = json.Marshal([]interface{}{"a", "b", "c", m})
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+
+	err = json.Unmarshal([]byte(`{"a":1,"b":2}`), &m)
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+
+	err = m.FromJSON([]byte(`[1,2]`))
+	if err == nil {
+		t.Errorf("Expected an error")
+	}
+}
+
+func TestMapString(t *testing.T) {
+	c := New(0)
+	c.Put("a", 1)
+	if !strings.HasPrefix(c.String(), "TTLMap") {
+		t.Errorf("String should start with container name")
+	}
+}
+
+func sameElements(a []interface{}, b []interface{}) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func benchmarkGet(b *testing.B, m *Map, size int) {
+	for i := 0; i < b.N; i++ {
+		for n := 0; n < size; n++ {
+			m.Get(n)
+		}
+	}
+}
+
+func benchmarkPut(b *testing.B, m *Map, size int) {
+	for i := 0; i < b.N; i++ {
+		for n := 0; n < size; n++ {
+			m.Put(n, struct{}{})
+		}
+	}
+}
+
+func benchmarkSweep(b *testing.B, m *Map, clock *ManualClock, size int) {
+	for i := 0; i < b.N; i++ {
+		for n := 0; n < size; n++ {
+			m.PutWithTTL(n, struct{}{}, time.Duration(n%2+1)*time.Second)
+		}
+		clock.Advance(time.Second)
+		m.Sweep()
+		clock.Advance(time.Second)
+		m.Sweep()
+	}
+}
+
+func BenchmarkTTLMapGet100(b *testing.B) {
+	b.StopTimer()
+	size := 100
+	m := New(time.Hour)
+	for n := 0; n < size; n++ {
+		m.Put(n, struct{}{})
+	}
+	b.StartTimer()
+	benchmarkGet(b, m, size)
+}
+
+func BenchmarkTTLMapGet10000(b *testing.B) {
+	b.StopTimer()
+	size := 10000
+	m := New(time.Hour)
+	for n := 0; n < size; n++ {
+		m.Put(n, struct{}{})
+	}
+	b.StartTimer()
+	benchmarkGet(b, m, size)
+}
+
+func BenchmarkTTLMapPut100(b *testing.B) {
+	b.StopTimer()
+	size := 100
+	m := New(time.Hour)
+	b.StartTimer()
+	benchmarkPut(b, m, size)
+}
+
+func BenchmarkTTLMapPut10000(b *testing.B) {
+	b.StopTimer()
+	size := 10000
+	m := New(time.Hour)
+	b.StartTimer()
+	benchmarkPut(b, m, size)
+}
+
+func BenchmarkTTLMapSweep100(b *testing.B) {
+	b.StopTimer()
+	size := 100
+	clock := NewManualClock(epoch)
+	m := NewWith(0, clock, nil)
+	b.StartTimer()
+	benchmarkSweep(b, m, clock, size)
+}
+
+func BenchmarkTTLMapSweep10000(b *testing.B) {
+	b.StopTimer()
+	size := 10000
+	clock := NewManualClock(epoch)
+	m := NewWith(0, clock, nil)
+	b.StartTimer()
+	benchmarkSweep(b, m, clock, size)
+}
@@ -0,0 +1,158 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package hashmultimap implements a multimap backed by a hash table.
+//
+// Elements are unordered in the multimap, except for the values of a key, which are kept in the order they were put.
+//
+// Structure is not thread safe.
+//
+// Reference: https://en.wikipedia.org/wiki/Multimap
+package hashmultimap
+
+import (
+	"fmt"
+	"github.com/emirpasic/gods/lists/arraylist"
+	"github.com/emirpasic/gods/multimaps"
+)
+
+// Assert Multimap implementation
+var _ multimaps.Multimap = (*Multimap)(nil)
+
+// Multimap holds the values of every key in a list stored in go's native map
+type Multimap struct {
+	m       map[interface{}]*arraylist.List
+	entries int
+}
+
+// New instantiates a hash multimap.
+func New() *Multimap {
+	return &Multimap{m: make(map[interface{}]*arraylist.List)}
+}
+
+// Put associates the value with the key, after the values already associated with it.
+// Key should adhere to the comparable data type constraints from Go spec.
+func (m *Multimap) Put(key interface{}, value interface{}) {
+	m.PutAll(key, value)
+}
+
+// PutAll associates the values (one or more) with the key, after the values already associated with it.
+// Key should adhere to the comparable data type constraints from Go spec.
+func (m *Multimap) PutAll(key interface{}, values ...interface{}) {
+	if len(values) == 0 {
+		return
+	}
+	list, found := m.m[key]
+	if !found {
+		list = arraylist.New()
+		m.m[key] = list
+	}
+	list.Add(values...)
+	m.entries += len(values)
+}
+
+// Get returns all values associated with the key in the order they were put, or nil if key is not found.
+// Second return parameter is true if key was found, otherwise false.
+// Key should adhere to the comparable data type constraints from Go spec.
+func (m *Multimap) Get(key interface{}) (values []interface{}, found bool) {
+	if list, found := m.m[key]; found {
+		return list.Values(), true
+	}
+	return nil, false
+}
+
+// Remove removes the first occurrence of the value from the values associated with the key.
+// The key is removed as well once no value is associated with it.
+// Key should adhere to the comparable data type constraints from Go spec.
+func (m *Multimap) Remove(key interface{}, value interface{}) {
+	list, found := m.m[key]
+	if !found {
+		return
+	}
+	for index, element := range list.Values() {
+		if element == value {
+			list.Remove(index)
+			m.entries--
+			break
+		}
+	}
+	if list.Empty() {
+		delete(m.m, key)
+	}
+}
+
+// RemoveAll removes the key and all values associated with it.
+// Key should adhere to the comparable data type constraints from Go spec.
+func (m *Multimap) RemoveAll(key interface{}) {
+	if list, found := m.m[key]; found {
+		m.entries -= list.Size()
+		delete(m.m, key)
+	}
+}
+
+// ContainsEntry returns true if the value is associated with the key.
+// Key should adhere to the comparable data type constraints from Go spec.
+func (m *Multimap) ContainsEntry(key interface{}, value interface{}) bool {
+	if list, found := m.m[key]; found {
+		return list.Contains(value)
+	}
+	return false
+}
+
+// KeySet returns all distinct keys (random order).
+func (m *Multimap) KeySet() []interface{} {
+	keys := make([]interface{}, m.Size())
+	count := 0
+	for key := range m.m {
+		keys[count] = key
+		count++
+	}
+	return keys
+}
+
+// EntryCount returns number of key-value pairs in the multimap.
+func (m *Multimap) EntryCount() int {
+	return m.entries
+}
+
+// Empty returns true if multimap does not contain any elements
+func (m *Multimap) Empty() bool {
+	return m.Size() == 0
+}
+
+// Size returns number of distinct keys in the multimap.
+func (m *Multimap) Size() int {
+	return len(m.m)
+}
+
+// Clear removes all elements from the multimap.
+func (m *Multimap) Clear() {
+	m.m = make(map[interface{}]*arraylist.List)
+	m.entries = 0
+}
+
+// Values returns the values of all key-value pairs, grouped by key (random key order).
+func (m *Multimap) Values() []interface{} {
+	values := make([]interface{}, 0, m.entries)
+	for _, list := range m.m {
+		values = append(values, list.Values()...)
+	}
+	return values
+}
+
+// String returns a string representation of container
+func (m *Multimap) String() string {
+	str := "HashMultimap\n"
+	str += fmt.Sprintf("%v", m.values())
+	return str
+}
+
+// values returns the values of every key as go's native map, the representation used for output
+func (m *Multimap) values() map[interface{}][]interface{} {
+	values := make(map[interface{}][]interface{}, len(m.m))
+	for key, list := range m.m {
+		values[key] = list.Values()
+	}
+	return values
+}
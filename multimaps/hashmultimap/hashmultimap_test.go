@@ -0,0 +1,233 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package hashmultimap
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"testing"
+)
+
+func TestMultimapPut(t *testing.T) {
+	m := New()
+	m.Put(5, "e")
+	m.Put(1, "a")
+	m.PutAll(3, "c", "x")
+	m.Put(1, "b")
+
+	if actualValue, expectedValue := sortedStrings(m.KeySet()), "[1 3 5]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := sortedStrings(m.Values()), "[a b c e x]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := m.Size(), 3; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := m.EntryCount(), 5; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, _ := m.Get(1); fmt.Sprintf("%v", actualValue) != "[a b]" {
+		t.Errorf("Got %v expected %v", actualValue, "[a b]")
+	}
+
+	m.Remove(3, "c")
+	m.Remove(3, "x")
+	if actualValue, expectedValue := sortedStrings(m.KeySet()), "[1 5]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestMultimapIterator(t *testing.T) {
+	m := New()
+	it := m.Iterator()
+	for it.Next() {
+		t.Errorf("Shouldn't iterate on empty multimap")
+	}
+
+	m.PutAll("c", 4, 5, 6)
+	m.PutAll("a", 1, 2)
+	m.PutAll("b", 3)
+
+	it = m.Iterator()
+	entries := []interface{}{}
+	for it.Next() {
+		entries = append(entries, fmt.Sprintf("%v:%v", it.Key(), it.Value()))
+	}
+	if actualValue, expectedValue := sortedStrings(entries), "[a:1 a:2 b:3 c:4 c:5 c:6]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := it.Next(), false; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	if actualValue, expectedValue := it.First(), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v:%v", it.Key(), it.Value()), entries[0]; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	// values of a key are visited in the order they were put
+	it.Begin()
+	if actualValue, expectedValue := it.NextTo(func(key interface{}, value interface{}) bool { return key == "c" }), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	values := []interface{}{it.Value()}
+	for it.Next() && it.Key() == "c" {
+		values = append(values, it.Value())
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", values), "[4 5 6]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	it.Begin()
+	if actualValue, expectedValue := it.NextTo(func(key interface{}, value interface{}) bool { return value == 7 }), false; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestMultimapSerialization(t *testing.T) {
+	m := New()
+	m.PutAll("b", 3.0)
+	m.PutAll("a", 1.0, 2.0)
+
+	serialized, err := m.ToJSON()
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := string(serialized), `{"a":[1,2],"b":[3]}`; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	deserialized := New()
+	err = deserialized.FromJSON(serialized)
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := deserialized.String(), m.String(); actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	_, err = json.Marshal([]interface{}{"a", "b", "c", m})
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+
+	err = json.Unmarshal([]byte(`{"a":[1],"b":[2,3]}`), &m)
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := m.EntryCount(), 3; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestMultimapString(t *testing.T) {
+	m := New()
+	m.PutAll("a", 1, 2)
+	if !strings.HasPrefix(m.String(), "HashMultimap") {
+		t.Errorf("String should start with container name")
+	}
+	if actualValue, expectedValue := m.String(), "HashMultimap\nmap[a:[1 2]]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+// sortedStrings formats the elements in sorted order, as the multimap is unordered
+func sortedStrings(values []interface{}) string {
+	strings := make([]string, len(values))
+	for i, value := range values {
+		strings[i] = fmt.Sprintf("%v", value)
+	}
+	sort.Strings(strings)
+	return fmt.Sprintf("%v", strings)
+}
+
+func benchmarkGet(b *testing.B, m *Multimap, size int) {
+	for i := 0; i < b.N; i++ {
+		for n := 0; n < size; n++ {
+			m.Get(n)
+		}
+	}
+}
+
+func benchmarkPut(b *testing.B, m *Multimap, size int) {
+	for i := 0; i < b.N; i++ {
+		for n := 0; n < size; n++ {
+			m.Put(n%(size/10+1), n)
+		}
+	}
+}
+
+func benchmarkRemove(b *testing.B, m *Multimap, size int) {
+	for i := 0; i < b.N; i++ {
+		for n := 0; n < size; n++ {
+			m.Remove(n%(size/10+1), n)
+		}
+	}
+}
+
+func BenchmarkHashMultimapGet100(b *testing.B) {
+	b.StopTimer()
+	size := 100
+	m := New()
+	for n := 0; n < size; n++ {
+		m.Put(n%(size/10+1), n)
+	}
+	b.StartTimer()
+	benchmarkGet(b, m, size)
+}
+
+func BenchmarkHashMultimapGet10000(b *testing.B) {
+	b.StopTimer()
+	size := 10000
+	m := New()
+	for n := 0; n < size; n++ {
+		m.Put(n%(size/10+1), n)
+	}
+	b.StartTimer()
+	benchmarkGet(b, m, size)
+}
+
+func BenchmarkHashMultimapPut100(b *testing.B) {
+	b.StopTimer()
+	size := 100
+	m := New()
+	b.StartTimer()
+	benchmarkPut(b, m, size)
+}
+
+func BenchmarkHashMultimapPut10000(b *testing.B) {
+	b.StopTimer()
+	size := 10000
+	m := New()
+	b.StartTimer()
+	benchmarkPut(b, m, size)
+}
+
+func BenchmarkHashMultimapRemove100(b *testing.B) {
+	b.StopTimer()
+	size := 100
+	m := New()
+	for n := 0; n < size; n++ {
+		m.Put(n%(size/10+1), n)
+	}
+	b.StartTimer()
+	benchmarkRemove(b, m, size)
+}
+
+func BenchmarkHashMultimapRemove10000(b *testing.B) {
+	b.StopTimer()
+	size := 10000
+	m := New()
+	for n := 0; n < size; n++ {
+		m.Put(n%(size/10+1), n)
+	}
+	b.StartTimer()
+	benchmarkRemove(b, m, size)
+}
@@ -0,0 +1,89 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package hashmultimap
+
+import "github.com/emirpasic/gods/containers"
+
+// Assert Iterator implementation
+var _ containers.IteratorWithKey = (*Iterator)(nil)
+
+// Iterator holding the iterator's state
+type Iterator struct {
+	m        *Multimap
+	keys     []interface{}
+	keyIndex int
+	index    int
+}
+
+// Iterator returns a stateful iterator whose elements are key-value pairs, grouped by key (random key order).
+// The keys are taken when the iterator is created, so the multimap should not be modified while iterating.
+func (m *Multimap) Iterator() Iterator {
+	return Iterator{m: m, keys: m.KeySet(), keyIndex: -1, index: -1}
+}
+
+// Next moves the iterator to the next element and returns true if there was a next element in the container.
+// If Next() returns true, then next element's key and value can be retrieved by Key() and Value().
+// If Next() was called for the first time, then it will point the iterator to the first element if it exists.
+// Modifies the state of the iterator.
+func (iterator *Iterator) Next() bool {
+	if iterator.keyIndex >= 0 && iterator.keyIndex < len(iterator.keys) {
+		if list, found := iterator.m.m[iterator.keys[iterator.keyIndex]]; found && iterator.index+1 < list.Size() {
+			iterator.index++
+			return true
+		}
+	}
+	for iterator.keyIndex < len(iterator.keys) {
+		iterator.keyIndex++
+		if iterator.keyIndex < len(iterator.keys) {
+			if _, found := iterator.m.m[iterator.keys[iterator.keyIndex]]; found {
+				iterator.index = 0
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Value returns the current element's value.
+// Does not modify the state of the iterator.
+func (iterator *Iterator) Value() interface{} {
+	value, _ := iterator.m.m[iterator.Key()].Get(iterator.index)
+	return value
+}
+
+// Key returns the current element's key.
+// Does not modify the state of the iterator.
+func (iterator *Iterator) Key() interface{} {
+	return iterator.keys[iterator.keyIndex]
+}
+
+// Begin resets the iterator to its initial state (one-before-first)
+// Call Next() to fetch the first element if any.
+func (iterator *Iterator) Begin() {
+	iterator.keyIndex = -1
+	iterator.index = -1
+}
+
+// First moves the iterator to the first element and returns true if there was a first element in the container.
+// If First() returns true, then first element's key and value can be retrieved by Key() and Value().
+// Modifies the state of the iterator
+func (iterator *Iterator) First() bool {
+	iterator.Begin()
+	return iterator.Next()
+}
+
+// NextTo moves the iterator to the next element from current position that satisfies the condition given by the
+// passed function, and returns true if there was a next element in the container.
+// If NextTo() returns true, then next element's key and value can be retrieved by Key() and Value().
+// Modifies the state of the iterator.
+func (iterator *Iterator) NextTo(f func(key interface{}, value interface{}) bool) bool {
+	for iterator.Next() {
+		key, value := iterator.Key(), iterator.Value()
+		if f(key, value) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,237 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package linkedhashmultimap
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestMultimapPut(t *testing.T) {
+	m := New()
+	m.Put(5, "e")
+	m.Put(1, "a")
+	m.PutAll(3, "c", "x")
+	m.Put(1, "b")
+
+	if actualValue, expectedValue := fmt.Sprintf("%v", m.KeySet()), "[5 1 3]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", m.Values()), "[e a b c x]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := m.Size(), 3; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := m.EntryCount(), 5; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	m.Remove(3, "c")
+	m.Remove(3, "x")
+	if actualValue, expectedValue := fmt.Sprintf("%v", m.KeySet()), "[5 1]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	// a key put again after removal is inserted last
+	m.RemoveAll(5)
+	m.Put(5, "f")
+	if actualValue, expectedValue := fmt.Sprintf("%v", m.KeySet()), "[1 5]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestMultimapIterator(t *testing.T) {
+	m := New()
+	it := m.Iterator()
+	for it.Next() {
+		t.Errorf("Shouldn't iterate on empty multimap")
+	}
+	for it.Prev() {
+		t.Errorf("Shouldn't iterate on empty multimap")
+	}
+
+	m.PutAll("c", 4, 5, 6)
+	m.PutAll("a", 1, 2)
+	m.PutAll("b", 3)
+
+	it = m.Iterator()
+	entries := []string{}
+	for it.Next() {
+		entries = append(entries, fmt.Sprintf("%v:%v", it.Key(), it.Value()))
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", entries), "[c:4 c:5 c:6 a:1 a:2 b:3]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	entries = []string{}
+	for it.Prev() {
+		entries = append(entries, fmt.Sprintf("%v:%v", it.Key(), it.Value()))
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", entries), "[b:3 a:2 a:1 c:6 c:5 c:4]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	if actualValue, expectedValue := it.First(), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v:%v", it.Key(), it.Value()), "c:4"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := it.Last(), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v:%v", it.Key(), it.Value()), "b:3"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	it.Begin()
+	if actualValue, expectedValue := it.NextTo(func(key interface{}, value interface{}) bool { return value == 2 }), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := it.PrevTo(func(key interface{}, value interface{}) bool { return key == "c" }), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := it.Value(), 6; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	it.End()
+	if actualValue, expectedValue := it.PrevTo(func(key interface{}, value interface{}) bool { return value == 7 }), false; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestMultimapSerialization(t *testing.T) {
+	m := New()
+	m.PutAll("b", 3.0)
+	m.PutAll("a", 1.0, 2.0)
+
+	serialized, err := m.ToJSON()
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := string(serialized), `{"b":[3],"a":[1,2]}`; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	deserialized := New()
+	err = deserialized.FromJSON(serialized)
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := deserialized.String(), m.String(); actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	_, err = json.Marshal([]interface{}{"a", "b", "c", m})
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+
+	err = json.Unmarshal([]byte(`{"a":[1],"b":[2,3]}`), &m)
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := m.EntryCount(), 3; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestMultimapString(t *testing.T) {
+	m := New()
+	m.PutAll("a", 1, 2)
+	if !strings.HasPrefix(m.String(), "LinkedHashMultimap") {
+		t.Errorf("String should start with container name")
+	}
+	if actualValue, expectedValue := m.String(), "LinkedHashMultimap\nmap[a:[1 2]]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func benchmarkGet(b *testing.B, m *Multimap, size int) {
+	for i := 0; i < b.N; i++ {
+		for n := 0; n < size; n++ {
+			m.Get(n)
+		}
+	}
+}
+
+func benchmarkPut(b *testing.B, m *Multimap, size int) {
+	for i := 0; i < b.N; i++ {
+		for n := 0; n < size; n++ {
+			m.Put(n%(size/10+1), n)
+		}
+	}
+}
+
+func benchmarkRemove(b *testing.B, m *Multimap, size int) {
+	for i := 0; i < b.N; i++ {
+		for n := 0; n < size; n++ {
+			m.Remove(n%(size/10+1), n)
+		}
+	}
+}
+
+func BenchmarkLinkedHashMultimapGet100(b *testing.B) {
+	b.StopTimer()
+	size := 100
+	m := New()
+	for n := 0; n < size; n++ {
+		m.Put(n%(size/10+1), n)
+	}
+	b.StartTimer()
+	benchmarkGet(b, m, size)
+}
+
+func BenchmarkLinkedHashMultimapGet10000(b *testing.B) {
+	b.StopTimer()
+	size := 10000
+	m := New()
+	for n := 0; n < size; n++ {
+		m.Put(n%(size/10+1), n)
+	}
+	b.StartTimer()
+	benchmarkGet(b, m, size)
+}
+
+func BenchmarkLinkedHashMultimapPut100(b *testing.B) {
+	b.StopTimer()
+	size := 100
+	m := New()
+	b.StartTimer()
+	benchmarkPut(b, m, size)
+}
+
+func BenchmarkLinkedHashMultimapPut10000(b *testing.B) {
+	b.StopTimer()
+	size := 10000
+	m := New()
+	b.StartTimer()
+	benchmarkPut(b, m, size)
+}
+
+func BenchmarkLinkedHashMultimapRemove100(b *testing.B) {
+	b.StopTimer()
+	size := 100
+	m := New()
+	for n := 0; n < size; n++ {
+		m.Put(n%(size/10+1), n)
+	}
+	b.StartTimer()
+	benchmarkRemove(b, m, size)
+}
+
+func BenchmarkLinkedHashMultimapRemove10000(b *testing.B) {
+	b.StopTimer()
+	size := 10000
+	m := New()
+	for n := 0; n < size; n++ {
+		m.Put(n%(size/10+1), n)
+	}
+	b.StartTimer()
+	benchmarkRemove(b, m, size)
+}
@@ -0,0 +1,54 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package linkedhashmultimap
+
+import (
+	"encoding/json"
+	"github.com/emirpasic/gods/containers"
+	"github.com/emirpasic/gods/lists/arraylist"
+	"github.com/emirpasic/gods/maps/linkedhashmap"
+	"github.com/emirpasic/gods/utils"
+)
+
+// Assert Serialization implementation
+var _ containers.JSONSerializer = (*Multimap)(nil)
+var _ containers.JSONDeserializer = (*Multimap)(nil)
+
+// ToJSON outputs the JSON representation of the multimap, i.e. an object mapping every key to the array of its values.
+func (m *Multimap) ToJSON() ([]byte, error) {
+	elements := linkedhashmap.New()
+	it := m.m.Iterator()
+	for it.Next() {
+		elements.Put(utils.ToString(it.Key()), it.Value().(*arraylist.List).Values())
+	}
+	return elements.ToJSON()
+}
+
+// FromJSON populates the multimap from the input JSON representation, keeping the order of the members.
+func (m *Multimap) FromJSON(data []byte) error {
+	elements := make(map[string][]interface{})
+	if err := json.Unmarshal(data, &elements); err != nil {
+		return err
+	}
+	order := linkedhashmap.New()
+	if err := order.FromJSON(data); err != nil {
+		return err
+	}
+	m.Clear()
+	for _, key := range order.Keys() {
+		m.PutAll(key, elements[key.(string)]...)
+	}
+	return nil
+}
+
+// UnmarshalJSON @implements json.Unmarshaler
+func (m *Multimap) UnmarshalJSON(bytes []byte) error {
+	return m.FromJSON(bytes)
+}
+
+// MarshalJSON @implements json.Marshaler
+func (m *Multimap) MarshalJSON() ([]byte, error) {
+	return m.ToJSON()
+}
@@ -0,0 +1,35 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package multimaps provides an abstract Multimap interface.
+//
+// A multimap is a generalization of a map in which more than one value may be associated with a given key. The values
+// of a key are kept in the order they were put, and the same value may be associated with a key more than once.
+//
+// Elements of a multimap are its key-value pairs, or entries. Size is the number of distinct keys and EntryCount the
+// number of entries.
+//
+// Reference: https://en.wikipedia.org/wiki/Multimap
+package multimaps
+
+import "github.com/emirpasic/gods/containers"
+
+// Multimap interface that all multimaps implement
+type Multimap interface {
+	Put(key interface{}, value interface{})
+	PutAll(key interface{}, values ...interface{})
+	Get(key interface{}) (values []interface{}, found bool)
+	Remove(key interface{}, value interface{})
+	RemoveAll(key interface{})
+	ContainsEntry(key interface{}, value interface{}) bool
+	KeySet() []interface{}
+	EntryCount() int
+
+	containers.Container
+	// Empty() bool
+	// Size() int
+	// Clear()
+	// Values() []interface{}
+	// String() string
+}
@@ -0,0 +1,191 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package multimaps_test
+
+import (
+	"fmt"
+	"github.com/emirpasic/gods/containers"
+	"github.com/emirpasic/gods/multimaps"
+	"github.com/emirpasic/gods/multimaps/hashmultimap"
+	"github.com/emirpasic/gods/multimaps/linkedhashmultimap"
+	"github.com/emirpasic/gods/multimaps/treemultimap"
+	"sort"
+	"testing"
+)
+
+// implementations returns a fresh empty instance of every multimap, all of them with string keys
+func implementations() map[string]func() multimaps.Multimap {
+	return map[string]func() multimaps.Multimap{
+		"HashMultimap":       func() multimaps.Multimap { return hashmultimap.New() },
+		"TreeMultimap":       func() multimaps.Multimap { return treemultimap.NewWithStringComparator() },
+		"LinkedHashMultimap": func() multimaps.Multimap { return linkedhashmultimap.New() },
+	}
+}
+
+func TestMultimapPutAndGet(t *testing.T) {
+	for name, newMultimap := range implementations() {
+		m := newMultimap()
+		m.Put("a", 1)
+		m.Put("b", 2)
+		m.Put("a", 3)
+		m.PutAll("c", 4, 5, 4)
+		m.PutAll("d")
+
+		tests := [][]interface{}{
+			{"a", "[1 3]", true},
+			{"b", "[2]", true},
+			{"c", "[4 5 4]", true},
+			{"d", "[]", false},
+		}
+		for _, test := range tests {
+			actualValue, actualFound := m.Get(test[0])
+			if fmt.Sprintf("%v", actualValue) != test[1] || actualFound != test[2] {
+				t.Errorf("[%s] Got %v,%v expected %v,%v", name, actualValue, actualFound, test[1], test[2])
+			}
+		}
+		if actualValue, expectedValue := m.Size(), 3; actualValue != expectedValue {
+			t.Errorf("[%s] Got %v expected %v", name, actualValue, expectedValue)
+		}
+		if actualValue, expectedValue := m.EntryCount(), 6; actualValue != expectedValue {
+			t.Errorf("[%s] Got %v expected %v", name, actualValue, expectedValue)
+		}
+		if actualValue, expectedValue := sortedStrings(m.KeySet()), "[a b c]"; actualValue != expectedValue {
+			t.Errorf("[%s] Got %v expected %v", name, actualValue, expectedValue)
+		}
+		if actualValue, expectedValue := sortedStrings(m.Values()), "[1 2 3 4 4 5]"; actualValue != expectedValue {
+			t.Errorf("[%s] Got %v expected %v", name, actualValue, expectedValue)
+		}
+
+		// returned values are a copy
+		values, _ := m.Get("a")
+		values[0] = 10
+		if actualValue, expectedValue := m.ContainsEntry("a", 10), false; actualValue != expectedValue {
+			t.Errorf("[%s] Got %v expected %v", name, actualValue, expectedValue)
+		}
+	}
+}
+
+func TestMultimapRemove(t *testing.T) {
+	for name, newMultimap := range implementations() {
+		m := newMultimap()
+		m.PutAll("a", 1, 2, 1)
+		m.PutAll("b", 3)
+		m.Put("c", nil)
+
+		tests := [][]interface{}{
+			{"a", 1, true},
+			{"a", 2, true},
+			{"a", 3, false},
+			{"b", 3, true},
+			{"b", 1, false},
+			{"c", nil, true},
+			{"x", 1, false},
+		}
+		for _, test := range tests {
+			if actualValue := m.ContainsEntry(test[0], test[1]); actualValue != test[2] {
+				t.Errorf("[%s] Got %v expected %v", name, actualValue, test[2])
+			}
+		}
+
+		m.Remove("a", 1) // removes the first occurrence only
+		if actualValue, _ := m.Get("a"); fmt.Sprintf("%v", actualValue) != "[2 1]" {
+			t.Errorf("[%s] Got %v expected %v", name, actualValue, "[2 1]")
+		}
+		m.Remove("a", 3)
+		m.Remove("x", 1)
+		if actualValue, expectedValue := m.EntryCount(), 4; actualValue != expectedValue {
+			t.Errorf("[%s] Got %v expected %v", name, actualValue, expectedValue)
+		}
+
+		m.Remove("b", 3) // removes the key with its last value
+		if actualValue, actualFound := m.Get("b"); actualValue != nil || actualFound {
+			t.Errorf("[%s] Got %v,%v expected %v,%v", name, actualValue, actualFound, nil, false)
+		}
+		m.Remove("c", nil)
+		if actualValue, expectedValue := sortedStrings(m.KeySet()), "[a]"; actualValue != expectedValue {
+			t.Errorf("[%s] Got %v expected %v", name, actualValue, expectedValue)
+		}
+
+		m.RemoveAll("a")
+		m.RemoveAll("x")
+		if actualValue, expectedValue := m.Empty(), true; actualValue != expectedValue {
+			t.Errorf("[%s] Got %v expected %v", name, actualValue, expectedValue)
+		}
+		if actualValue, expectedValue := m.EntryCount(), 0; actualValue != expectedValue {
+			t.Errorf("[%s] Got %v expected %v", name, actualValue, expectedValue)
+		}
+
+		m.PutAll("a", 1, 2)
+		m.Clear()
+		if actualValue, expectedValue := m.Size()+m.EntryCount(), 0; actualValue != expectedValue {
+			t.Errorf("[%s] Got %v expected %v", name, actualValue, expectedValue)
+		}
+	}
+}
+
+func TestMultimapSerialization(t *testing.T) {
+	for name, newMultimap := range implementations() {
+		original := newMultimap()
+		original.PutAll("a", "x", nil, "x")
+		original.PutAll("b", 1.0)
+
+		serialized, err := original.(containers.JSONSerializer).ToJSON()
+		if err != nil {
+			t.Errorf("[%s] Got error %v", name, err)
+		}
+
+		deserialized := newMultimap()
+		err = deserialized.(containers.JSONDeserializer).FromJSON(serialized)
+		if err != nil {
+			t.Errorf("[%s] Got error %v", name, err)
+		}
+		if actualValue, _ := deserialized.Get("a"); fmt.Sprintf("%v", actualValue) != "[x <nil> x]" {
+			t.Errorf("[%s] Got %v expected %v", name, actualValue, "[x <nil> x]")
+		}
+		if actualValue, _ := deserialized.Get("b"); fmt.Sprintf("%v", actualValue) != "[1]" {
+			t.Errorf("[%s] Got %v expected %v", name, actualValue, "[1]")
+		}
+		if actualValue, expectedValue := deserialized.EntryCount(), 4; actualValue != expectedValue {
+			t.Errorf("[%s] Got %v expected %v", name, actualValue, expectedValue)
+		}
+
+		for _, invalid := range []string{`[1,2]`, `{"a":1}`} {
+			err = deserialized.(containers.JSONDeserializer).FromJSON([]byte(invalid))
+			if err == nil {
+				t.Errorf("[%s] Expected an error", name)
+			}
+		}
+	}
+}
+
+func TestMultimapSerializationNonStringKeys(t *testing.T) {
+	for name, newMultimap := range map[string]func() multimaps.Multimap{
+		"HashMultimap":       func() multimaps.Multimap { return hashmultimap.New() },
+		"TreeMultimap":       func() multimaps.Multimap { return treemultimap.NewWithIntComparator() },
+		"LinkedHashMultimap": func() multimaps.Multimap { return linkedhashmultimap.New() },
+	} {
+		m := newMultimap()
+		m.PutAll(1, "a", "b")
+		m.PutAll(2, "c")
+
+		serialized, err := m.(containers.JSONSerializer).ToJSON()
+		if err != nil {
+			t.Errorf("[%s] Got error %v", name, err)
+		}
+		if actualValue, expectedValue := string(serialized), `{"1":["a","b"],"2":["c"]}`; actualValue != expectedValue {
+			t.Errorf("[%s] Got %v expected %v", name, actualValue, expectedValue)
+		}
+	}
+}
+
+// sortedStrings formats the elements in sorted order, so that multimaps with different orderings can be compared
+func sortedStrings(values []interface{}) string {
+	strings := make([]string, len(values))
+	for i, value := range values {
+		strings[i] = fmt.Sprintf("%v", value)
+	}
+	sort.Strings(strings)
+	return fmt.Sprintf("%v", strings)
+}
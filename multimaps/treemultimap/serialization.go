@@ -0,0 +1,50 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package treemultimap
+
+import (
+	"encoding/json"
+	"github.com/emirpasic/gods/containers"
+	"github.com/emirpasic/gods/lists/arraylist"
+	"github.com/emirpasic/gods/maps/linkedhashmap"
+	"github.com/emirpasic/gods/utils"
+)
+
+// Assert Serialization implementation
+var _ containers.JSONSerializer = (*Multimap)(nil)
+var _ containers.JSONDeserializer = (*Multimap)(nil)
+
+// ToJSON outputs the JSON representation of the multimap, i.e. an object mapping every key to the array of its values.
+func (m *Multimap) ToJSON() ([]byte, error) {
+	elements := linkedhashmap.New()
+	it := m.m.Iterator()
+	for it.Next() {
+		elements.Put(utils.ToString(it.Key()), it.Value().(*arraylist.List).Values())
+	}
+	return elements.ToJSON()
+}
+
+// FromJSON populates the multimap from the input JSON representation.
+func (m *Multimap) FromJSON(data []byte) error {
+	elements := make(map[string][]interface{})
+	err := json.Unmarshal(data, &elements)
+	if err == nil {
+		m.Clear()
+		for key, values := range elements {
+			m.PutAll(key, values...)
+		}
+	}
+	return err
+}
+
+// UnmarshalJSON @implements json.Unmarshaler
+func (m *Multimap) UnmarshalJSON(bytes []byte) error {
+	return m.FromJSON(bytes)
+}
+
+// MarshalJSON @implements json.Marshaler
+func (m *Multimap) MarshalJSON() ([]byte, error) {
+	return m.ToJSON()
+}
@@ -0,0 +1,168 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package treemultimap implements a multimap backed by a red-black tree.
+//
+// Elements are ordered by key in the multimap, and the values of a key are kept in the order they were put.
+//
+// Structure is not thread safe.
+//
+// Reference: https://en.wikipedia.org/wiki/Multimap
+package treemultimap
+
+import (
+	"fmt"
+	"github.com/emirpasic/gods/lists/arraylist"
+	"github.com/emirpasic/gods/maps/treemap"
+	"github.com/emirpasic/gods/multimaps"
+	"github.com/emirpasic/gods/utils"
+	"strings"
+)
+
+// Assert Multimap implementation
+var _ multimaps.Multimap = (*Multimap)(nil)
+
+// Multimap holds the values of every key in a list stored in a tree map
+type Multimap struct {
+	m       *treemap.Map
+	entries int
+}
+
+// NewWith instantiates a tree multimap with the custom comparator.
+func NewWith(comparator utils.Comparator) *Multimap {
+	return &Multimap{m: treemap.NewWith(comparator)}
+}
+
+// NewWithIntComparator instantiates a tree multimap with the IntComparator, i.e. keys are of type int.
+func NewWithIntComparator() *Multimap {
+	return &Multimap{m: treemap.NewWithIntComparator()}
+}
+
+// NewWithStringComparator instantiates a tree multimap with the StringComparator, i.e. keys are of type string.
+func NewWithStringComparator() *Multimap {
+	return &Multimap{m: treemap.NewWithStringComparator()}
+}
+
+// Put associates the value with the key, after the values already associated with it.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (m *Multimap) Put(key interface{}, value interface{}) {
+	m.PutAll(key, value)
+}
+
+// PutAll associates the values (one or more) with the key, after the values already associated with it.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (m *Multimap) PutAll(key interface{}, values ...interface{}) {
+	if len(values) == 0 {
+		return
+	}
+	list, found := m.list(key)
+	if !found {
+		list = arraylist.New()
+		m.m.Put(key, list)
+	}
+	list.Add(values...)
+	m.entries += len(values)
+}
+
+// Get returns all values associated with the key in the order they were put, or nil if key is not found.
+// Second return parameter is true if key was found, otherwise false.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (m *Multimap) Get(key interface{}) (values []interface{}, found bool) {
+	if list, found := m.list(key); found {
+		return list.Values(), true
+	}
+	return nil, false
+}
+
+// Remove removes the first occurrence of the value from the values associated with the key.
+// The key is removed as well once no value is associated with it.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (m *Multimap) Remove(key interface{}, value interface{}) {
+	list, found := m.list(key)
+	if !found {
+		return
+	}
+	for index, element := range list.Values() {
+		if element == value {
+			list.Remove(index)
+			m.entries--
+			break
+		}
+	}
+	if list.Empty() {
+		m.m.Remove(key)
+	}
+}
+
+// RemoveAll removes the key and all values associated with it.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (m *Multimap) RemoveAll(key interface{}) {
+	if list, found := m.list(key); found {
+		m.entries -= list.Size()
+		m.m.Remove(key)
+	}
+}
+
+// ContainsEntry returns true if the value is associated with the key.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (m *Multimap) ContainsEntry(key interface{}, value interface{}) bool {
+	if list, found := m.list(key); found {
+		return list.Contains(value)
+	}
+	return false
+}
+
+// KeySet returns all distinct keys in-order.
+func (m *Multimap) KeySet() []interface{} {
+	return m.m.Keys()
+}
+
+// EntryCount returns number of key-value pairs in the multimap.
+func (m *Multimap) EntryCount() int {
+	return m.entries
+}
+
+// Empty returns true if multimap does not contain any elements
+func (m *Multimap) Empty() bool {
+	return m.m.Empty()
+}
+
+// Size returns number of distinct keys in the multimap.
+func (m *Multimap) Size() int {
+	return m.m.Size()
+}
+
+// Clear removes all elements from the multimap.
+func (m *Multimap) Clear() {
+	m.m.Clear()
+	m.entries = 0
+}
+
+// Values returns the values of all key-value pairs in-order based on the key.
+func (m *Multimap) Values() []interface{} {
+	values := make([]interface{}, 0, m.entries)
+	it := m.m.Iterator()
+	for it.Next() {
+		values = append(values, it.Value().(*arraylist.List).Values()...)
+	}
+	return values
+}
+
+// String returns a string representation of container
+func (m *Multimap) String() string {
+	str := "TreeMultimap\nmap["
+	it := m.m.Iterator()
+	for it.Next() {
+		str += fmt.Sprintf("%v:%v ", it.Key(), it.Value().(*arraylist.List).Values())
+	}
+	return strings.TrimRight(str, " ") + "]"
+}
+
+// list returns the list of values of the key
+func (m *Multimap) list(key interface{}) (list *arraylist.List, found bool) {
+	if value, found := m.m.Get(key); found {
+		return value.(*arraylist.List), true
+	}
+	return nil, false
+}
@@ -0,0 +1,215 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package hashmultiset implements a multiset backed by a hash table.
+//
+// Structure is not thread safe.
+//
+// References: https://en.wikipedia.org/wiki/Multiset
+package hashmultiset
+
+import (
+	"fmt"
+	"github.com/emirpasic/gods/multisets"
+	"strings"
+)
+
+// Assert Multiset implementation
+var _ multisets.Multiset = (*Multiset)(nil)
+
+// Multiset holds the count of every element in go's native map
+type Multiset struct {
+	counts map[interface{}]int
+	size   int
+}
+
+// New instantiates a new empty multiset and adds one occurrence of each of the passed values, if any
+func New(values ...interface{}) *Multiset {
+	multiset := &Multiset{counts: make(map[interface{}]int)}
+	for _, value := range values {
+		multiset.Add(value, 1)
+	}
+	return multiset
+}
+
+// Add adds the given number of occurrences of the element.
+// Non-positive numbers of occurrences are ignored.
+func (multiset *Multiset) Add(element interface{}, occurrences int) {
+	if occurrences > 0 {
+		multiset.SetCount(element, multiset.counts[element]+occurrences)
+	}
+}
+
+// Remove removes the given number of occurrences of the element, or all of them if there are fewer.
+// Non-positive numbers of occurrences are ignored.
+func (multiset *Multiset) Remove(element interface{}, occurrences int) {
+	if occurrences > 0 {
+		multiset.SetCount(element, multiset.counts[element]-occurrences)
+	}
+}
+
+// Count returns the number of occurrences of the element, which is zero if it is not in the multiset.
+func (multiset *Multiset) Count(element interface{}) int {
+	return multiset.counts[element]
+}
+
+// SetCount sets the number of occurrences of the element.
+// A non-positive count removes the element.
+func (multiset *Multiset) SetCount(element interface{}, count int) {
+	if count < 0 {
+		count = 0
+	}
+	multiset.size += count - multiset.counts[element]
+	if count == 0 {
+		delete(multiset.counts, element)
+	} else {
+		multiset.counts[element] = count
+	}
+}
+
+// Contains check if elements (one or more) are present in the multiset.
+// All elements have to be present in the multiset for the method to return true.
+// Returns true if no arguments are passed at all, i.e. multiset is always superset of empty multiset.
+func (multiset *Multiset) Contains(elements ...interface{}) bool {
+	for _, element := range elements {
+		if _, contains := multiset.counts[element]; !contains {
+			return false
+		}
+	}
+	return true
+}
+
+// ElementSet returns all distinct elements (random order).
+func (multiset *Multiset) ElementSet() []interface{} {
+	elements := make([]interface{}, 0, len(multiset.counts))
+	for element := range multiset.counts {
+		elements = append(elements, element)
+	}
+	return elements
+}
+
+// EntrySet returns all distinct elements with their counts, sorted by decreasing count (random order for equal counts).
+func (multiset *Multiset) EntrySet() []multisets.Entry {
+	return multisets.MostCommon(multiset.entries(), -1)
+}
+
+// MostCommon returns the k elements with the highest counts, sorted by decreasing count, or all of them if k is
+// negative or exceeds the number of distinct elements (random order for equal counts).
+func (multiset *Multiset) MostCommon(k int) []multisets.Entry {
+	return multisets.MostCommon(multiset.entries(), k)
+}
+
+// Sum returns the sum of two multisets.
+// The new multiset counts every element as many times as "multiset" and "another" together.
+func (multiset *Multiset) Sum(another multisets.Multiset) multisets.Multiset {
+	result := New()
+	for element, count := range multiset.counts {
+		result.Add(element, count)
+	}
+	for _, entry := range another.EntrySet() {
+		result.Add(entry.Element, entry.Count)
+	}
+	return result
+}
+
+// Union returns the union of two multisets.
+// The new multiset counts every element as many times as the larger of its counts in "multiset" and "another".
+// Ref: https://en.wikipedia.org/wiki/Multiset#Basic_properties_and_operations
+func (multiset *Multiset) Union(another multisets.Multiset) multisets.Multiset {
+	result := New()
+	for element, count := range multiset.counts {
+		result.SetCount(element, count)
+	}
+	for _, entry := range another.EntrySet() {
+		if entry.Count > result.Count(entry.Element) {
+			result.SetCount(entry.Element, entry.Count)
+		}
+	}
+	return result
+}
+
+// Intersection returns the intersection of two multisets.
+// The new multiset counts every element as many times as the smaller of its counts in "multiset" and "another".
+// Ref: https://en.wikipedia.org/wiki/Multiset#Basic_properties_and_operations
+func (multiset *Multiset) Intersection(another multisets.Multiset) multisets.Multiset {
+	result := New()
+	for element, count := range multiset.counts {
+		if anotherCount := another.Count(element); anotherCount < count {
+			result.SetCount(element, anotherCount)
+		} else {
+			result.SetCount(element, count)
+		}
+	}
+	return result
+}
+
+// Difference returns the difference between two multisets.
+// The new multiset counts every element as many times as it occurs in "multiset" more than in "another".
+func (multiset *Multiset) Difference(another multisets.Multiset) multisets.Multiset {
+	result := New()
+	for element, count := range multiset.counts {
+		result.SetCount(element, count-another.Count(element))
+	}
+	return result
+}
+
+// Equals returns true if "multiset" and "another" contain the same elements with the same counts.
+func (multiset *Multiset) Equals(another multisets.Multiset) bool {
+	if multiset.Size() != another.Size() || len(multiset.counts) != len(another.ElementSet()) {
+		return false
+	}
+	for element, count := range multiset.counts {
+		if another.Count(element) != count {
+			return false
+		}
+	}
+	return true
+}
+
+// Empty returns true if multiset does not contain any elements.
+func (multiset *Multiset) Empty() bool {
+	return multiset.Size() == 0
+}
+
+// Size returns the total number of occurrences of all elements within the multiset.
+func (multiset *Multiset) Size() int {
+	return multiset.size
+}
+
+// Clear clears all values in the multiset.
+func (multiset *Multiset) Clear() {
+	multiset.counts = make(map[interface{}]int)
+	multiset.size = 0
+}
+
+// Values returns every element as many times as it occurs (random order).
+func (multiset *Multiset) Values() []interface{} {
+	values := make([]interface{}, 0, multiset.size)
+	for element, count := range multiset.counts {
+		for i := 0; i < count; i++ {
+			values = append(values, element)
+		}
+	}
+	return values
+}
+
+// String returns a string representation of container
+func (multiset *Multiset) String() string {
+	str := "HashMultiset\n"
+	items := []string{}
+	for element, count := range multiset.counts {
+		items = append(items, fmt.Sprintf("%v:%v", element, count))
+	}
+	str += strings.Join(items, ", ")
+	return str
+}
+
+// entries returns all distinct elements with their counts (random order)
+func (multiset *Multiset) entries() []multisets.Entry {
+	entries := make([]multisets.Entry, 0, len(multiset.counts))
+	for element, count := range multiset.counts {
+		entries = append(entries, multisets.Entry{Element: element, Count: count})
+	}
+	return entries
+}
@@ -0,0 +1,195 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package hashmultiset
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"testing"
+)
+
+func TestMultisetAdd(t *testing.T) {
+	m := New(5, 1, 5)
+	m.Add(3, 2)
+	m.Add(1, 1)
+
+	if actualValue, expectedValue := sortedStrings(m.ElementSet()), "[1 3 5]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := sortedStrings(m.Values()), "[1 1 3 3 5 5]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := m.Size(), 6; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	m.Add(3, 1)
+	if actualValue, expectedValue := fmt.Sprintf("%v", m.EntrySet()[0]), "{3 3}"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestMultisetIterator(t *testing.T) {
+	m := New()
+	it := m.Iterator()
+	for it.Next() {
+		t.Errorf("Shouldn't iterate on empty multiset")
+	}
+
+	m.Add("a", 2)
+	m.Add("b", 1)
+	m.Add("c", 3)
+
+	it = m.Iterator()
+	entries := []interface{}{}
+	for it.Next() {
+		entries = append(entries, fmt.Sprintf("%v:%v", it.Key(), it.Value()))
+	}
+	if actualValue, expectedValue := sortedStrings(entries), "[a:2 b:1 c:3]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	if actualValue, expectedValue := it.First(), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := it.NextTo(func(key interface{}, value interface{}) bool {
+		return value == 3
+	}), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := it.Key(), "c"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestMultisetSerialization(t *testing.T) {
+	m := New("a", "b", "a")
+
+	serialized, err := m.ToJSON()
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := string(serialized), `{"a":2,"b":1}`; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	_, err = json.Marshal([]interface{}{"a", "b", "c", m})
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+
+	err = json.Unmarshal([]byte(`{"x":3,"y":0}`), &m)
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := sortedStrings(m.Values()), "[x x x]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestMultisetString(t *testing.T) {
+	m := New("a", "a")
+	if !strings.HasPrefix(m.String(), "HashMultiset") {
+		t.Errorf("String should start with container name")
+	}
+	if actualValue, expectedValue := m.String(), "HashMultiset\na:2"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func sortedStrings(values []interface{}) string {
+	strings := make([]string, len(values))
+	for i, value := range values {
+		strings[i] = fmt.Sprintf("%v", value)
+	}
+	sort.Strings(strings)
+	return fmt.Sprintf("%v", strings)
+}
+
+func benchmarkCount(b *testing.B, m *Multiset, size int) {
+	for i := 0; i < b.N; i++ {
+		for n := 0; n < size; n++ {
+			m.Count(n)
+		}
+	}
+}
+
+func benchmarkAdd(b *testing.B, m *Multiset, size int) {
+	for i := 0; i < b.N; i++ {
+		for n := 0; n < size; n++ {
+			m.Add(n%(size/10+1), 1)
+		}
+	}
+}
+
+func benchmarkRemove(b *testing.B, m *Multiset, size int) {
+	for i := 0; i < b.N; i++ {
+		for n := 0; n < size; n++ {
+			m.Remove(n%(size/10+1), 1)
+		}
+	}
+}
+
+func BenchmarkHashMultisetCount100(b *testing.B) {
+	b.StopTimer()
+	size := 100
+	m := New()
+	for n := 0; n < size; n++ {
+		m.Add(n%(size/10+1), 1)
+	}
+	b.StartTimer()
+	benchmarkCount(b, m, size)
+}
+
+func BenchmarkHashMultisetCount10000(b *testing.B) {
+	b.StopTimer()
+	size := 10000
+	m := New()
+	for n := 0; n < size; n++ {
+		m.Add(n%(size/10+1), 1)
+	}
+	b.StartTimer()
+	benchmarkCount(b, m, size)
+}
+
+func BenchmarkHashMultisetAdd100(b *testing.B) {
+	b.StopTimer()
+	size := 100
+	m := New()
+	b.StartTimer()
+	benchmarkAdd(b, m, size)
+}
+
+func BenchmarkHashMultisetAdd10000(b *testing.B) {
+	b.StopTimer()
+	size := 10000
+	m := New()
+	b.StartTimer()
+	benchmarkAdd(b, m, size)
+}
+
+func BenchmarkHashMultisetRemove100(b *testing.B) {
+	b.StopTimer()
+	size := 100
+	m := New()
+	for n := 0; n < size; n++ {
+		m.Add(n%(size/10+1), 1)
+	}
+	b.StartTimer()
+	benchmarkRemove(b, m, size)
+}
+
+func BenchmarkHashMultisetRemove10000(b *testing.B) {
+	b.StopTimer()
+	size := 10000
+	m := New()
+	for n := 0; n < size; n++ {
+		m.Add(n%(size/10+1), 1)
+	}
+	b.StartTimer()
+	benchmarkRemove(b, m, size)
+}
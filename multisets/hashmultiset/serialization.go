@@ -0,0 +1,47 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package hashmultiset
+
+import (
+	"encoding/json"
+	"github.com/emirpasic/gods/containers"
+	"github.com/emirpasic/gods/utils"
+)
+
+// Assert Serialization implementation
+var _ containers.JSONSerializer = (*Multiset)(nil)
+var _ containers.JSONDeserializer = (*Multiset)(nil)
+
+// ToJSON outputs the JSON representation of the multiset, i.e. an object mapping every element to its count.
+func (multiset *Multiset) ToJSON() ([]byte, error) {
+	elements := make(map[string]int)
+	for element, count := range multiset.counts {
+		elements[utils.ToString(element)] = count
+	}
+	return json.Marshal(&elements)
+}
+
+// FromJSON populates the multiset from the input JSON representation.
+func (multiset *Multiset) FromJSON(data []byte) error {
+	elements := make(map[string]int)
+	err := json.Unmarshal(data, &elements)
+	if err == nil {
+		multiset.Clear()
+		for element, count := range elements {
+			multiset.SetCount(element, count)
+		}
+	}
+	return err
+}
+
+// UnmarshalJSON @implements json.Unmarshaler
+func (multiset *Multiset) UnmarshalJSON(bytes []byte) error {
+	return multiset.FromJSON(bytes)
+}
+
+// MarshalJSON @implements json.Marshaler
+func (multiset *Multiset) MarshalJSON() ([]byte, error) {
+	return multiset.ToJSON()
+}
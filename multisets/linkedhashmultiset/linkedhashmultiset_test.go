@@ -0,0 +1,231 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package linkedhashmultiset
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestMultisetAdd(t *testing.T) {
+	m := New(5, 1, 5)
+	m.Add(3, 2)
+	m.Add(1, 1)
+
+	if actualValue, expectedValue := fmt.Sprintf("%v", m.ElementSet()), "[5 1 3]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", m.Values()), "[5 5 1 1 3 3]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := m.Size(), 6; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	// entries are sorted by count, equal counts in insertion order
+	m.Add(3, 1)
+	if actualValue, expectedValue := fmt.Sprintf("%v", m.EntrySet()), "[{3 3} {5 2} {1 2}]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	// removing some occurrences keeps the position, removing all of them does not
+	m.Remove(5, 1)
+	m.Remove(1, 2)
+	m.Add(1, 1)
+	if actualValue, expectedValue := fmt.Sprintf("%v", m.ElementSet()), "[5 3 1]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestMultisetSetOperations(t *testing.T) {
+	m := New("c", "a", "c")
+	another := New("b", "a", "a")
+
+	if actualValue, expectedValue := fmt.Sprintf("%v", m.Sum(another).Values()), "[c c a a a b]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", m.Union(another).Values()), "[c c a a b]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", m.Intersection(another).Values()), "[a]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", m.Difference(another).Values()), "[c c]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestMultisetIterator(t *testing.T) {
+	m := New()
+	it := m.Iterator()
+	for it.Next() {
+		t.Errorf("Shouldn't iterate on empty multiset")
+	}
+
+	m.Add("c", 3)
+	m.Add("a", 2)
+	m.Add("b", 1)
+
+	it = m.Iterator()
+	entries := []interface{}{}
+	for it.Next() {
+		entries = append(entries, fmt.Sprintf("%v:%v", it.Key(), it.Value()))
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", entries), "[c:3 a:2 b:1]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	entries = []interface{}{}
+	for it.Prev() {
+		entries = append(entries, fmt.Sprintf("%v:%v", it.Key(), it.Value()))
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", entries), "[b:1 a:2 c:3]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	if actualValue, expectedValue := it.Last(), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := it.PrevTo(func(key interface{}, value interface{}) bool {
+		return value == 3
+	}), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := it.Key(), "c"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := it.First(), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := it.NextTo(func(key interface{}, value interface{}) bool {
+		return value == 1
+	}), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := it.Key(), "b"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestMultisetSerialization(t *testing.T) {
+	m := New("b", "a", "b")
+
+	serialized, err := m.ToJSON()
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := string(serialized), `{"b":2,"a":1}`; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	_, err = json.Marshal([]interface{}{"a", "b", "c", m})
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+
+	err = json.Unmarshal([]byte(`{"y":1,"x":3}`), &m)
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", m.Values()), "[y x x x]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestMultisetString(t *testing.T) {
+	m := New("b", "a", "b")
+	if !strings.HasPrefix(m.String(), "LinkedHashMultiset") {
+		t.Errorf("String should start with container name")
+	}
+	if actualValue, expectedValue := m.String(), "LinkedHashMultiset\nb:2, a:1"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func benchmarkCount(b *testing.B, m *Multiset, size int) {
+	for i := 0; i < b.N; i++ {
+		for n := 0; n < size; n++ {
+			m.Count(n)
+		}
+	}
+}
+
+func benchmarkAdd(b *testing.B, m *Multiset, size int) {
+	for i := 0; i < b.N; i++ {
+		for n := 0; n < size; n++ {
+			m.Add(n%(size/10+1), 1)
+		}
+	}
+}
+
+func benchmarkRemove(b *testing.B, m *Multiset, size int) {
+	for i := 0; i < b.N; i++ {
+		for n := 0; n < size; n++ {
+			m.Remove(n%(size/10+1), 1)
+		}
+	}
+}
+
+func BenchmarkLinkedHashMultisetCount100(b *testing.B) {
+	b.StopTimer()
+	size := 100
+	m := New()
+	for n := 0; n < size; n++ {
+		m.Add(n%(size/10+1), 1)
+	}
+	b.StartTimer()
+	benchmarkCount(b, m, size)
+}
+
+func BenchmarkLinkedHashMultisetCount10000(b *testing.B) {
+	b.StopTimer()
+	size := 10000
+	m := New()
+	for n := 0; n < size; n++ {
+		m.Add(n%(size/10+1), 1)
+	}
+	b.StartTimer()
+	benchmarkCount(b, m, size)
+}
+
+func BenchmarkLinkedHashMultisetAdd100(b *testing.B) {
+	b.StopTimer()
+	size := 100
+	m := New()
+	b.StartTimer()
+	benchmarkAdd(b, m, size)
+}
+
+func BenchmarkLinkedHashMultisetAdd10000(b *testing.B) {
+	b.StopTimer()
+	size := 10000
+	m := New()
+	b.StartTimer()
+	benchmarkAdd(b, m, size)
+}
+
+func BenchmarkLinkedHashMultisetRemove100(b *testing.B) {
+	b.StopTimer()
+	size := 100
+	m := New()
+	for n := 0; n < size; n++ {
+		m.Add(n%(size/10+1), 1)
+	}
+	b.StartTimer()
+	benchmarkRemove(b, m, size)
+}
+
+func BenchmarkLinkedHashMultisetRemove10000(b *testing.B) {
+	b.StopTimer()
+	size := 10000
+	m := New()
+	for n := 0; n < size; n++ {
+		m.Add(n%(size/10+1), 1)
+	}
+	b.StartTimer()
+	benchmarkRemove(b, m, size)
+}
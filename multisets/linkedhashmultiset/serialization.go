@@ -0,0 +1,53 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package linkedhashmultiset
+
+import (
+	"encoding/json"
+	"github.com/emirpasic/gods/containers"
+	"github.com/emirpasic/gods/maps/linkedhashmap"
+	"github.com/emirpasic/gods/utils"
+)
+
+// Assert Serialization implementation
+var _ containers.JSONSerializer = (*Multiset)(nil)
+var _ containers.JSONDeserializer = (*Multiset)(nil)
+
+// ToJSON outputs the JSON representation of the multiset, i.e. an object mapping every element to its count.
+func (multiset *Multiset) ToJSON() ([]byte, error) {
+	elements := linkedhashmap.New()
+	it := multiset.counts.Iterator()
+	for it.Next() {
+		elements.Put(utils.ToString(it.Key()), it.Value())
+	}
+	return elements.ToJSON()
+}
+
+// FromJSON populates the multiset from the input JSON representation, keeping the order of the members.
+func (multiset *Multiset) FromJSON(data []byte) error {
+	elements := make(map[string]int)
+	if err := json.Unmarshal(data, &elements); err != nil {
+		return err
+	}
+	order := linkedhashmap.New()
+	if err := order.FromJSON(data); err != nil {
+		return err
+	}
+	multiset.Clear()
+	for _, element := range order.Keys() {
+		multiset.SetCount(element, elements[element.(string)])
+	}
+	return nil
+}
+
+// UnmarshalJSON @implements json.Unmarshaler
+func (multiset *Multiset) UnmarshalJSON(bytes []byte) error {
+	return multiset.FromJSON(bytes)
+}
+
+// MarshalJSON @implements json.Marshaler
+func (multiset *Multiset) MarshalJSON() ([]byte, error) {
+	return multiset.ToJSON()
+}
@@ -0,0 +1,67 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package multisets provides an abstract Multiset interface.
+//
+// A multiset, or bag, is a generalization of a set that allows multiple occurrences of its elements. The number of
+// occurrences of an element is its count, and an element is contained in the multiset while its count is positive.
+//
+// Size is the total number of occurrences and Values returns every element as many times as it occurs, while
+// ElementSet and EntrySet return every distinct element once.
+//
+// Set operations respect the counts: the sum adds them, the union takes the larger, the intersection the smaller and
+// the difference subtracts them.
+//
+// Reference: https://en.wikipedia.org/wiki/Multiset
+package multisets
+
+import (
+	"github.com/emirpasic/gods/containers"
+	"sort"
+)
+
+// Multiset interface that all multisets implement
+type Multiset interface {
+	Add(element interface{}, occurrences int)
+	Remove(element interface{}, occurrences int)
+	Count(element interface{}) int
+	SetCount(element interface{}, count int)
+	Contains(elements ...interface{}) bool
+	ElementSet() []interface{}
+	EntrySet() []Entry
+	MostCommon(k int) []Entry
+	Sum(another Multiset) Multiset
+	Union(another Multiset) Multiset
+	Intersection(another Multiset) Multiset
+	Difference(another Multiset) Multiset
+	Equals(another Multiset) bool
+
+	containers.Container
+	// Empty() bool
+	// Size() int
+	// Clear()
+	// Values() []interface{}
+	// String() string
+}
+
+// Entry is a distinct element of a multiset together with its count.
+type Entry struct {
+	Element interface{}
+	Count   int
+}
+
+// MostCommon returns the first k entries after sorting them by decreasing count, keeping the order of entries with
+// equal counts, or all of them if k is negative or exceeds their number.
+// It is the common implementation of MostCommon for all multisets.
+func MostCommon(entries []Entry, k int) []Entry {
+	sorted := make([]Entry, len(entries))
+	copy(sorted, entries)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Count > sorted[j].Count
+	})
+	if k >= 0 && k < len(sorted) {
+		sorted = sorted[:k]
+	}
+	return sorted
+}
@@ -0,0 +1,240 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package multisets_test
+
+import (
+	"fmt"
+	"github.com/emirpasic/gods/containers"
+	"github.com/emirpasic/gods/multisets"
+	"github.com/emirpasic/gods/multisets/hashmultiset"
+	"github.com/emirpasic/gods/multisets/linkedhashmultiset"
+	"github.com/emirpasic/gods/multisets/treemultiset"
+	"sort"
+	"testing"
+)
+
+// implementations returns a constructor of every multiset, all of them with string elements
+func implementations() map[string]func(values ...interface{}) multisets.Multiset {
+	return map[string]func(values ...interface{}) multisets.Multiset{
+		"HashMultiset":       func(values ...interface{}) multisets.Multiset { return hashmultiset.New(values...) },
+		"TreeMultiset":       func(values ...interface{}) multisets.Multiset { return treemultiset.NewWithStringComparator(values...) },
+		"LinkedHashMultiset": func(values ...interface{}) multisets.Multiset { return linkedhashmultiset.New(values...) },
+	}
+}
+
+func TestMultisetAddRemoveAndCount(t *testing.T) {
+	for name, newMultiset := range implementations() {
+		m := newMultiset("a", "b", "a")
+		m.Add("c", 3)
+		m.Add("d", 0)
+		m.Add("d", -1)
+
+		tests := [][]interface{}{
+			{"a", 2},
+			{"b", 1},
+			{"c", 3},
+			{"d", 0},
+		}
+		for _, test := range tests {
+			if actualValue := m.Count(test[0]); actualValue != test[1] {
+				t.Errorf("[%s] Got %v expected %v", name, actualValue, test[1])
+			}
+		}
+		if actualValue, expectedValue := m.Size(), 6; actualValue != expectedValue {
+			t.Errorf("[%s] Got %v expected %v", name, actualValue, expectedValue)
+		}
+		if actualValue, expectedValue := sortedStrings(m.ElementSet()), "[a b c]"; actualValue != expectedValue {
+			t.Errorf("[%s] Got %v expected %v", name, actualValue, expectedValue)
+		}
+		if actualValue, expectedValue := sortedStrings(m.Values()), "[a a b c c c]"; actualValue != expectedValue {
+			t.Errorf("[%s] Got %v expected %v", name, actualValue, expectedValue)
+		}
+		if actualValue, expectedValue := m.Contains("a", "c"), true; actualValue != expectedValue {
+			t.Errorf("[%s] Got %v expected %v", name, actualValue, expectedValue)
+		}
+		if actualValue, expectedValue := m.Contains("a", "d"), false; actualValue != expectedValue {
+			t.Errorf("[%s] Got %v expected %v", name, actualValue, expectedValue)
+		}
+		if actualValue, expectedValue := m.Contains(), true; actualValue != expectedValue {
+			t.Errorf("[%s] Got %v expected %v", name, actualValue, expectedValue)
+		}
+
+		m.Remove("c", 2)
+		m.Remove("a", 5) // more occurrences than there are
+		m.Remove("b", 0)
+		m.Remove("x", 1)
+		if actualValue, expectedValue := sortedStrings(m.Values()), "[b c]"; actualValue != expectedValue {
+			t.Errorf("[%s] Got %v expected %v", name, actualValue, expectedValue)
+		}
+		if actualValue, expectedValue := m.Contains("a"), false; actualValue != expectedValue {
+			t.Errorf("[%s] Got %v expected %v", name, actualValue, expectedValue)
+		}
+
+		m.SetCount("b", 4)
+		m.SetCount("c", 0)
+		m.SetCount("e", -2)
+		if actualValue, expectedValue := sortedStrings(m.Values()), "[b b b b]"; actualValue != expectedValue {
+			t.Errorf("[%s] Got %v expected %v", name, actualValue, expectedValue)
+		}
+		if actualValue, expectedValue := len(m.ElementSet()), 1; actualValue != expectedValue {
+			t.Errorf("[%s] Got %v expected %v", name, actualValue, expectedValue)
+		}
+
+		m.Clear()
+		if actualValue, expectedValue := m.Empty(), true; actualValue != expectedValue {
+			t.Errorf("[%s] Got %v expected %v", name, actualValue, expectedValue)
+		}
+		if actualValue, expectedValue := m.Count("b"), 0; actualValue != expectedValue {
+			t.Errorf("[%s] Got %v expected %v", name, actualValue, expectedValue)
+		}
+	}
+}
+
+func TestMultisetMostCommon(t *testing.T) {
+	for name, newMultiset := range implementations() {
+		m := newMultiset("a", "b", "b", "c", "c", "c")
+
+		if actualValue, expectedValue := fmt.Sprintf("%v", m.MostCommon(2)), "[{c 3} {b 2}]"; actualValue != expectedValue {
+			t.Errorf("[%s] Got %v expected %v", name, actualValue, expectedValue)
+		}
+		if actualValue, expectedValue := len(m.MostCommon(0)), 0; actualValue != expectedValue {
+			t.Errorf("[%s] Got %v expected %v", name, actualValue, expectedValue)
+		}
+		if actualValue, expectedValue := len(m.MostCommon(10)), 3; actualValue != expectedValue {
+			t.Errorf("[%s] Got %v expected %v", name, actualValue, expectedValue)
+		}
+		if actualValue, expectedValue := len(m.MostCommon(-1)), 3; actualValue != expectedValue {
+			t.Errorf("[%s] Got %v expected %v", name, actualValue, expectedValue)
+		}
+	}
+}
+
+func TestMultisetSetOperations(t *testing.T) {
+	for name, newMultiset := range implementations() {
+		for anotherName, newAnother := range implementations() {
+			m := newMultiset("a", "a", "a", "b", "c", "c")
+			another := newAnother("a", "b", "b", "d")
+
+			tests := []struct {
+				operation string
+				result    multisets.Multiset
+				expected  string
+			}{
+				{"Sum", m.Sum(another), "[a a a a b b b c c d]"},
+				{"Union", m.Union(another), "[a a a b b c c d]"},
+				{"Intersection", m.Intersection(another), "[a b]"},
+				{"Difference", m.Difference(another), "[a a c c]"},
+			}
+			for _, test := range tests {
+				if actualValue := sortedStrings(test.result.Values()); actualValue != test.expected {
+					t.Errorf("[%s %s %s] Got %v expected %v", name, test.operation, anotherName, actualValue, test.expected)
+				}
+			}
+
+			// operands are not modified
+			if actualValue, expectedValue := m.Size()+another.Size(), 10; actualValue != expectedValue {
+				t.Errorf("[%s %s] Got %v expected %v", name, anotherName, actualValue, expectedValue)
+			}
+		}
+	}
+}
+
+func TestMultisetEquals(t *testing.T) {
+	for name, newMultiset := range implementations() {
+		for anotherName, newAnother := range implementations() {
+			tests := []struct {
+				m        multisets.Multiset
+				another  multisets.Multiset
+				expected bool
+			}{
+				{newMultiset(), newAnother(), true},
+				{newMultiset("a", "b", "a"), newAnother("b", "a", "a"), true},
+				{newMultiset("a", "b", "a"), newAnother("a", "b", "b"), false},
+				{newMultiset("a", "b"), newAnother("a", "b", "c"), false},
+				{newMultiset("a", "a"), newAnother("a"), false},
+			}
+			for _, test := range tests {
+				if actualValue := test.m.Equals(test.another); actualValue != test.expected {
+					t.Errorf("[%s %s] Got %v expected %v for %v and %v", name, anotherName, actualValue, test.expected, test.m, test.another)
+				}
+			}
+		}
+	}
+}
+
+func TestMultisetSerialization(t *testing.T) {
+	for name, newMultiset := range implementations() {
+		original := newMultiset("a", "b", "a")
+
+		serialized, err := original.(containers.JSONSerializer).ToJSON()
+		if err != nil {
+			t.Errorf("[%s] Got error %v", name, err)
+		}
+
+		deserialized := newMultiset()
+		err = deserialized.(containers.JSONDeserializer).FromJSON(serialized)
+		if err != nil {
+			t.Errorf("[%s] Got error %v", name, err)
+		}
+		if actualValue, expectedValue := deserialized.Equals(original), true; actualValue != expectedValue {
+			t.Errorf("[%s] Got %v expected %v", name, actualValue, expectedValue)
+		}
+
+		for _, invalid := range []string{`["a","b"]`, `{"a":"b"}`} {
+			err = deserialized.(containers.JSONDeserializer).FromJSON([]byte(invalid))
+			if err == nil {
+				t.Errorf("[%s] Expected an error", name)
+			}
+		}
+	}
+}
+
+func TestMultisetSerializationNonStringElements(t *testing.T) {
+	for name, m := range map[string]multisets.Multiset{
+		"HashMultiset":       hashmultiset.New(1, 2, 1),
+		"TreeMultiset":       treemultiset.NewWithIntComparator(1, 2, 1),
+		"LinkedHashMultiset": linkedhashmultiset.New(1, 2, 1),
+	} {
+		serialized, err := m.(containers.JSONSerializer).ToJSON()
+		if err != nil {
+			t.Errorf("[%s] Got error %v", name, err)
+		}
+		if actualValue, expectedValue := string(serialized), `{"1":2,"2":1}`; actualValue != expectedValue {
+			t.Errorf("[%s] Got %v expected %v", name, actualValue, expectedValue)
+		}
+	}
+}
+
+func TestMostCommon(t *testing.T) {
+	entries := []multisets.Entry{{"a", 1}, {"b", 3}, {"c", 1}, {"d", 3}}
+
+	tests := [][]interface{}{
+		{-1, "[{b 3} {d 3} {a 1} {c 1}]"},
+		{0, "[]"},
+		{3, "[{b 3} {d 3} {a 1}]"},
+		{4, "[{b 3} {d 3} {a 1} {c 1}]"},
+		{5, "[{b 3} {d 3} {a 1} {c 1}]"},
+	}
+	for _, test := range tests {
+		if actualValue := fmt.Sprintf("%v", multisets.MostCommon(entries, test[0].(int))); actualValue != test[1] {
+			t.Errorf("Got %v expected %v", actualValue, test[1])
+		}
+	}
+
+	// the entries are not reordered
+	if actualValue, expectedValue := fmt.Sprintf("%v", entries), "[{a 1} {b 3} {c 1} {d 3}]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+// sortedStrings formats the elements in sorted order, so that multisets with different orderings can be compared
+func sortedStrings(values []interface{}) string {
+	strings := make([]string, len(values))
+	for i, value := range values {
+		strings[i] = fmt.Sprintf("%v", value)
+	}
+	sort.Strings(strings)
+	return fmt.Sprintf("%v", strings)
+}
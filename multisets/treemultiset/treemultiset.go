@@ -0,0 +1,242 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package treemultiset implements a multiset backed by a red-black tree.
+//
+// Elements are ordered by the comparator in the multiset.
+//
+// Structure is not thread safe.
+//
+// Reference: https://en.wikipedia.org/wiki/Multiset
+package treemultiset
+
+import (
+	"fmt"
+	"github.com/emirpasic/gods/multisets"
+	rbt "github.com/emirpasic/gods/trees/redblacktree"
+	"github.com/emirpasic/gods/utils"
+	"strings"
+)
+
+// Assert Multiset implementation
+var _ multisets.Multiset = (*Multiset)(nil)
+
+// Multiset holds the count of every element in a red-black tree
+type Multiset struct {
+	tree *rbt.Tree
+	size int
+}
+
+// NewWith instantiates a new empty multiset with the custom comparator and adds one occurrence of each of the passed
+// values, if any.
+func NewWith(comparator utils.Comparator, values ...interface{}) *Multiset {
+	multiset := &Multiset{tree: rbt.NewWith(comparator)}
+	for _, value := range values {
+		multiset.Add(value, 1)
+	}
+	return multiset
+}
+
+// NewWithIntComparator instantiates a new empty multiset with the IntComparator, i.e. elements are of type int, and
+// adds one occurrence of each of the passed values, if any.
+func NewWithIntComparator(values ...interface{}) *Multiset {
+	return NewWith(utils.IntComparator, values...)
+}
+
+// NewWithStringComparator instantiates a new empty multiset with the StringComparator, i.e. elements are of type
+// string, and adds one occurrence of each of the passed values, if any.
+func NewWithStringComparator(values ...interface{}) *Multiset {
+	return NewWith(utils.StringComparator, values...)
+}
+
+// Add adds the given number of occurrences of the element.
+// Non-positive numbers of occurrences are ignored.
+func (multiset *Multiset) Add(element interface{}, occurrences int) {
+	if occurrences > 0 {
+		multiset.SetCount(element, multiset.Count(element)+occurrences)
+	}
+}
+
+// Remove removes the given number of occurrences of the element, or all of them if there are fewer.
+// Non-positive numbers of occurrences are ignored.
+func (multiset *Multiset) Remove(element interface{}, occurrences int) {
+	if occurrences > 0 {
+		multiset.SetCount(element, multiset.Count(element)-occurrences)
+	}
+}
+
+// Count returns the number of occurrences of the element, which is zero if it is not in the multiset.
+func (multiset *Multiset) Count(element interface{}) int {
+	if count, found := multiset.tree.Get(element); found {
+		return count.(int)
+	}
+	return 0
+}
+
+// SetCount sets the number of occurrences of the element.
+// A non-positive count removes the element.
+func (multiset *Multiset) SetCount(element interface{}, count int) {
+	if count < 0 {
+		count = 0
+	}
+	multiset.size += count - multiset.Count(element)
+	if count == 0 {
+		multiset.tree.Remove(element)
+	} else {
+		multiset.tree.Put(element, count)
+	}
+}
+
+// Contains check if elements (one or more) are present in the multiset.
+// All elements have to be present in the multiset for the method to return true.
+// Returns true if no arguments are passed at all, i.e. multiset is always superset of empty multiset.
+func (multiset *Multiset) Contains(elements ...interface{}) bool {
+	for _, element := range elements {
+		if _, contains := multiset.tree.Get(element); !contains {
+			return false
+		}
+	}
+	return true
+}
+
+// ElementSet returns all distinct elements in order.
+func (multiset *Multiset) ElementSet() []interface{} {
+	return multiset.tree.Keys()
+}
+
+// EntrySet returns all distinct elements with their counts, in order of the elements.
+func (multiset *Multiset) EntrySet() []multisets.Entry {
+	entries := make([]multisets.Entry, 0, multiset.tree.Size())
+	it := multiset.tree.Iterator()
+	for it.Next() {
+		entries = append(entries, multisets.Entry{Element: it.Key(), Count: it.Value().(int)})
+	}
+	return entries
+}
+
+// MostCommon returns the k elements with the highest counts, sorted by decreasing count and then in order of the
+// elements, or all of them if k is negative or exceeds the number of distinct elements.
+func (multiset *Multiset) MostCommon(k int) []multisets.Entry {
+	return multisets.MostCommon(multiset.EntrySet(), k)
+}
+
+// Sum returns the sum of two multisets.
+// The new multiset counts every element as many times as "multiset" and "another" together.
+// The result is ordered with the comparator of "multiset", which need not be the comparator of "another".
+func (multiset *Multiset) Sum(another multisets.Multiset) multisets.Multiset {
+	result := multiset.copy()
+	for _, entry := range another.EntrySet() {
+		result.Add(entry.Element, entry.Count)
+	}
+	return result
+}
+
+// Union returns the union of two multisets.
+// The new multiset counts every element as many times as the larger of its counts in "multiset" and "another".
+// The result is ordered with the comparator of "multiset", which need not be the comparator of "another".
+// Ref: https://en.wikipedia.org/wiki/Multiset#Basic_properties_and_operations
+func (multiset *Multiset) Union(another multisets.Multiset) multisets.Multiset {
+	result := multiset.copy()
+	for _, entry := range another.EntrySet() {
+		if entry.Count > result.Count(entry.Element) {
+			result.SetCount(entry.Element, entry.Count)
+		}
+	}
+	return result
+}
+
+// Intersection returns the intersection of two multisets.
+// The new multiset counts every element as many times as the smaller of its counts in "multiset" and "another".
+// The result is ordered with the comparator of "multiset", which need not be the comparator of "another".
+// Ref: https://en.wikipedia.org/wiki/Multiset#Basic_properties_and_operations
+func (multiset *Multiset) Intersection(another multisets.Multiset) multisets.Multiset {
+	result := NewWith(multiset.tree.Comparator)
+	it := multiset.tree.Iterator()
+	for it.Next() {
+		if anotherCount := another.Count(it.Key()); anotherCount < it.Value().(int) {
+			result.SetCount(it.Key(), anotherCount)
+		} else {
+			result.SetCount(it.Key(), it.Value().(int))
+		}
+	}
+	return result
+}
+
+// Difference returns the difference between two multisets.
+// The new multiset counts every element as many times as it occurs in "multiset" more than in "another".
+// The result is ordered with the comparator of "multiset", which need not be the comparator of "another".
+func (multiset *Multiset) Difference(another multisets.Multiset) multisets.Multiset {
+	result := NewWith(multiset.tree.Comparator)
+	it := multiset.tree.Iterator()
+	for it.Next() {
+		result.SetCount(it.Key(), it.Value().(int)-another.Count(it.Key()))
+	}
+	return result
+}
+
+// Equals returns true if "multiset" and "another" contain the same elements with the same counts.
+// Counts are looked up in "another", so it may order its elements with a different comparator.
+func (multiset *Multiset) Equals(another multisets.Multiset) bool {
+	if multiset.Size() != another.Size() || multiset.tree.Size() != len(another.ElementSet()) {
+		return false
+	}
+	it := multiset.tree.Iterator()
+	for it.Next() {
+		if another.Count(it.Key()) != it.Value().(int) {
+			return false
+		}
+	}
+	return true
+}
+
+// Empty returns true if multiset does not contain any elements.
+func (multiset *Multiset) Empty() bool {
+	return multiset.Size() == 0
+}
+
+// Size returns the total number of occurrences of all elements within the multiset.
+func (multiset *Multiset) Size() int {
+	return multiset.size
+}
+
+// Clear clears all values in the multiset.
+func (multiset *Multiset) Clear() {
+	multiset.tree.Clear()
+	multiset.size = 0
+}
+
+// Values returns every element as many times as it occurs, in order.
+func (multiset *Multiset) Values() []interface{} {
+	values := make([]interface{}, 0, multiset.size)
+	it := multiset.tree.Iterator()
+	for it.Next() {
+		for i := 0; i < it.Value().(int); i++ {
+			values = append(values, it.Key())
+		}
+	}
+	return values
+}
+
+// String returns a string representation of container
+func (multiset *Multiset) String() string {
+	str := "TreeMultiset\n"
+	items := []string{}
+	it := multiset.tree.Iterator()
+	for it.Next() {
+		items = append(items, fmt.Sprintf("%v:%v", it.Key(), it.Value()))
+	}
+	str += strings.Join(items, ", ")
+	return str
+}
+
+// copy returns a multiset with the same comparator and counts
+func (multiset *Multiset) copy() *Multiset {
+	result := NewWith(multiset.tree.Comparator)
+	it := multiset.tree.Iterator()
+	for it.Next() {
+		result.tree.Put(it.Key(), it.Value())
+	}
+	result.size = multiset.size
+	return result
+}
@@ -0,0 +1,246 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package treemultiset
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/emirpasic/gods/multisets/hashmultiset"
+	"github.com/emirpasic/gods/utils"
+	"strings"
+	"testing"
+)
+
+func TestMultisetAdd(t *testing.T) {
+	m := NewWithIntComparator(5, 1, 5)
+	m.Add(3, 2)
+	m.Add(1, 1)
+
+	if actualValue, expectedValue := fmt.Sprintf("%v", m.ElementSet()), "[1 3 5]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", m.Values()), "[1 1 3 3 5 5]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := m.Size(), 6; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	// entries are in order of the elements, most common ones by count
+	m.Add(3, 1)
+	if actualValue, expectedValue := fmt.Sprintf("%v", m.EntrySet()), "[{1 2} {3 3} {5 2}]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", m.MostCommon(-1)), "[{3 3} {1 2} {5 2}]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestMultisetSetOperations(t *testing.T) {
+	m := NewWithStringComparator("c", "a", "c")
+	another := hashmultiset.New("b", "c")
+
+	if actualValue, expectedValue := fmt.Sprintf("%v", m.Sum(another).Values()), "[a b c c c]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", m.Union(another).Values()), "[a b c c]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	// tree multisets with different comparators are compared through counts
+	different := NewWith(func(a, b interface{}) int { return -utils.StringComparator(a, b) }, "a", "c")
+	if actualValue, expectedValue := fmt.Sprintf("%v", m.Sum(different).Values()), "[a a c c c]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", m.Union(different).Values()), "[a c c]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", different.Union(m).Values()), "[c c a]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", m.Intersection(different).Values()), "[a c]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", m.Difference(different).Values()), "[c]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := different.Equals(NewWithStringComparator("a", "c")), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := different.Equals(m), false; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestMultisetIterator(t *testing.T) {
+	m := NewWithStringComparator()
+	it := m.Iterator()
+	for it.Next() {
+		t.Errorf("Shouldn't iterate on empty multiset")
+	}
+
+	m.Add("c", 3)
+	m.Add("a", 2)
+	m.Add("b", 1)
+
+	it = m.Iterator()
+	entries := []interface{}{}
+	for it.Next() {
+		entries = append(entries, fmt.Sprintf("%v:%v", it.Key(), it.Value()))
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", entries), "[a:2 b:1 c:3]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	entries = []interface{}{}
+	for it.Prev() {
+		entries = append(entries, fmt.Sprintf("%v:%v", it.Key(), it.Value()))
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", entries), "[c:3 b:1 a:2]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	if actualValue, expectedValue := it.Last(), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := it.PrevTo(func(key interface{}, value interface{}) bool {
+		return value == 2
+	}), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := it.Key(), "a"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := it.First(), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := it.NextTo(func(key interface{}, value interface{}) bool {
+		return value == 3
+	}), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := it.Key(), "c"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestMultisetSerialization(t *testing.T) {
+	m := NewWithStringComparator("b", "a", "b")
+
+	serialized, err := m.ToJSON()
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := string(serialized), `{"a":1,"b":2}`; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	_, err = json.Marshal([]interface{}{"a", "b", "c", m})
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+
+	err = json.Unmarshal([]byte(`{"y":1,"x":3}`), &m)
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", m.Values()), "[x x x y]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestMultisetString(t *testing.T) {
+	m := NewWithStringComparator("b", "a", "b")
+	if !strings.HasPrefix(m.String(), "TreeMultiset") {
+		t.Errorf("String should start with container name")
+	}
+	if actualValue, expectedValue := m.String(), "TreeMultiset\na:1, b:2"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func benchmarkCount(b *testing.B, m *Multiset, size int) {
+	for i := 0; i < b.N; i++ {
+		for n := 0; n < size; n++ {
+			m.Count(n)
+		}
+	}
+}
+
+func benchmarkAdd(b *testing.B, m *Multiset, size int) {
+	for i := 0; i < b.N; i++ {
+		for n := 0; n < size; n++ {
+			m.Add(n%(size/10+1), 1)
+		}
+	}
+}
+
+func benchmarkRemove(b *testing.B, m *Multiset, size int) {
+	for i := 0; i < b.N; i++ {
+		for n := 0; n < size; n++ {
+			m.Remove(n%(size/10+1), 1)
+		}
+	}
+}
+
+func BenchmarkTreeMultisetCount100(b *testing.B) {
+	b.StopTimer()
+	size := 100
+	m := NewWithIntComparator()
+	for n := 0; n < size; n++ {
+		m.Add(n%(size/10+1), 1)
+	}
+	b.StartTimer()
+	benchmarkCount(b, m, size)
+}
+
+func BenchmarkTreeMultisetCount10000(b *testing.B) {
+	b.StopTimer()
+	size := 10000
+	m := NewWithIntComparator()
+	for n := 0; n < size; n++ {
+		m.Add(n%(size/10+1), 1)
+	}
+	b.StartTimer()
+	benchmarkCount(b, m, size)
+}
+
+func BenchmarkTreeMultisetAdd100(b *testing.B) {
+	b.StopTimer()
+	size := 100
+	m := NewWithIntComparator()
+	b.StartTimer()
+	benchmarkAdd(b, m, size)
+}
+
+func BenchmarkTreeMultisetAdd10000(b *testing.B) {
+	b.StopTimer()
+	size := 10000
+	m := NewWithIntComparator()
+	b.StartTimer()
+	benchmarkAdd(b, m, size)
+}
+
+func BenchmarkTreeMultisetRemove100(b *testing.B) {
+	b.StopTimer()
+	size := 100
+	m := NewWithIntComparator()
+	for n := 0; n < size; n++ {
+		m.Add(n%(size/10+1), 1)
+	}
+	b.StartTimer()
+	benchmarkRemove(b, m, size)
+}
+
+func BenchmarkTreeMultisetRemove10000(b *testing.B) {
+	b.StopTimer()
+	size := 10000
+	m := NewWithIntComparator()
+	for n := 0; n < size; n++ {
+		m.Add(n%(size/10+1), 1)
+	}
+	b.StartTimer()
+	benchmarkRemove(b, m, size)
+}
@@ -0,0 +1,88 @@
+// Copyright (c) 2021, Aryan Ahadinia. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package arrayqueue
+
+import "github.com/emirpasic/gods/containers"
+
+// Assert Enumerable implementation
+var _ containers.EnumerableWithIndex = (*Queue)(nil)
+
+// Each calls the given function once for each element, passing that element's index and value.
+func (queue *Queue) Each(f func(index int, value interface{})) {
+	iterator := queue.Iterator()
+	for iterator.Next() {
+		f(iterator.Index(), iterator.Value())
+	}
+}
+
+// Map invokes the given function once for each element and returns a
+// container containing the values returned by the given function.
+// Elements are visited in dequeue (FIFO) order and the returned queue dequeues the results in that same order.
+func (queue *Queue) Map(f func(index int, value interface{}) interface{}) containers.EnumerableContainerWithIndex {
+	values := make([]interface{}, 0, queue.Size())
+	iterator := queue.Iterator()
+	for iterator.Next() {
+		values = append(values, f(iterator.Index(), iterator.Value()))
+	}
+	return newFromValues(values)
+}
+
+// Select returns a new container containing all elements for which the given function returns a true value.
+func (queue *Queue) Select(f func(index int, value interface{}) bool) containers.EnumerableContainerWithIndex {
+	values := make([]interface{}, 0)
+	iterator := queue.Iterator()
+	for iterator.Next() {
+		if f(iterator.Index(), iterator.Value()) {
+			values = append(values, iterator.Value())
+		}
+	}
+	return newFromValues(values)
+}
+
+// Any passes each element of the container to the given function and
+// returns true if the function ever returns true for any element.
+func (queue *Queue) Any(f func(index int, value interface{}) bool) bool {
+	iterator := queue.Iterator()
+	for iterator.Next() {
+		if f(iterator.Index(), iterator.Value()) {
+			return true
+		}
+	}
+	return false
+}
+
+// All passes each element of the container to the given function and
+// returns true if the function returns true for all elements.
+func (queue *Queue) All(f func(index int, value interface{}) bool) bool {
+	iterator := queue.Iterator()
+	for iterator.Next() {
+		if !f(iterator.Index(), iterator.Value()) {
+			return false
+		}
+	}
+	return true
+}
+
+// Find passes each element of the container to the given function and returns
+// the first (index,value) for which the function is true or -1,nil otherwise
+// if no element matches the criteria.
+func (queue *Queue) Find(f func(index int, value interface{}) bool) (int, interface{}) {
+	iterator := queue.Iterator()
+	for iterator.Next() {
+		if f(iterator.Index(), iterator.Value()) {
+			return iterator.Index(), iterator.Value()
+		}
+	}
+	return -1, nil
+}
+
+// newFromValues instantiates a new queue that dequeues the passed values in the given order.
+func newFromValues(values []interface{}) *Queue {
+	queue := New()
+	for _, value := range values {
+		queue.Enqueue(value)
+	}
+	return queue
+}
@@ -0,0 +1,254 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package blockingqueue implements a bounded queue that is safe for concurrent use and blocks producers while it is
+// full and consumers while it is empty.
+//
+// Elements are held in a circular buffer of a fixed capacity. Put and Take wait until there is space or an element,
+// or until their context is done, so that producer/consumer pipelines do not have to poll. Offer and Poll wait at most
+// a given timeout instead.
+//
+// A queue can be closed to signal that no more elements will be put into it. Closing wakes all waiting goroutines:
+// waiting producers fail, while consumers keep taking the remaining elements until the queue is drained.
+//
+// Structure is thread safe.
+//
+// Reference: https://en.wikipedia.org/wiki/Producer%E2%80%93consumer_problem
+package blockingqueue
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/emirpasic/gods/lists"
+	"github.com/emirpasic/gods/queues"
+	"github.com/emirpasic/gods/queues/circularbuffer"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Assert Queue implementation
+var _ queues.Queue = (*Queue)(nil)
+
+// ErrClosed is returned by operations that cannot complete because the queue is closed.
+var ErrClosed = errors.New("blockingqueue: queue is closed")
+
+// Queue holds elements in a circular buffer guarded by a lock.
+type Queue struct {
+	mutex    sync.Mutex
+	buffer   *circularbuffer.Queue
+	capacity int
+	closed   bool
+	notEmpty chan struct{} // closed when an element is added, to wake up waiting consumers, nil if there are none
+	notFull  chan struct{} // closed when an element is removed, to wake up waiting producers, nil if there are none
+}
+
+// New instantiates a new empty queue that holds at most capacity elements.
+func New(capacity int) *Queue {
+	if capacity < 1 {
+		panic("Invalid capacity, should be at least 1")
+	}
+	return &Queue{buffer: circularbuffer.New(capacity), capacity: capacity}
+}
+
+// Put adds a value to the end of the queue, waiting for space if the queue is full.
+// It returns ErrClosed if the queue is or gets closed, or the context's error if the context is done first.
+// Space is checked before the context, so that a done context still adds the value if there is space.
+func (queue *Queue) Put(ctx context.Context, value interface{}) error {
+	for {
+		queue.mutex.Lock()
+		if queue.closed {
+			queue.mutex.Unlock()
+			return ErrClosed
+		}
+		if !queue.buffer.Full() {
+			queue.buffer.Enqueue(value)
+			signal(&queue.notEmpty)
+			queue.mutex.Unlock()
+			return nil
+		}
+		notFull := wait(&queue.notFull)
+		queue.mutex.Unlock()
+		select {
+		case <-notFull:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// Take removes the first element of the queue and returns it, waiting for an element if the queue is empty.
+// It returns ErrClosed if the queue is closed and drained, or the context's error if the context is done first.
+// Elements are checked before the context, so that a done context still takes an element if there is one.
+func (queue *Queue) Take(ctx context.Context) (interface{}, error) {
+	for {
+		queue.mutex.Lock()
+		if value, ok := queue.buffer.Dequeue(); ok {
+			signal(&queue.notFull)
+			queue.mutex.Unlock()
+			return value, nil
+		}
+		if queue.closed {
+			queue.mutex.Unlock()
+			return nil, ErrClosed
+		}
+		notEmpty := wait(&queue.notEmpty)
+		queue.mutex.Unlock()
+		select {
+		case <-notEmpty:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// Offer adds a value to the end of the queue, waiting at most timeout for space if the queue is full.
+// Returns true if the value was added, false if the timeout elapsed or the queue is closed.
+// A timeout that is not positive does not wait at all.
+func (queue *Queue) Offer(value interface{}, timeout time.Duration) bool {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	return queue.Put(ctx, value) == nil
+}
+
+// Poll removes the first element of the queue and returns it, waiting at most timeout for an element if the queue is
+// empty.
+// Second return parameter is true, unless the timeout elapsed or the queue is closed and drained.
+// A timeout that is not positive does not wait at all.
+func (queue *Queue) Poll(timeout time.Duration) (value interface{}, ok bool) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	value, err := queue.Take(ctx)
+	return value, err == nil
+}
+
+// Enqueue adds a value to the end of the queue, waiting for space if the queue is full.
+// Like a send on a closed channel, it panics if the queue is closed.
+func (queue *Queue) Enqueue(value interface{}) {
+	if err := queue.Put(context.Background(), value); err != nil {
+		panic(err)
+	}
+}
+
+// Dequeue removes first element of the queue and returns it, or nil if queue is empty.
+// Second return parameter is true, unless the queue was empty and there was nothing to dequeue.
+// It does not wait, see Take and Poll for that.
+func (queue *Queue) Dequeue() (value interface{}, ok bool) {
+	queue.mutex.Lock()
+	defer queue.mutex.Unlock()
+	if value, ok = queue.buffer.Dequeue(); ok {
+		signal(&queue.notFull)
+	}
+	return value, ok
+}
+
+// Peek returns first element of the queue without removing it, or nil if queue is empty.
+// Second return parameter is true, unless the queue was empty and there was nothing to peek.
+func (queue *Queue) Peek() (value interface{}, ok bool) {
+	queue.mutex.Lock()
+	defer queue.mutex.Unlock()
+	return queue.buffer.Peek()
+}
+
+// DrainTo removes all elements of the queue, adds them to the end of the list in FIFO order and returns their number.
+// It does not wait for elements.
+func (queue *Queue) DrainTo(list lists.List) int {
+	queue.mutex.Lock()
+	values := queue.buffer.Values()
+	queue.buffer.Clear()
+	if len(values) > 0 {
+		signal(&queue.notFull)
+	}
+	queue.mutex.Unlock()
+	list.Add(values...)
+	return len(values)
+}
+
+// Close marks the queue as closed and wakes up all waiting goroutines.
+// Afterwards, no more elements can be added, but the remaining ones can still be taken.
+// Closing a closed queue does nothing.
+func (queue *Queue) Close() {
+	queue.mutex.Lock()
+	defer queue.mutex.Unlock()
+	if queue.closed {
+		return
+	}
+	queue.closed = true
+	signal(&queue.notEmpty)
+	signal(&queue.notFull)
+}
+
+// Closed returns true if the queue was closed.
+func (queue *Queue) Closed() bool {
+	queue.mutex.Lock()
+	defer queue.mutex.Unlock()
+	return queue.closed
+}
+
+// Empty returns true if queue does not contain any elements.
+func (queue *Queue) Empty() bool {
+	return queue.Size() == 0
+}
+
+// Full returns true if the queue holds as many elements as its capacity.
+func (queue *Queue) Full() bool {
+	return queue.Size() == queue.capacity
+}
+
+// Size returns number of elements within the queue.
+func (queue *Queue) Size() int {
+	queue.mutex.Lock()
+	defer queue.mutex.Unlock()
+	return queue.buffer.Size()
+}
+
+// Capacity returns the maximum number of elements that the queue can hold.
+func (queue *Queue) Capacity() int {
+	return queue.capacity
+}
+
+// Clear removes all elements from the queue and wakes up waiting producers.
+func (queue *Queue) Clear() {
+	queue.mutex.Lock()
+	defer queue.mutex.Unlock()
+	if !queue.buffer.Empty() {
+		queue.buffer.Clear()
+		signal(&queue.notFull)
+	}
+}
+
+// Values returns all elements in the queue (FIFO order).
+func (queue *Queue) Values() []interface{} {
+	queue.mutex.Lock()
+	defer queue.mutex.Unlock()
+	return queue.buffer.Values()
+}
+
+// String returns a string representation of container
+func (queue *Queue) String() string {
+	str := "BlockingQueue\n"
+	values := []string{}
+	for _, value := range queue.Values() {
+		values = append(values, fmt.Sprintf("%v", value))
+	}
+	str += strings.Join(values, ", ")
+	return str
+}
+
+// wait returns the channel to wait on for the next signal, creating it for the first waiter
+func wait(ch *chan struct{}) <-chan struct{} {
+	if *ch == nil {
+		*ch = make(chan struct{})
+	}
+	return *ch
+}
+
+// signal wakes up all goroutines waiting on the channel, if any
+func signal(ch *chan struct{}) {
+	if *ch != nil {
+		close(*ch)
+		*ch = nil
+	}
+}
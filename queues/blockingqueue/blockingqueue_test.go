@@ -0,0 +1,344 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package blockingqueue
+
+import (
+	"context"
+	"fmt"
+	"github.com/emirpasic/gods/lists/arraylist"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestQueueEnqueueAndDequeue(t *testing.T) {
+	queue := New(3)
+	if actualValue := queue.Empty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	queue.Enqueue(1)
+	queue.Enqueue(2)
+	queue.Enqueue(3)
+
+	if actualValue, expectedValue := fmt.Sprintf("%v", queue.Values()), "[1 2 3]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := queue.Size(), 3; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := queue.Capacity(), 3; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue := queue.Full(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue, ok := queue.Peek(); actualValue != 1 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 1)
+	}
+	if actualValue, ok := queue.Dequeue(); actualValue != 1 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 1)
+	}
+	if actualValue, ok := queue.Dequeue(); actualValue != 2 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 2)
+	}
+	if actualValue, ok := queue.Dequeue(); actualValue != 3 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 3)
+	}
+	if actualValue, ok := queue.Dequeue(); actualValue != nil || ok {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+	if actualValue, ok := queue.Peek(); actualValue != nil || ok {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+
+	queue.Enqueue(4)
+	queue.Clear()
+	if actualValue := queue.Empty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+}
+
+func TestQueuePutAndTake(t *testing.T) {
+	queue := New(2)
+	ctx := context.Background()
+	if err := queue.Put(ctx, "a"); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if err := queue.Put(ctx, "b"); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+
+	// blocks until the consumer makes room
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		queue.Take(ctx)
+	}()
+	if err := queue.Put(ctx, "c"); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", queue.Values()), "[b c]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	queue.Clear()
+	// blocks until the producer adds an element
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		queue.Put(ctx, "d")
+	}()
+	if actualValue, err := queue.Take(ctx); actualValue != "d" || err != nil {
+		t.Errorf("Got %v,%v expected %v,%v", actualValue, err, "d", nil)
+	}
+}
+
+func TestQueueContextCancellation(t *testing.T) {
+	queue := New(1)
+	queue.Enqueue(1)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		cancel()
+	}()
+	if err := queue.Put(ctx, 2); err != context.Canceled {
+		t.Errorf("Got %v expected %v", err, context.Canceled)
+	}
+
+	// a done context still takes an element that is already there
+	if actualValue, err := queue.Take(ctx); actualValue != 1 || err != nil {
+		t.Errorf("Got %v,%v expected %v,%v", actualValue, err, 1, nil)
+	}
+
+	ctx, cancel = context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if actualValue, err := queue.Take(ctx); actualValue != nil || err != context.DeadlineExceeded {
+		t.Errorf("Got %v,%v expected %v,%v", actualValue, err, nil, context.DeadlineExceeded)
+	}
+	if actualValue, expectedValue := queue.Size(), 0; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestQueueOfferAndPoll(t *testing.T) {
+	queue := New(1)
+	if actualValue := queue.Offer(1, 0); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := queue.Offer(2, 0); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	if actualValue := queue.Offer(2, 10*time.Millisecond); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		queue.Dequeue()
+	}()
+	if actualValue := queue.Offer(3, time.Minute); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue, ok := queue.Poll(0); actualValue != 3 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 3)
+	}
+	if actualValue, ok := queue.Poll(10 * time.Millisecond); actualValue != nil || ok {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		queue.Enqueue(4)
+	}()
+	if actualValue, ok := queue.Poll(time.Minute); actualValue != 4 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 4)
+	}
+}
+
+func TestQueueClose(t *testing.T) {
+	queue := New(1)
+	queue.Enqueue(1)
+	empty := New(1)
+
+	// closing wakes up a waiting producer and a waiting consumer
+	var wg sync.WaitGroup
+	var putErr, takeErr error
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		putErr = queue.Put(context.Background(), 2)
+	}()
+	go func() {
+		defer wg.Done()
+		_, takeErr = empty.Take(context.Background())
+	}()
+	time.Sleep(10 * time.Millisecond)
+	queue.Close()
+	queue.Close()
+	empty.Close()
+	wg.Wait()
+	if putErr != ErrClosed {
+		t.Errorf("Got %v expected %v", putErr, ErrClosed)
+	}
+	if takeErr != ErrClosed {
+		t.Errorf("Got %v expected %v", takeErr, ErrClosed)
+	}
+	if actualValue := queue.Closed(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+
+	// remaining elements can still be taken
+	if actualValue := queue.Offer(3, 0); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	if actualValue, err := queue.Take(context.Background()); actualValue != 1 || err != nil {
+		t.Errorf("Got %v,%v expected %v,%v", actualValue, err, 1, nil)
+	}
+	if actualValue, err := queue.Take(context.Background()); actualValue != nil || err != ErrClosed {
+		t.Errorf("Got %v,%v expected %v,%v", actualValue, err, nil, ErrClosed)
+	}
+	if actualValue, ok := queue.Poll(time.Minute); actualValue != nil || ok {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+
+	defer func() {
+		if r := recover(); r != ErrClosed {
+			t.Errorf("Got %v expected %v", r, ErrClosed)
+		}
+	}()
+	queue.Enqueue(4)
+}
+
+func TestQueueDrainTo(t *testing.T) {
+	queue := New(3)
+	list := arraylist.New("x")
+	if actualValue, expectedValue := queue.DrainTo(list), 0; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	queue.Enqueue("a")
+	queue.Enqueue("b")
+	queue.Enqueue("c")
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		queue.DrainTo(arraylist.New())
+	}()
+	queue.Enqueue("d") // waits for the drain
+	if actualValue, expectedValue := queue.DrainTo(list), 1; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", list.Values()), "[x d]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue := queue.Empty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+}
+
+func TestQueueProducersAndConsumers(t *testing.T) {
+	queue := New(4)
+	producers, consumers, n := 4, 4, 1000
+
+	var wg sync.WaitGroup
+	for p := 0; p < producers; p++ {
+		wg.Add(1)
+		go func(p int) {
+			defer wg.Done()
+			for i := 0; i < n; i++ {
+				if err := queue.Put(context.Background(), p*n+i); err != nil {
+					t.Errorf("Got error %v", err)
+				}
+			}
+		}(p)
+	}
+
+	var mutex sync.Mutex
+	seen := make(map[interface{}]bool)
+	var consumed sync.WaitGroup
+	for c := 0; c < consumers; c++ {
+		consumed.Add(1)
+		go func() {
+			defer consumed.Done()
+			for {
+				value, err := queue.Take(context.Background())
+				if err == ErrClosed {
+					return
+				}
+				mutex.Lock()
+				seen[value] = true
+				mutex.Unlock()
+			}
+		}()
+	}
+
+	wg.Wait()
+	queue.Close()
+	consumed.Wait()
+	if actualValue, expectedValue := len(seen), producers*n; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestQueueInvalidCapacity(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("Should panic on a capacity smaller than 1")
+		}
+	}()
+	New(0)
+}
+
+func TestQueueString(t *testing.T) {
+	c := New(3)
+	c.Enqueue(1)
+	if !strings.HasPrefix(c.String(), "BlockingQueue") {
+		t.Errorf("String should start with container name")
+	}
+}
+
+func benchmarkPutAndTake(b *testing.B, queue *Queue, size int) {
+	ctx := context.Background()
+	for i := 0; i < b.N; i++ {
+		for n := 0; n < size; n++ {
+			queue.Put(ctx, n)
+		}
+		for n := 0; n < size; n++ {
+			queue.Take(ctx)
+		}
+	}
+}
+
+func BenchmarkBlockingQueuePutAndTake100(b *testing.B) {
+	b.StopTimer()
+	size := 100
+	queue := New(size)
+	b.StartTimer()
+	benchmarkPutAndTake(b, queue, size)
+}
+
+func BenchmarkBlockingQueuePutAndTake10000(b *testing.B) {
+	b.StopTimer()
+	size := 10000
+	queue := New(size)
+	b.StartTimer()
+	benchmarkPutAndTake(b, queue, size)
+}
+
+func BenchmarkBlockingQueueProducerConsumer(b *testing.B) {
+	b.StopTimer()
+	queue := New(100)
+	done := make(chan struct{})
+	go func() {
+		for i := 0; i < b.N; i++ {
+			queue.Take(context.Background())
+		}
+		close(done)
+	}()
+	b.StartTimer()
+	for i := 0; i < b.N; i++ {
+		queue.Put(context.Background(), i)
+	}
+	<-done
+}
@@ -7,6 +7,7 @@ package linkedlistqueue
 import (
 	"encoding/json"
 	"fmt"
+	"github.com/emirpasic/gods/containers"
 	"strings"
 	"testing"
 )
@@ -271,6 +272,61 @@ func benchmarkDequeue(b *testing.B, queue *Queue, size int) {
 	}
 }
 
+func TestQueueEnumerable(t *testing.T) {
+	c := New()
+	c.Enqueue(1)
+	c.Enqueue(2)
+	c.Enqueue(3)
+	var enumerable containers.EnumerableContainerWithIndex = c
+
+	visited := []interface{}{}
+	enumerable.Each(func(index int, value interface{}) {
+		if index != len(visited) {
+			t.Errorf("Got index %v expected %v", index, len(visited))
+		}
+		visited = append(visited, value)
+	})
+	if actualValue, expectedValue := fmt.Sprint(visited), "[1 2 3]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	mapped := enumerable.Map(func(index int, value interface{}) interface{} {
+		return value.(int) * 10
+	})
+	if _, ok := mapped.(*Queue); !ok {
+		t.Errorf("Got %T expected %T", mapped, c)
+	}
+	if actualValue, expectedValue := fmt.Sprint(mapped.Values()), "[10 20 30]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	selected := enumerable.Select(func(index int, value interface{}) bool {
+		return value.(int) > 1
+	})
+	if actualValue, expectedValue := fmt.Sprint(selected.Values()), "[2 3]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	if actualValue := enumerable.Any(func(index int, value interface{}) bool { return value.(int) == 2 }); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := enumerable.Any(func(index int, value interface{}) bool { return value.(int) == 4 }); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	if actualValue := enumerable.All(func(index int, value interface{}) bool { return value.(int) > 0 }); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := enumerable.All(func(index int, value interface{}) bool { return value.(int) > 1 }); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	if index, value := enumerable.Find(func(index int, value interface{}) bool { return value.(int) == 1 }); index != 0 || value != 1 {
+		t.Errorf("Got %v at %v expected %v at %v", value, index, 1, 0)
+	}
+	if index, value := enumerable.Find(func(index int, value interface{}) bool { return value.(int) == 4 }); index != -1 || value != nil {
+		t.Errorf("Got %v at %v expected %v at %v", value, index, nil, -1)
+	}
+}
+
 func BenchmarkArrayQueueDequeue100(b *testing.B) {
 	b.StopTimer()
 	size := 100
@@ -0,0 +1,54 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package priorityqueue
+
+import (
+	"github.com/emirpasic/gods/containers"
+	"github.com/emirpasic/gods/trees/binaryheap"
+)
+
+// Assert Enumerable implementation
+var _ containers.EnumerableWithIndex = (*Queue)(nil)
+
+// Each calls the given function once for each element, passing that element's index and value.
+// Elements are visited in dequeue (priority) order, the index being the number of elements dequeued before it.
+func (queue *Queue) Each(f func(index int, value interface{})) {
+	queue.heap.Each(f)
+}
+
+// Map invokes the given function once for each element and returns a
+// container containing the values returned by the given function.
+// Elements are visited in dequeue (priority) order and the returned queue orders the results with the same comparator.
+func (queue *Queue) Map(f func(index int, value interface{}) interface{}) containers.EnumerableContainerWithIndex {
+	return &Queue{heap: queue.heap.Map(f).(*binaryheap.Heap), Comparator: queue.Comparator}
+}
+
+// Select returns a new container containing all elements for which the given function returns a true value.
+// Elements are visited in dequeue (priority) order.
+func (queue *Queue) Select(f func(index int, value interface{}) bool) containers.EnumerableContainerWithIndex {
+	return &Queue{heap: queue.heap.Select(f).(*binaryheap.Heap), Comparator: queue.Comparator}
+}
+
+// Any passes each element of the container to the given function and
+// returns true if the function ever returns true for any element.
+// Elements are visited in dequeue (priority) order.
+func (queue *Queue) Any(f func(index int, value interface{}) bool) bool {
+	return queue.heap.Any(f)
+}
+
+// All passes each element of the container to the given function and
+// returns true if the function returns true for all elements.
+// Elements are visited in dequeue (priority) order.
+func (queue *Queue) All(f func(index int, value interface{}) bool) bool {
+	return queue.heap.All(f)
+}
+
+// Find passes each element of the container to the given function and returns
+// the first (index,value) for which the function is true or -1,nil otherwise
+// if no element matches the criteria.
+// Elements are visited in dequeue (priority) order.
+func (queue *Queue) Find(f func(index int, value interface{}) bool) (int, interface{}) {
+	return queue.heap.Find(f)
+}
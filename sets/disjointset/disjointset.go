@@ -0,0 +1,190 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package disjointset implements a disjoint-set, also known as union-find, that partitions elements into disjoint sets.
+//
+// Every set is represented by one of its elements, its representative. Sets are merged by union by rank and
+// representatives are found with path compression, so that all operations take nearly constant amortized time.
+//
+// Sets are ordered by the insertion of their first element and the elements of a set by their insertion.
+//
+// Unlike the other sets, it does not implement the Set interface, since it holds a partition of its elements into
+// sets rather than a single set.
+//
+// Structure is not thread safe.
+//
+// Reference: https://en.wikipedia.org/wiki/Disjoint-set_data_structure
+package disjointset
+
+import (
+	"fmt"
+	"github.com/emirpasic/gods/containers"
+	"strings"
+)
+
+// Assert Container implementation
+var _ containers.Container = (*DisjointSet)(nil)
+
+// DisjointSet holds the elements in a forest of trees, one tree per set
+type DisjointSet struct {
+	nodes    map[interface{}]*node
+	ordering []interface{}
+	sets     int
+}
+
+type node struct {
+	element interface{}
+	parent  *node
+	rank    int // upper bound of the height of the node
+	size    int // number of elements in the set, only maintained for representatives
+}
+
+// New instantiates a new empty disjoint-set and makes a set of every passed value, if any
+func New(values ...interface{}) *DisjointSet {
+	set := &DisjointSet{nodes: make(map[interface{}]*node)}
+	set.MakeSet(values...)
+	return set
+}
+
+// MakeSet adds every element (one or more) as a set on its own.
+// Elements that are already present are ignored, so that their sets are not modified.
+func (set *DisjointSet) MakeSet(elements ...interface{}) {
+	for _, element := range elements {
+		if _, contains := set.nodes[element]; !contains {
+			n := &node{element: element, size: 1}
+			n.parent = n
+			set.nodes[element] = n
+			set.ordering = append(set.ordering, element)
+			set.sets++
+		}
+	}
+}
+
+// Find returns the representative of the set that contains the element, or nil if the element is not present.
+// Second return parameter is true if the element was found, otherwise false.
+// Two elements are in the same set if and only if they have the same representative, which may change on Union.
+func (set *DisjointSet) Find(element interface{}) (representative interface{}, found bool) {
+	n, found := set.nodes[element]
+	if !found {
+		return nil, false
+	}
+	return set.find(n).element, true
+}
+
+// Union merges the sets that contain the two elements and returns true if they were different sets.
+// Elements that are not present are added first, each as a set on its own.
+func (set *DisjointSet) Union(element1 interface{}, element2 interface{}) bool {
+	set.MakeSet(element1, element2)
+	root1, root2 := set.find(set.nodes[element1]), set.find(set.nodes[element2])
+	if root1 == root2 {
+		return false
+	}
+	if root1.rank < root2.rank {
+		root1, root2 = root2, root1
+	}
+	root2.parent = root1
+	root1.size += root2.size
+	if root1.rank == root2.rank {
+		root1.rank++
+	}
+	set.sets--
+	return true
+}
+
+// Connected returns true if the two elements are present and in the same set.
+func (set *DisjointSet) Connected(element1 interface{}, element2 interface{}) bool {
+	n1, found1 := set.nodes[element1]
+	n2, found2 := set.nodes[element2]
+	return found1 && found2 && set.find(n1) == set.find(n2)
+}
+
+// Contains checks if elements (one or more) are present in the disjoint-set.
+// All elements have to be present for the method to return true.
+// Returns true if no arguments are passed at all.
+func (set *DisjointSet) Contains(elements ...interface{}) bool {
+	for _, element := range elements {
+		if _, contains := set.nodes[element]; !contains {
+			return false
+		}
+	}
+	return true
+}
+
+// SetSize returns the number of elements in the set that contains the element, or 0 if the element is not present.
+func (set *DisjointSet) SetSize(element interface{}) int {
+	if n, found := set.nodes[element]; found {
+		return set.find(n).size
+	}
+	return 0
+}
+
+// SetCount returns the number of disjoint sets.
+func (set *DisjointSet) SetCount() int {
+	return set.sets
+}
+
+// Sets returns the elements of every set, with the sets ordered by the insertion of their first element and the
+// elements of a set by their insertion.
+func (set *DisjointSet) Sets() [][]interface{} {
+	sets := make([][]interface{}, 0, set.sets)
+	indexes := make(map[*node]int, set.sets)
+	for _, element := range set.ordering {
+		root := set.find(set.nodes[element])
+		index, found := indexes[root]
+		if !found {
+			index = len(sets)
+			indexes[root] = index
+			sets = append(sets, make([]interface{}, 0, root.size))
+		}
+		sets[index] = append(sets[index], element)
+	}
+	return sets
+}
+
+// Empty returns true if disjoint-set does not contain any elements.
+func (set *DisjointSet) Empty() bool {
+	return set.Size() == 0
+}
+
+// Size returns the number of elements in all sets.
+func (set *DisjointSet) Size() int {
+	return len(set.ordering)
+}
+
+// Clear removes all elements and sets.
+func (set *DisjointSet) Clear() {
+	set.nodes = make(map[interface{}]*node)
+	set.ordering = nil
+	set.sets = 0
+}
+
+// Values returns all elements in insertion order.
+func (set *DisjointSet) Values() []interface{} {
+	values := make([]interface{}, len(set.ordering))
+	copy(values, set.ordering)
+	return values
+}
+
+// String returns a string representation of container
+func (set *DisjointSet) String() string {
+	str := "DisjointSet\n"
+	items := []string{}
+	for _, elements := range set.Sets() {
+		items = append(items, fmt.Sprintf("%v", elements))
+	}
+	str += strings.Join(items, ", ")
+	return str
+}
+
+// find returns the root of the node's tree and points every node on the way directly to it
+func (set *DisjointSet) find(n *node) *node {
+	root := n
+	for root.parent != root {
+		root = root.parent
+	}
+	for n != root {
+		n, n.parent = n.parent, root
+	}
+	return root
+}
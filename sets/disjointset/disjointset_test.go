@@ -0,0 +1,319 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package disjointset
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+func TestDisjointSetMakeSet(t *testing.T) {
+	set := New("a", "b")
+	set.MakeSet("c", "a")
+	set.MakeSet()
+
+	if actualValue, expectedValue := set.Size(), 3; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := set.SetCount(), 3; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", set.Values()), "[a b c]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := set.Contains("a", "c"), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := set.Contains("a", "d"), false; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, actualFound := set.Find("b"); actualValue != "b" || !actualFound {
+		t.Errorf("Got %v,%v expected %v,%v", actualValue, actualFound, "b", true)
+	}
+	if actualValue, actualFound := set.Find("d"); actualValue != nil || actualFound {
+		t.Errorf("Got %v,%v expected %v,%v", actualValue, actualFound, nil, false)
+	}
+
+	// making a set of an element that is already in a larger set does not split it
+	set.Union("a", "b")
+	set.MakeSet("b")
+	if actualValue, expectedValue := set.SetSize("b"), 2; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestDisjointSetUnion(t *testing.T) {
+	set := New(1, 2, 3, 4, 5)
+
+	tests := [][]interface{}{
+		{1, 2, true},
+		{3, 4, true},
+		{2, 1, false},
+		{2, 4, true},
+		{1, 3, false},
+		{6, 7, true}, // missing elements are added
+		{7, 5, true},
+	}
+	for _, test := range tests {
+		if actualValue := set.Union(test[0], test[1]); actualValue != test[2] {
+			t.Errorf("Got %v expected %v for %v and %v", actualValue, test[2], test[0], test[1])
+		}
+	}
+	if actualValue, expectedValue := set.Size(), 7; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := set.SetCount(), 2; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", set.Sets()), "[[1 2 3 4] [5 6 7]]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	tests = [][]interface{}{
+		{1, 4, true},
+		{4, 3, true},
+		{1, 5, false},
+		{6, 5, true},
+		{1, 1, true},
+		{1, 8, false},
+		{8, 8, false},
+	}
+	for _, test := range tests {
+		if actualValue := set.Connected(test[0], test[1]); actualValue != test[2] {
+			t.Errorf("Got %v expected %v for %v and %v", actualValue, test[2], test[0], test[1])
+		}
+	}
+
+	representative, _ := set.Find(1)
+	for _, element := range []interface{}{2, 3, 4} {
+		if actualValue, _ := set.Find(element); actualValue != representative {
+			t.Errorf("Got %v expected %v", actualValue, representative)
+		}
+	}
+
+	if actualValue, expectedValue := set.SetSize(3), 4; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := set.SetSize(7), 3; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := set.SetSize(8), 0; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	set.Clear()
+	if actualValue, expectedValue := set.Empty(), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := set.SetCount(), 0; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := len(set.Sets()), 0; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestDisjointSetRankAndPathCompression(t *testing.T) {
+	set := New()
+	for i := 0; i < 1024; i += 2 {
+		set.Union(i, i+1)
+	}
+	for size := 2; size < 1024; size *= 2 {
+		for i := 0; i < 1024; i += 2 * size {
+			set.Union(i, i+size)
+		}
+	}
+	if actualValue, expectedValue := set.SetCount(), 1; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	// union by rank keeps the trees logarithmically high
+	representative, _ := set.Find(0)
+	root := set.nodes[representative]
+	if actualValue, expectedValue := root.rank, 10; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	for element, n := range set.nodes {
+		height := 0
+		for ; n.parent != n; n = n.parent {
+			height++
+		}
+		if height > 10 {
+			t.Errorf("Got height %v for %v expected at most %v", height, element, 10)
+		}
+	}
+
+	// path compression points every node on the way directly to the root
+	set.Find(1023)
+	for n := set.nodes[1023]; n != root; n = n.parent {
+		if n.parent != root {
+			t.Errorf("Got parent %v expected %v", n.parent.element, representative)
+		}
+	}
+}
+
+func TestDisjointSetRandomOperations(t *testing.T) {
+	set := New()
+	components := make(map[int]int) // element -> component label
+	random := rand.New(rand.NewSource(1))
+	for i := 0; i < 5000; i++ {
+		a, b := random.Intn(500), random.Intn(500)
+		for _, element := range []int{a, b} {
+			if _, found := components[element]; !found {
+				components[element] = element
+			}
+		}
+		expected := components[a] != components[b]
+		if expected {
+			from, to := components[b], components[a]
+			for element, label := range components {
+				if label == from {
+					components[element] = to
+				}
+			}
+		}
+		if actualValue := set.Union(a, b); actualValue != expected {
+			t.Fatalf("Got %v expected %v for %v and %v", actualValue, expected, a, b)
+		}
+	}
+
+	labels := make(map[int]int)
+	for _, label := range components {
+		labels[label]++
+	}
+	if actualValue, expectedValue := set.SetCount(), len(labels); actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	for element, label := range components {
+		if actualValue, expectedValue := set.SetSize(element), labels[label]; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		if actualValue, expectedValue := set.Connected(element, label), true; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+	size := 0
+	for _, elements := range set.Sets() {
+		for _, element := range elements {
+			if components[element.(int)] != components[elements[0].(int)] {
+				t.Errorf("Got %v and %v in the same set", element, elements[0])
+			}
+		}
+		size += len(elements)
+	}
+	if actualValue, expectedValue := size, len(components); actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestDisjointSetSerialization(t *testing.T) {
+	set := New("a", "b", "c", "d")
+	set.Union("a", "c")
+	set.Union("d", "c")
+
+	serialized, err := set.ToJSON()
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := string(serialized), `[["a","c","d"],["b"]]`; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	deserialized := New()
+	err = deserialized.FromJSON(serialized)
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", deserialized.Sets()), "[[a c d] [b]]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	_, err = json.Marshal([]interface{}{"a", "b", "c", set})
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+
+	// sets that share elements are merged
+	err = json.Unmarshal([]byte(`[["x","y"],[],["z"],["y",1]]`), &set)
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", set.Sets()), "[[x y 1] [z]]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	err = set.FromJSON([]byte(`{"a":1}`))
+	if err == nil {
+		t.Errorf("Expected an error")
+	}
+}
+
+func TestDisjointSetString(t *testing.T) {
+	set := New(1, 2, 3)
+	set.Union(1, 3)
+	if !strings.HasPrefix(set.String(), "DisjointSet") {
+		t.Errorf("String should start with container name")
+	}
+	if actualValue, expectedValue := set.String(), "DisjointSet\n[1 3], [2]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func benchmarkUnion(b *testing.B, set *DisjointSet, size int) {
+	for i := 0; i < b.N; i++ {
+		for n := 0; n < size; n++ {
+			set.Union(n, (n*7+3)%size)
+		}
+	}
+}
+
+func benchmarkFind(b *testing.B, set *DisjointSet, size int) {
+	for i := 0; i < b.N; i++ {
+		for n := 0; n < size; n++ {
+			set.Find(n)
+		}
+	}
+}
+
+func BenchmarkDisjointSetUnion100(b *testing.B) {
+	b.StopTimer()
+	size := 100
+	set := New()
+	b.StartTimer()
+	benchmarkUnion(b, set, size)
+}
+
+func BenchmarkDisjointSetUnion10000(b *testing.B) {
+	b.StopTimer()
+	size := 10000
+	set := New()
+	b.StartTimer()
+	benchmarkUnion(b, set, size)
+}
+
+func BenchmarkDisjointSetFind100(b *testing.B) {
+	b.StopTimer()
+	size := 100
+	set := New()
+	for n := 0; n < size; n++ {
+		set.Union(n, n/2)
+	}
+	b.StartTimer()
+	benchmarkFind(b, set, size)
+}
+
+func BenchmarkDisjointSetFind10000(b *testing.B) {
+	b.StopTimer()
+	size := 10000
+	set := New()
+	for n := 0; n < size; n++ {
+		set.Union(n, n/2)
+	}
+	b.StartTimer()
+	benchmarkFind(b, set, size)
+}
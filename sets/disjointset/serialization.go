@@ -0,0 +1,46 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package disjointset
+
+import (
+	"encoding/json"
+	"github.com/emirpasic/gods/containers"
+)
+
+// Assert Serialization implementation
+var _ containers.JSONSerializer = (*DisjointSet)(nil)
+var _ containers.JSONDeserializer = (*DisjointSet)(nil)
+
+// ToJSON outputs the JSON representation of the partition, i.e. an array holding the array of elements of every set.
+func (set *DisjointSet) ToJSON() ([]byte, error) {
+	return json.Marshal(set.Sets())
+}
+
+// FromJSON populates the disjoint-set from the input JSON representation.
+// Sets that share elements are merged.
+func (set *DisjointSet) FromJSON(data []byte) error {
+	sets := [][]interface{}{}
+	err := json.Unmarshal(data, &sets)
+	if err == nil {
+		set.Clear()
+		for _, elements := range sets {
+			set.MakeSet(elements...)
+			for i := 1; i < len(elements); i++ {
+				set.Union(elements[0], elements[i])
+			}
+		}
+	}
+	return err
+}
+
+// UnmarshalJSON @implements json.Unmarshaler
+func (set *DisjointSet) UnmarshalJSON(bytes []byte) error {
+	return set.FromJSON(bytes)
+}
+
+// MarshalJSON @implements json.Marshaler
+func (set *DisjointSet) MarshalJSON() ([]byte, error) {
+	return set.ToJSON()
+}
@@ -0,0 +1,71 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package hashset
+
+import "github.com/emirpasic/gods/containers"
+
+// Assert Enumerable implementation
+var _ containers.EnumerableWithIndex = (*Set)(nil)
+
+// Each calls the given function once for each element, passing that element's index and value.
+// Elements are visited in random order and the index is the position within that visit.
+func (set *Set) Each(f func(index int, value interface{})) {
+	index := 0
+	for item := range set.items {
+		f(index, item)
+		index++
+	}
+}
+
+// Map invokes the given function once for each element and returns a
+// container containing the values returned by the given function.
+func (set *Set) Map(f func(index int, value interface{}) interface{}) containers.EnumerableContainerWithIndex {
+	newSet := New()
+	set.Each(func(index int, value interface{}) {
+		newSet.Add(f(index, value))
+	})
+	return newSet
+}
+
+// Select returns a new container containing all elements for which the given function returns a true value.
+func (set *Set) Select(f func(index int, value interface{}) bool) containers.EnumerableContainerWithIndex {
+	newSet := New()
+	set.Each(func(index int, value interface{}) {
+		if f(index, value) {
+			newSet.Add(value)
+		}
+	})
+	return newSet
+}
+
+// Any passes each element of the container to the given function and
+// returns true if the function ever returns true for any element.
+func (set *Set) Any(f func(index int, value interface{}) bool) bool {
+	index, _ := set.Find(f)
+	return index != -1
+}
+
+// All passes each element of the container to the given function and
+// returns true if the function returns true for all elements.
+func (set *Set) All(f func(index int, value interface{}) bool) bool {
+	index, _ := set.Find(func(index int, value interface{}) bool {
+		return !f(index, value)
+	})
+	return index == -1
+}
+
+// Find passes each element of the container to the given function and returns
+// the first (index,value) for which the function is true or -1,nil otherwise
+// if no element matches the criteria.
+func (set *Set) Find(f func(index int, value interface{}) bool) (int, interface{}) {
+	index := 0
+	for item := range set.items {
+		if f(index, item) {
+			return index, item
+		}
+		index++
+	}
+	return -1, nil
+}
@@ -100,18 +100,18 @@ func (set *Set) String() string {
 // Intersection returns the intersection between two sets.
 // The new set consists of all elements that are both in "set" and "another".
 // Ref: https://en.wikipedia.org/wiki/Intersection_(set_theory)
-func (set *Set) Intersection(another *Set) *Set {
+func (set *Set) Intersection(another sets.Set) sets.Set {
 	result := New()
 
 	// Iterate over smaller set (optimization)
 	if set.Size() <= another.Size() {
 		for item := range set.items {
-			if _, contains := another.items[item]; contains {
+			if another.Contains(item) {
 				result.Add(item)
 			}
 		}
 	} else {
-		for item := range another.items {
+		for _, item := range another.Values() {
 			if _, contains := set.items[item]; contains {
 				result.Add(item)
 			}
@@ -124,15 +124,13 @@ func (set *Set) Intersection(another *Set) *Set {
 // Union returns the union of two sets.
 // The new set consists of all elements that are in "set" or "another" (possibly both).
 // Ref: https://en.wikipedia.org/wiki/Union_(set_theory)
-func (set *Set) Union(another *Set) *Set {
+func (set *Set) Union(another sets.Set) sets.Set {
 	result := New()
 
 	for item := range set.items {
 		result.Add(item)
 	}
-	for item := range another.items {
-		result.Add(item)
-	}
+	result.Add(another.Values()...)
 
 	return result
 }
@@ -140,14 +138,82 @@ func (set *Set) Union(another *Set) *Set {
 // Difference returns the difference between two sets.
 // The new set consists of all elements that are in "set" but not in "another".
 // Ref: https://proofwiki.org/wiki/Definition:Set_Difference
-func (set *Set) Difference(another *Set) *Set {
+func (set *Set) Difference(another sets.Set) sets.Set {
 	result := New()
 
 	for item := range set.items {
-		if _, contains := another.items[item]; !contains {
+		if !another.Contains(item) {
 			result.Add(item)
 		}
 	}
 
 	return result
 }
+
+// SymmetricDifference returns the symmetric difference between two sets.
+// The new set consists of all elements that are in exactly one of "set" and "another".
+// Ref: https://en.wikipedia.org/wiki/Symmetric_difference
+func (set *Set) SymmetricDifference(another sets.Set) sets.Set {
+	result := New()
+
+	for item := range set.items {
+		if !another.Contains(item) {
+			result.Add(item)
+		}
+	}
+	for _, item := range another.Values() {
+		if _, contains := set.items[item]; !contains {
+			result.Add(item)
+		}
+	}
+
+	return result
+}
+
+// IsSubsetOf returns true if every element of "set" is also in "another".
+// Ref: https://en.wikipedia.org/wiki/Subset
+func (set *Set) IsSubsetOf(another sets.Set) bool {
+	if set.Size() > another.Size() {
+		return false
+	}
+	for item := range set.items {
+		if !another.Contains(item) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsSupersetOf returns true if every element of "another" is also in "set".
+// Ref: https://en.wikipedia.org/wiki/Subset
+func (set *Set) IsSupersetOf(another sets.Set) bool {
+	if set.Size() < another.Size() {
+		return false
+	}
+	return set.Contains(another.Values()...)
+}
+
+// IsDisjoint returns true if "set" and "another" have no elements in common.
+// Ref: https://en.wikipedia.org/wiki/Disjoint_sets
+func (set *Set) IsDisjoint(another sets.Set) bool {
+	// Iterate over smaller set (optimization)
+	if set.Size() <= another.Size() {
+		for item := range set.items {
+			if another.Contains(item) {
+				return false
+			}
+		}
+		return true
+	}
+	for _, item := range another.Values() {
+		if _, contains := set.items[item]; contains {
+			return false
+		}
+	}
+	return true
+}
+
+// Equals returns true if "set" and "another" contain exactly the same elements.
+func (set *Set) Equals(another sets.Set) bool {
+	return set.Size() == another.Size() && set.IsSubsetOf(another)
+}
@@ -6,6 +6,9 @@ package hashset
 
 import (
 	"encoding/json"
+	"github.com/emirpasic/gods/containers"
+	"github.com/emirpasic/gods/sets"
+	"github.com/emirpasic/gods/sets/linkedhashset"
 	"strings"
 	"testing"
 )
@@ -191,6 +194,142 @@ func TestSetDifference(t *testing.T) {
 	}
 }
 
+func TestSetSymmetricDifference(t *testing.T) {
+	set := New()
+	another := New()
+
+	symmetricDifference := set.SymmetricDifference(another)
+	if actualValue, expectedValue := symmetricDifference.Size(), 0; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	set.Add("a", "b", "c", "d")
+	another.Add("c", "d", "e", "f")
+
+	symmetricDifference = set.SymmetricDifference(another)
+
+	if actualValue, expectedValue := symmetricDifference.Size(), 4; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue := symmetricDifference.Contains("a", "b", "e", "f"); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+}
+
+func TestSetIsSubsetOf(t *testing.T) {
+	set := New()
+	another := New("a", "b", "c")
+
+	if actualValue := set.IsSubsetOf(another); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	set.Add("a", "c")
+	if actualValue := set.IsSubsetOf(another); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := another.IsSubsetOf(set); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	set.Add("d")
+	if actualValue := set.IsSubsetOf(another); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+}
+
+func TestSetIsSupersetOf(t *testing.T) {
+	set := New("a", "b", "c")
+	another := New()
+
+	if actualValue := set.IsSupersetOf(another); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	another.Add("a", "c")
+	if actualValue := set.IsSupersetOf(another); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := another.IsSupersetOf(set); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	another.Add("d")
+	if actualValue := set.IsSupersetOf(another); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+}
+
+func TestSetIsDisjoint(t *testing.T) {
+	set := New()
+	another := New()
+
+	if actualValue := set.IsDisjoint(another); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	set.Add("a", "b")
+	another.Add("c", "d", "e")
+	if actualValue := set.IsDisjoint(another); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := another.IsDisjoint(set); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	another.Add("b")
+	if actualValue := set.IsDisjoint(another); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	if actualValue := another.IsDisjoint(set); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+}
+
+func TestSetEquals(t *testing.T) {
+	set := New()
+	another := New()
+
+	if actualValue := set.Equals(another); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	set.Add("a", "b", "c")
+	another.Add("c", "b", "a")
+	if actualValue := set.Equals(another); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	another.Add("d")
+	if actualValue := set.Equals(another); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	set.Add("e")
+	if actualValue := set.Equals(another); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+}
+
+func TestSetAlgebraAcrossImplementations(t *testing.T) {
+	set := New("a", "b", "c", "d")
+	var another sets.Set = linkedhashset.New("c", "d", "e", "f")
+
+	if actualValue := set.Union(another); actualValue.Size() != 6 || !actualValue.Contains("a", "b", "c", "d", "e", "f") {
+		t.Errorf("Got %v expected %v", actualValue.Values(), "[a b c d e f]")
+	}
+	if actualValue := set.Intersection(another); actualValue.Size() != 2 || !actualValue.Contains("c", "d") {
+		t.Errorf("Got %v expected %v", actualValue.Values(), "[c d]")
+	}
+	if actualValue := set.Difference(another); actualValue.Size() != 2 || !actualValue.Contains("a", "b") {
+		t.Errorf("Got %v expected %v", actualValue.Values(), "[a b]")
+	}
+	if actualValue := set.SymmetricDifference(another); actualValue.Size() != 4 || !actualValue.Contains("a", "b", "e", "f") {
+		t.Errorf("Got %v expected %v", actualValue.Values(), "[a b e f]")
+	}
+	if _, ok := set.Union(another).(*Set); !ok {
+		t.Errorf("Got %T expected %T", set.Union(another), set)
+	}
+	if actualValue := set.IsDisjoint(another); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	another = linkedhashset.New("a", "b", "c", "d")
+	if actualValue := set.Equals(another) && set.IsSubsetOf(another) && set.IsSupersetOf(another); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+}
+
 func benchmarkContains(b *testing.B, set *Set, size int) {
 	for i := 0; i < b.N; i++ {
 		for n := 0; n < size; n++ {
@@ -215,6 +354,51 @@ func benchmarkRemove(b *testing.B, set *Set, size int) {
 	}
 }
 
+func TestSetEnumerable(t *testing.T) {
+	set := New(1, 2, 3)
+	var enumerable containers.EnumerableContainerWithIndex = set
+
+	visited := New()
+	enumerable.Each(func(index int, value interface{}) {
+		if index != visited.Size() {
+			t.Errorf("Got index %v expected %v", index, visited.Size())
+		}
+		visited.Add(value)
+	})
+	if actualValue := visited.Contains(1, 2, 3) && visited.Size() == 3; actualValue != true {
+		t.Errorf("Got %v expected %v", visited, set)
+	}
+
+	mapped := enumerable.Map(func(index int, value interface{}) interface{} {
+		return value.(int) * 10
+	}).(*Set)
+	if actualValue := mapped.Contains(10, 20, 30) && mapped.Size() == 3; actualValue != true {
+		t.Errorf("Got %v expected %v", mapped, "10, 20, 30")
+	}
+	selected := enumerable.Select(func(index int, value interface{}) bool {
+		return value.(int) > 1
+	}).(*Set)
+	if actualValue := selected.Contains(2, 3) && selected.Size() == 2; actualValue != true {
+		t.Errorf("Got %v expected %v", selected, "2, 3")
+	}
+
+	if actualValue := enumerable.Any(func(index int, value interface{}) bool { return value.(int) == 2 }); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := enumerable.All(func(index int, value interface{}) bool { return value.(int) > 1 }); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	if actualValue := enumerable.All(func(index int, value interface{}) bool { return value.(int) > 0 }); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if index, value := enumerable.Find(func(index int, value interface{}) bool { return value.(int) == 3 }); index < 0 || value != 3 {
+		t.Errorf("Got %v at %v expected %v", value, index, 3)
+	}
+	if index, value := enumerable.Find(func(index int, value interface{}) bool { return value.(int) == 4 }); index != -1 || value != nil {
+		t.Errorf("Got %v at %v expected %v at %v", value, index, nil, -1)
+	}
+}
+
 func BenchmarkHashSetContains100(b *testing.B) {
 	b.StopTimer()
 	size := 100
@@ -19,7 +19,7 @@ func (set *Set) Each(f func(index int, value interface{})) {
 
 // Map invokes the given function once for each element and returns a
 // container containing the values returned by the given function.
-func (set *Set) Map(f func(index int, value interface{}) interface{}) *Set {
+func (set *Set) Map(f func(index int, value interface{}) interface{}) containers.EnumerableContainerWithIndex {
 	newSet := New()
 	iterator := set.Iterator()
 	for iterator.Next() {
@@ -29,7 +29,7 @@ func (set *Set) Map(f func(index int, value interface{}) interface{}) *Set {
 }
 
 // Select returns a new container containing all elements for which the given function returns a true value.
-func (set *Set) Select(f func(index int, value interface{}) bool) *Set {
+func (set *Set) Select(f func(index int, value interface{}) bool) containers.EnumerableContainerWithIndex {
 	newSet := New()
 	iterator := set.Iterator()
 	for iterator.Next() {
@@ -7,6 +7,8 @@ package linkedhashset
 import (
 	"encoding/json"
 	"fmt"
+	"github.com/emirpasic/gods/sets"
+	"github.com/emirpasic/gods/sets/hashset"
 	"strings"
 	"testing"
 )
@@ -107,7 +109,7 @@ func TestSetMap(t *testing.T) {
 	set.Add("c", "a", "b")
 	mappedSet := set.Map(func(index int, value interface{}) interface{} {
 		return "mapped: " + value.(string)
-	})
+	}).(*Set)
 	if actualValue, expectedValue := mappedSet.Contains("mapped: c", "mapped: b", "mapped: a"), true; actualValue != expectedValue {
 		t.Errorf("Got %v expected %v", actualValue, expectedValue)
 	}
@@ -124,7 +126,7 @@ func TestSetSelect(t *testing.T) {
 	set.Add("c", "a", "b")
 	selectedSet := set.Select(func(index int, value interface{}) bool {
 		return value.(string) >= "a" && value.(string) <= "b"
-	})
+	}).(*Set)
 	if actualValue, expectedValue := selectedSet.Contains("a", "b"), true; actualValue != expectedValue {
 		fmt.Println("A: ", selectedSet.Contains("b"))
 		t.Errorf("Got %v (%v) expected %v (%v)", actualValue, selectedSet.Values(), expectedValue, "[a b]")
@@ -544,6 +546,142 @@ func TestSetDifference(t *testing.T) {
 	}
 }
 
+func TestSetSymmetricDifference(t *testing.T) {
+	set := New()
+	another := New()
+
+	symmetricDifference := set.SymmetricDifference(another)
+	if actualValue, expectedValue := symmetricDifference.Size(), 0; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	set.Add("a", "b", "c", "d")
+	another.Add("c", "d", "e", "f")
+
+	symmetricDifference = set.SymmetricDifference(another)
+
+	if actualValue, expectedValue := symmetricDifference.Size(), 4; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue := symmetricDifference.Contains("a", "b", "e", "f"); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+}
+
+func TestSetIsSubsetOf(t *testing.T) {
+	set := New()
+	another := New("a", "b", "c")
+
+	if actualValue := set.IsSubsetOf(another); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	set.Add("a", "c")
+	if actualValue := set.IsSubsetOf(another); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := another.IsSubsetOf(set); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	set.Add("d")
+	if actualValue := set.IsSubsetOf(another); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+}
+
+func TestSetIsSupersetOf(t *testing.T) {
+	set := New("a", "b", "c")
+	another := New()
+
+	if actualValue := set.IsSupersetOf(another); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	another.Add("a", "c")
+	if actualValue := set.IsSupersetOf(another); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := another.IsSupersetOf(set); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	another.Add("d")
+	if actualValue := set.IsSupersetOf(another); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+}
+
+func TestSetIsDisjoint(t *testing.T) {
+	set := New()
+	another := New()
+
+	if actualValue := set.IsDisjoint(another); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	set.Add("a", "b")
+	another.Add("c", "d", "e")
+	if actualValue := set.IsDisjoint(another); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := another.IsDisjoint(set); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	another.Add("b")
+	if actualValue := set.IsDisjoint(another); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	if actualValue := another.IsDisjoint(set); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+}
+
+func TestSetEquals(t *testing.T) {
+	set := New()
+	another := New()
+
+	if actualValue := set.Equals(another); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	set.Add("a", "b", "c")
+	another.Add("c", "b", "a")
+	if actualValue := set.Equals(another); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	another.Add("d")
+	if actualValue := set.Equals(another); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	set.Add("e")
+	if actualValue := set.Equals(another); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+}
+
+func TestSetAlgebraAcrossImplementations(t *testing.T) {
+	set := New("a", "b", "c", "d")
+	var another sets.Set = hashset.New("c", "d", "e", "f")
+
+	if actualValue := set.Union(another); actualValue.Size() != 6 || !actualValue.Contains("a", "b", "c", "d", "e", "f") {
+		t.Errorf("Got %v expected %v", actualValue.Values(), "[a b c d e f]")
+	}
+	if actualValue := set.Intersection(another); actualValue.Size() != 2 || !actualValue.Contains("c", "d") {
+		t.Errorf("Got %v expected %v", actualValue.Values(), "[c d]")
+	}
+	if actualValue := set.Difference(another); actualValue.Size() != 2 || !actualValue.Contains("a", "b") {
+		t.Errorf("Got %v expected %v", actualValue.Values(), "[a b]")
+	}
+	if actualValue := set.SymmetricDifference(another); actualValue.Size() != 4 || !actualValue.Contains("a", "b", "e", "f") {
+		t.Errorf("Got %v expected %v", actualValue.Values(), "[a b e f]")
+	}
+	if _, ok := set.Union(another).(*Set); !ok {
+		t.Errorf("Got %T expected %T", set.Union(another), set)
+	}
+	if actualValue := set.IsDisjoint(another); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	another = hashset.New("a", "b", "c", "d")
+	if actualValue := set.Equals(another) && set.IsSubsetOf(another) && set.IsSupersetOf(another); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+}
+
 func benchmarkContains(b *testing.B, set *Set, size int) {
 	for i := 0; i < b.N; i++ {
 		for n := 0; n < size; n++ {
@@ -16,9 +16,14 @@ type Set interface {
 	Add(elements ...interface{})
 	Remove(elements ...interface{})
 	Contains(elements ...interface{}) bool
-	// Intersection(another *Set) *Set
-	// Union(another *Set) *Set
-	// Difference(another *Set) *Set
+	Union(another Set) Set
+	Intersection(another Set) Set
+	Difference(another Set) Set
+	SymmetricDifference(another Set) Set
+	IsSubsetOf(another Set) bool
+	IsSupersetOf(another Set) bool
+	IsDisjoint(another Set) bool
+	Equals(another Set) bool
 
 	containers.Container
 	// Empty() bool
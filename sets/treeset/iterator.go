@@ -114,3 +114,153 @@ func (iterator *Iterator) PrevTo(f func(index int, value interface{}) bool) bool
 	}
 	return false
 }
+
+// Assert Iterator implementation
+var _ containers.ReverseIteratorWithIndex = (*ViewIterator)(nil)
+
+// ViewIterator holding the iterator's state over a view
+type ViewIterator struct {
+	view     *View
+	index    int
+	iterator rbt.Iterator
+	position position
+}
+
+type position byte
+
+const (
+	begin, between, end position = 0, 1, 2
+)
+
+// Iterator returns a stateful iterator whose values can be fetched by an index, in the view's order.
+func (view *View) Iterator() ViewIterator {
+	return ViewIterator{view: view, index: -1, position: begin}
+}
+
+// Next moves the iterator to the next element and returns true if there was a next element in the view.
+// If Next() returns true, then next element's index and value can be retrieved by Index() and Value().
+// If Next() was called for the first time, then it will point the iterator to the first element if it exists.
+// Modifies the state of the iterator.
+func (iterator *ViewIterator) Next() bool {
+	switch iterator.position {
+	case end:
+		return false
+	case begin:
+		iterator.index++
+		return iterator.moveTo(iterator.view.first(), end)
+	}
+	iterator.index++
+	if !iterator.step(!iterator.view.descending) || !iterator.view.bounds.Contains(iterator.iterator.Key()) {
+		iterator.position = end
+		return false
+	}
+	return true
+}
+
+// Prev moves the iterator to the previous element and returns true if there was a previous element in the view.
+// If Prev() returns true, then previous element's index and value can be retrieved by Index() and Value().
+// Modifies the state of the iterator.
+func (iterator *ViewIterator) Prev() bool {
+	switch iterator.position {
+	case begin:
+		return false
+	case end:
+		iterator.index--
+		return iterator.moveTo(iterator.view.last(), begin)
+	}
+	iterator.index--
+	if !iterator.step(iterator.view.descending) || !iterator.view.bounds.Contains(iterator.iterator.Key()) {
+		iterator.position = begin
+		return false
+	}
+	return true
+}
+
+// Value returns the current element's value.
+// Does not modify the state of the iterator.
+func (iterator *ViewIterator) Value() interface{} {
+	return iterator.iterator.Key()
+}
+
+// Index returns the current element's index within the view.
+// Does not modify the state of the iterator.
+func (iterator *ViewIterator) Index() int {
+	return iterator.index
+}
+
+// Begin resets the iterator to its initial state (one-before-first)
+// Call Next() to fetch the first element if any.
+func (iterator *ViewIterator) Begin() {
+	iterator.index = -1
+	iterator.position = begin
+}
+
+// End moves the iterator past the last element (one-past-the-end).
+// Call Prev() to fetch the last element if any.
+func (iterator *ViewIterator) End() {
+	iterator.index = iterator.view.Size()
+	iterator.position = end
+}
+
+// First moves the iterator to the first element and returns true if there was a first element in the view.
+// If First() returns true, then first element's index and value can be retrieved by Index() and Value().
+// Modifies the state of the iterator.
+func (iterator *ViewIterator) First() bool {
+	iterator.Begin()
+	return iterator.Next()
+}
+
+// Last moves the iterator to the last element and returns true if there was a last element in the view.
+// If Last() returns true, then last element's index and value can be retrieved by Index() and Value().
+// Modifies the state of the iterator.
+func (iterator *ViewIterator) Last() bool {
+	iterator.End()
+	return iterator.Prev()
+}
+
+// NextTo moves the iterator to the next element from current position that satisfies the condition given by the
+// passed function, and returns true if there was a next element in the view.
+// If NextTo() returns true, then next element's index and value can be retrieved by Index() and Value().
+// Modifies the state of the iterator.
+func (iterator *ViewIterator) NextTo(f func(index int, value interface{}) bool) bool {
+	for iterator.Next() {
+		index, value := iterator.Index(), iterator.Value()
+		if f(index, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// PrevTo moves the iterator to the previous element from current position that satisfies the condition given by the
+// passed function, and returns true if there was a next element in the view.
+// If PrevTo() returns true, then next element's index and value can be retrieved by Index() and Value().
+// Modifies the state of the iterator.
+func (iterator *ViewIterator) PrevTo(f func(index int, value interface{}) bool) bool {
+	for iterator.Prev() {
+		index, value := iterator.Index(), iterator.Value()
+		if f(index, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// moveTo points the iterator to node, or to the given out-of-range position if node is nil.
+func (iterator *ViewIterator) moveTo(node *rbt.Node, otherwise position) bool {
+	if node == nil {
+		iterator.position = otherwise
+		return false
+	}
+	iterator.iterator = iterator.view.set.tree.IteratorAt(node)
+	iterator.position = between
+	return true
+}
+
+// step moves the underlying tree iterator one element up (in ascending order) or down.
+func (iterator *ViewIterator) step(up bool) bool {
+	if up {
+		return iterator.iterator.Next()
+	}
+	return iterator.iterator.Prev()
+}
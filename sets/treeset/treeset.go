@@ -101,6 +101,133 @@ func (set *Set) Values() []interface{} {
 	return set.tree.Keys()
 }
 
+// GetByIndex returns the element at the given (zero-based) index in the order of the set.
+// Second return parameter is true if index is within bounds, otherwise false.
+// Runs in O(log n) time.
+func (set *Set) GetByIndex(index int) (value interface{}, found bool) {
+	if node := set.tree.Select(index); node != nil {
+		return node.Key, true
+	}
+	return nil, false
+}
+
+// IndexOf returns the (zero-based) index of the item in the order of the set, or -1 if item is not found.
+// Runs in O(log n) time.
+// Item should adhere to the comparator's type assertion, otherwise method panics.
+func (set *Set) IndexOf(item interface{}) int {
+	if set.tree.GetNode(item) == nil {
+		return -1
+	}
+	return set.tree.Rank(item)
+}
+
+// First returns the smallest element in the set.
+// Second return parameter is false if set is empty.
+func (set *Set) First() (value interface{}, found bool) {
+	if node := set.tree.Left(); node != nil {
+		return node.Key, true
+	}
+	return nil, false
+}
+
+// Last returns the largest element in the set.
+// Second return parameter is false if set is empty.
+func (set *Set) Last() (value interface{}, found bool) {
+	if node := set.tree.Right(); node != nil {
+		return node.Key, true
+	}
+	return nil, false
+}
+
+// Floor returns the largest element in the set that is smaller than or equal to the given item.
+// Second return parameter is false if there is no such element.
+// Item should adhere to the comparator's type assertion, otherwise method panics.
+func (set *Set) Floor(item interface{}) (value interface{}, found bool) {
+	if node, found := set.tree.Floor(item); found {
+		return node.Key, true
+	}
+	return nil, false
+}
+
+// Ceiling returns the smallest element in the set that is larger than or equal to the given item.
+// Second return parameter is false if there is no such element.
+// Item should adhere to the comparator's type assertion, otherwise method panics.
+func (set *Set) Ceiling(item interface{}) (value interface{}, found bool) {
+	if node, found := set.tree.Ceiling(item); found {
+		return node.Key, true
+	}
+	return nil, false
+}
+
+// Lower returns the largest element in the set that is strictly smaller than the given item.
+// Second return parameter is false if there is no such element.
+// Item should adhere to the comparator's type assertion, otherwise method panics.
+func (set *Set) Lower(item interface{}) (value interface{}, found bool) {
+	if node, found := set.tree.Lower(item); found {
+		return node.Key, true
+	}
+	return nil, false
+}
+
+// Higher returns the smallest element in the set that is strictly larger than the given item.
+// Second return parameter is false if there is no such element.
+// Item should adhere to the comparator's type assertion, otherwise method panics.
+func (set *Set) Higher(item interface{}) (value interface{}, found bool) {
+	if node, found := set.tree.Higher(item); found {
+		return node.Key, true
+	}
+	return nil, false
+}
+
+// PollFirst removes the smallest element from the set and returns it.
+// Second return parameter is false if set is empty.
+func (set *Set) PollFirst() (value interface{}, found bool) {
+	if value, found = set.First(); found {
+		set.tree.Remove(value)
+	}
+	return value, found
+}
+
+// PollLast removes the largest element from the set and returns it.
+// Second return parameter is false if set is empty.
+func (set *Set) PollLast() (value interface{}, found bool) {
+	if value, found = set.Last(); found {
+		set.tree.Remove(value)
+	}
+	return value, found
+}
+
+// SubSet returns a view of the portion of the set whose elements range from fromItem to toItem.
+// Each bound is included in the view if its inclusive flag is set.
+// The view is backed by the set, so changes in the set are reflected in the view.
+//
+// Items should adhere to the comparator's type assertion, otherwise method panics.
+func (set *Set) SubSet(fromItem interface{}, fromInclusive bool, toItem interface{}, toInclusive bool) *View {
+	return &View{set: set, bounds: set.tree.Range().From(fromItem, fromInclusive).To(toItem, toInclusive)}
+}
+
+// HeadSet returns a view of the portion of the set whose elements are smaller than (or equal to, if inclusive is set) toItem.
+// The view is backed by the set, so changes in the set are reflected in the view.
+//
+// Item should adhere to the comparator's type assertion, otherwise method panics.
+func (set *Set) HeadSet(toItem interface{}, inclusive bool) *View {
+	return &View{set: set, bounds: set.tree.Range().To(toItem, inclusive)}
+}
+
+// TailSet returns a view of the portion of the set whose elements are larger than (or equal to, if inclusive is set) fromItem.
+// The view is backed by the set, so changes in the set are reflected in the view.
+//
+// Item should adhere to the comparator's type assertion, otherwise method panics.
+func (set *Set) TailSet(fromItem interface{}, inclusive bool) *View {
+	return &View{set: set, bounds: set.tree.Range().From(fromItem, inclusive)}
+}
+
+// DescendingSet returns a view of the whole set with its elements in reverse order.
+// The view is backed by the set, so changes in the set are reflected in the view.
+func (set *Set) DescendingSet() *View {
+	return &View{set: set, bounds: set.tree.Range(), descending: true}
+}
+
 // String returns a string representation of container
 func (set *Set) String() string {
 	str := "TreeSet\n"
@@ -114,29 +241,24 @@ func (set *Set) String() string {
 
 // Intersection returns the intersection between two sets.
 // The new set consists of all elements that are both in "set" and "another".
-// The two sets should have the same comparators, otherwise the result is empty set.
+// If both are tree sets ordered by the same one of the comparators in utils, they are walked at the same time in linear time.
 // Ref: https://en.wikipedia.org/wiki/Intersection_(set_theory)
-func (set *Set) Intersection(another *Set) *Set {
+func (set *Set) Intersection(another sets.Set) sets.Set {
 	result := NewWith(set.tree.Comparator)
 
-	setComparator := reflect.ValueOf(set.tree.Comparator)
-	anotherComparator := reflect.ValueOf(another.tree.Comparator)
-	if setComparator.Pointer() != anotherComparator.Pointer() {
+	if other, ok := another.(*Set); ok && set.sameComparator(other) {
+		set.merge(other, func(value interface{}, inSet, inAnother bool) bool {
+			if inSet && inAnother {
+				result.Add(value)
+			}
+			return true
+		})
 		return result
 	}
 
-	// Iterate over smaller set (optimization)
-	if set.Size() <= another.Size() {
-		for it := set.Iterator(); it.Next(); {
-			if another.Contains(it.Value()) {
-				result.Add(it.Value())
-			}
-		}
-	} else {
-		for it := another.Iterator(); it.Next(); {
-			if set.Contains(it.Value()) {
-				result.Add(it.Value())
-			}
+	for it := set.Iterator(); it.Next(); {
+		if another.Contains(it.Value()) {
+			result.Add(it.Value())
 		}
 	}
 
@@ -145,37 +267,39 @@ func (set *Set) Intersection(another *Set) *Set {
 
 // Union returns the union of two sets.
 // The new set consists of all elements that are in "set" or "another" (possibly both).
-// The two sets should have the same comparators, otherwise the result is empty set.
+// If both are tree sets ordered by the same one of the comparators in utils, they are walked at the same time in linear time.
 // Ref: https://en.wikipedia.org/wiki/Union_(set_theory)
-func (set *Set) Union(another *Set) *Set {
+func (set *Set) Union(another sets.Set) sets.Set {
 	result := NewWith(set.tree.Comparator)
 
-	setComparator := reflect.ValueOf(set.tree.Comparator)
-	anotherComparator := reflect.ValueOf(another.tree.Comparator)
-	if setComparator.Pointer() != anotherComparator.Pointer() {
+	if other, ok := another.(*Set); ok && set.sameComparator(other) {
+		set.merge(other, func(value interface{}, inSet, inAnother bool) bool {
+			result.Add(value)
+			return true
+		})
 		return result
 	}
 
-	for it := set.Iterator(); it.Next(); {
-		result.Add(it.Value())
-	}
-	for it := another.Iterator(); it.Next(); {
-		result.Add(it.Value())
-	}
+	result.Add(set.Values()...)
+	result.Add(another.Values()...)
 
 	return result
 }
 
 // Difference returns the difference between two sets.
-// The two sets should have the same comparators, otherwise the result is empty set.
 // The new set consists of all elements that are in "set" but not in "another".
+// If both are tree sets ordered by the same one of the comparators in utils, they are walked at the same time in linear time.
 // Ref: https://proofwiki.org/wiki/Definition:Set_Difference
-func (set *Set) Difference(another *Set) *Set {
+func (set *Set) Difference(another sets.Set) sets.Set {
 	result := NewWith(set.tree.Comparator)
 
-	setComparator := reflect.ValueOf(set.tree.Comparator)
-	anotherComparator := reflect.ValueOf(another.tree.Comparator)
-	if setComparator.Pointer() != anotherComparator.Pointer() {
+	if other, ok := another.(*Set); ok && set.sameComparator(other) {
+		set.merge(other, func(value interface{}, inSet, inAnother bool) bool {
+			if inSet && !inAnother {
+				result.Add(value)
+			}
+			return true
+		})
 		return result
 	}
 
@@ -187,3 +311,171 @@ func (set *Set) Difference(another *Set) *Set {
 
 	return result
 }
+
+// SymmetricDifference returns the symmetric difference between two sets.
+// The new set consists of all elements that are in exactly one of "set" and "another".
+// If both are tree sets ordered by the same one of the comparators in utils, they are walked at the same time in linear time.
+// Ref: https://en.wikipedia.org/wiki/Symmetric_difference
+func (set *Set) SymmetricDifference(another sets.Set) sets.Set {
+	result := NewWith(set.tree.Comparator)
+
+	if other, ok := another.(*Set); ok && set.sameComparator(other) {
+		set.merge(other, func(value interface{}, inSet, inAnother bool) bool {
+			if inSet != inAnother {
+				result.Add(value)
+			}
+			return true
+		})
+		return result
+	}
+
+	for it := set.Iterator(); it.Next(); {
+		if !another.Contains(it.Value()) {
+			result.Add(it.Value())
+		}
+	}
+	for _, item := range another.Values() {
+		if !set.Contains(item) {
+			result.Add(item)
+		}
+	}
+
+	return result
+}
+
+// IsSubsetOf returns true if every element of "set" is also in "another".
+// If both are tree sets ordered by the same one of the comparators in utils, they are walked at the same time in linear time.
+// Ref: https://en.wikipedia.org/wiki/Subset
+func (set *Set) IsSubsetOf(another sets.Set) bool {
+	if set.Size() > another.Size() {
+		return false
+	}
+
+	if other, ok := another.(*Set); ok && set.sameComparator(other) {
+		isSubset := true
+		set.merge(other, func(value interface{}, inSet, inAnother bool) bool {
+			isSubset = !inSet || inAnother
+			return isSubset
+		})
+		return isSubset
+	}
+
+	for it := set.Iterator(); it.Next(); {
+		if !another.Contains(it.Value()) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsSupersetOf returns true if every element of "another" is also in "set".
+// If both are tree sets ordered by the same one of the comparators in utils, they are walked at the same time in linear time.
+// Ref: https://en.wikipedia.org/wiki/Subset
+func (set *Set) IsSupersetOf(another sets.Set) bool {
+	if set.Size() < another.Size() {
+		return false
+	}
+
+	if other, ok := another.(*Set); ok && set.sameComparator(other) {
+		isSuperset := true
+		set.merge(other, func(value interface{}, inSet, inAnother bool) bool {
+			isSuperset = inSet || !inAnother
+			return isSuperset
+		})
+		return isSuperset
+	}
+
+	return set.Contains(another.Values()...)
+}
+
+// IsDisjoint returns true if "set" and "another" have no elements in common.
+// If both are tree sets ordered by the same one of the comparators in utils, they are walked at the same time in linear time.
+// Ref: https://en.wikipedia.org/wiki/Disjoint_sets
+func (set *Set) IsDisjoint(another sets.Set) bool {
+	if other, ok := another.(*Set); ok && set.sameComparator(other) {
+		isDisjoint := true
+		set.merge(other, func(value interface{}, inSet, inAnother bool) bool {
+			isDisjoint = !inSet || !inAnother
+			return isDisjoint
+		})
+		return isDisjoint
+	}
+
+	for it := set.Iterator(); it.Next(); {
+		if another.Contains(it.Value()) {
+			return false
+		}
+	}
+	return true
+}
+
+// Equals returns true if "set" and "another" contain exactly the same elements.
+func (set *Set) Equals(another sets.Set) bool {
+	return set.Size() == another.Size() && set.IsSubsetOf(another)
+}
+
+// sameComparator returns true if both tree sets are provably ordered alike, i.e. by the same one of the comparators
+// provided in utils. Other comparators cannot be told apart, since closures created by the same function share their
+// code pointer while capturing a different state, e.g. ascending and descending comparators from one factory.
+func (set *Set) sameComparator(another *Set) bool {
+	pointer := reflect.ValueOf(set.tree.Comparator).Pointer()
+	if _, ok := utilsComparators[pointer]; !ok {
+		return false
+	}
+	return pointer == reflect.ValueOf(another.tree.Comparator).Pointer()
+}
+
+// utilsComparators holds the code pointers of the comparators provided in utils.
+// They are plain functions capturing no state, so equal pointers mean equal orderings.
+var utilsComparators = func() map[uintptr]struct{} {
+	pointers := make(map[uintptr]struct{})
+	for _, comparator := range []utils.Comparator{
+		utils.StringComparator, utils.IntComparator, utils.Int8Comparator, utils.Int16Comparator,
+		utils.Int32Comparator, utils.Int64Comparator, utils.UIntComparator, utils.UInt8Comparator,
+		utils.UInt16Comparator, utils.UInt32Comparator, utils.UInt64Comparator, utils.Float32Comparator,
+		utils.Float64Comparator, utils.ByteComparator, utils.RuneComparator, utils.TimeComparator,
+	} {
+		pointers[reflect.ValueOf(comparator).Pointer()] = itemExists
+	}
+	return pointers
+}()
+
+// merge walks both sets in ascending order at the same time, in linear time, calling f once for every distinct element
+// with flags telling in which of the sets the element is present. Walking stops early if f returns false.
+func (set *Set) merge(another *Set, f func(value interface{}, inSet, inAnother bool) bool) {
+	comparator := set.tree.Comparator
+	it1, it2 := set.tree.Iterator(), another.tree.Iterator()
+	ok1, ok2 := it1.Next(), it2.Next()
+	for ok1 || ok2 {
+		switch {
+		case !ok2:
+			if !f(it1.Key(), true, false) {
+				return
+			}
+			ok1 = it1.Next()
+		case !ok1:
+			if !f(it2.Key(), false, true) {
+				return
+			}
+			ok2 = it2.Next()
+		default:
+			switch compare := comparator(it1.Key(), it2.Key()); {
+			case compare < 0:
+				if !f(it1.Key(), true, false) {
+					return
+				}
+				ok1 = it1.Next()
+			case compare > 0:
+				if !f(it2.Key(), false, true) {
+					return
+				}
+				ok2 = it2.Next()
+			default:
+				if !f(it1.Key(), true, true) {
+					return
+				}
+				ok1, ok2 = it1.Next(), it2.Next()
+			}
+		}
+	}
+}
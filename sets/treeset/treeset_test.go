@@ -7,6 +7,10 @@ package treeset
 import (
 	"encoding/json"
 	"fmt"
+	"github.com/emirpasic/gods/containers"
+	"github.com/emirpasic/gods/sets"
+	"github.com/emirpasic/gods/sets/hashset"
+	"github.com/emirpasic/gods/utils"
 	"strings"
 	"testing"
 )
@@ -108,7 +112,7 @@ func TestSetMap(t *testing.T) {
 	set.Add("c", "a", "b")
 	mappedSet := set.Map(func(index int, value interface{}) interface{} {
 		return "mapped: " + value.(string)
-	})
+	}).(*Set)
 	if actualValue, expectedValue := mappedSet.Contains("mapped: a", "mapped: b", "mapped: c"), true; actualValue != expectedValue {
 		t.Errorf("Got %v expected %v", actualValue, expectedValue)
 	}
@@ -120,12 +124,27 @@ func TestSetMap(t *testing.T) {
 	}
 }
 
+func TestSetEnumerableInterface(t *testing.T) {
+	var enumerable containers.EnumerableContainerWithIndex = NewWithIntComparator(3, 1, 2)
+	result := enumerable.Select(func(index int, value interface{}) bool {
+		return value.(int) > 1
+	}).Map(func(index int, value interface{}) interface{} {
+		return value.(int) * 10
+	})
+	if _, ok := result.(*Set); !ok {
+		t.Errorf("Got %T expected %T", result, &Set{})
+	}
+	if actualValue, expectedValue := fmt.Sprint(result.Values()), "[20 30]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
 func TestSetSelect(t *testing.T) {
 	set := NewWithStringComparator()
 	set.Add("c", "a", "b")
 	selectedSet := set.Select(func(index int, value interface{}) bool {
 		return value.(string) >= "a" && value.(string) <= "b"
-	})
+	}).(*Set)
 	if actualValue, expectedValue := selectedSet.Contains("a", "b"), true; actualValue != expectedValue {
 		fmt.Println("A: ", selectedSet.Contains("b"))
 		t.Errorf("Got %v (%v) expected %v (%v)", actualValue, selectedSet.Values(), expectedValue, "[a b]")
@@ -487,14 +506,228 @@ func TestSetString(t *testing.T) {
 	}
 }
 
+func TestSetGetByIndexAndIndexOf(t *testing.T) {
+	set := NewWithStringComparator()
+
+	if actualValue, found := set.GetByIndex(0); actualValue != nil || found {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+	if actualValue := set.IndexOf("a"); actualValue != -1 {
+		t.Errorf("Got %v expected %v", actualValue, -1)
+	}
+
+	set.Add("c", "a", "b")
+
+	for index, item := range []string{"a", "b", "c"} {
+		if actualValue, found := set.GetByIndex(index); actualValue != item || !found {
+			t.Errorf("Got %v expected %v", actualValue, item)
+		}
+		if actualValue := set.IndexOf(item); actualValue != index {
+			t.Errorf("Got %v expected %v", actualValue, index)
+		}
+	}
+	if actualValue, found := set.GetByIndex(-1); actualValue != nil || found {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+	if actualValue := set.IndexOf("d"); actualValue != -1 {
+		t.Errorf("Got %v expected %v", actualValue, -1)
+	}
+}
+
+func TestSetNavigation(t *testing.T) {
+	set := NewWithIntComparator()
+
+	if actualValue, found := set.First(); actualValue != nil || found {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+	if actualValue, found := set.Last(); actualValue != nil || found {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+
+	set.Add(7, 3, 1)
+
+	// item,expectedFloor,expectedCeiling,expectedLower,expectedHigher
+	tests := [][]interface{}{
+		{0, nil, 1, nil, 1},
+		{1, 1, 1, nil, 3},
+		{2, 1, 3, 1, 3},
+		{3, 3, 3, 1, 7},
+		{7, 7, 7, 3, nil},
+		{8, 7, nil, 7, nil},
+	}
+	for _, test := range tests {
+		if actualValue, found := set.Floor(test[0]); actualValue != test[1] || found != (test[1] != nil) {
+			t.Errorf("Got %v expected %v", actualValue, test[1])
+		}
+		if actualValue, found := set.Ceiling(test[0]); actualValue != test[2] || found != (test[2] != nil) {
+			t.Errorf("Got %v expected %v", actualValue, test[2])
+		}
+		if actualValue, found := set.Lower(test[0]); actualValue != test[3] || found != (test[3] != nil) {
+			t.Errorf("Got %v expected %v", actualValue, test[3])
+		}
+		if actualValue, found := set.Higher(test[0]); actualValue != test[4] || found != (test[4] != nil) {
+			t.Errorf("Got %v expected %v", actualValue, test[4])
+		}
+	}
+
+	if actualValue, found := set.First(); actualValue != 1 || !found {
+		t.Errorf("Got %v expected %v", actualValue, 1)
+	}
+	if actualValue, found := set.Last(); actualValue != 7 || !found {
+		t.Errorf("Got %v expected %v", actualValue, 7)
+	}
+	if actualValue, found := set.PollFirst(); actualValue != 1 || !found {
+		t.Errorf("Got %v expected %v", actualValue, 1)
+	}
+	if actualValue, found := set.PollLast(); actualValue != 7 || !found {
+		t.Errorf("Got %v expected %v", actualValue, 7)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", set.Values()), "[3]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	set.Clear()
+	if actualValue, found := set.PollFirst(); actualValue != nil || found {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+	if actualValue, found := set.PollLast(); actualValue != nil || found {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+}
+
+func TestSetSubSet(t *testing.T) {
+	set := NewWithIntComparator(1, 2, 3, 4, 5, 6, 7, 8, 9)
+
+	tests := []struct {
+		view     *View
+		expected string
+	}{
+		{set.SubSet(3, true, 6, true), "[3 4 5 6]"},
+		{set.SubSet(3, false, 6, false), "[4 5]"},
+		{set.SubSet(0, true, 100, true), "[1 2 3 4 5 6 7 8 9]"},
+		{set.SubSet(5, false, 5, true), "[]"},
+		{set.SubSet(7, true, 3, true), "[]"},
+		{set.HeadSet(3, true), "[1 2 3]"},
+		{set.HeadSet(3, false), "[1 2]"},
+		{set.HeadSet(1, false), "[]"},
+		{set.TailSet(7, true), "[7 8 9]"},
+		{set.TailSet(7, false), "[8 9]"},
+		{set.TailSet(9, false), "[]"},
+		{set.DescendingSet(), "[9 8 7 6 5 4 3 2 1]"},
+		{set.SubSet(3, true, 6, false).DescendingSet(), "[5 4 3]"},
+		{set.HeadSet(3, true).DescendingSet().DescendingSet(), "[1 2 3]"},
+	}
+
+	for _, test := range tests {
+		if actualValue := fmt.Sprintf("%v", test.view.Values()); actualValue != test.expected {
+			t.Errorf("Got %v expected %v", actualValue, test.expected)
+		}
+		if actualValue, expectedValue := test.view.Size(), len(test.view.Values()); actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		if actualValue, expectedValue := test.view.Empty(), test.expected == "[]"; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+
+	view := set.SubSet(3, true, 6, false)
+	if actualValue := view.Contains(3, 4, 5); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := view.Contains(6); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	if actualValue, found := view.First(); actualValue != 3 || !found {
+		t.Errorf("Got %v expected %v", actualValue, 3)
+	}
+	if actualValue, found := view.DescendingSet().First(); actualValue != 5 || !found {
+		t.Errorf("Got %v expected %v", actualValue, 5)
+	}
+	if actualValue, found := view.Last(); actualValue != 5 || !found {
+		t.Errorf("Got %v expected %v", actualValue, 5)
+	}
+	if actualValue, found := set.SubSet(5, false, 6, false).First(); actualValue != nil || found {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+
+	// views are live
+	set.Remove(4)
+	set.Add(100)
+	if actualValue, expectedValue := fmt.Sprintf("%v", view.Values()), "[3 5]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	view.Remove(7, 5)
+	if actualValue, expectedValue := fmt.Sprintf("%v", set.Values()), "[1 2 3 6 7 8 9 100]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	set.TailSet(6, true).Clear()
+	if actualValue, expectedValue := fmt.Sprintf("%v", set.Values()), "[1 2 3]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := view.String(), "TreeSetView\n3"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestSetViewIterator(t *testing.T) {
+	set := NewWithIntComparator(1, 2, 3, 4, 5, 6, 7, 8, 9)
+
+	for _, view := range []*View{set.SubSet(3, false, 7, true), set.SubSet(3, false, 7, true).DescendingSet()} {
+		expected := []interface{}{4, 5, 6, 7}
+		if view.descending {
+			expected = []interface{}{7, 6, 5, 4}
+		}
+
+		it := view.Iterator()
+		count := 0
+		for it.Next() {
+			if actualValue, expectedValue := it.Value(), expected[count]; actualValue != expectedValue {
+				t.Errorf("Got %v expected %v", actualValue, expectedValue)
+			}
+			if actualValue, expectedValue := it.Index(), count; actualValue != expectedValue {
+				t.Errorf("Got %v expected %v", actualValue, expectedValue)
+			}
+			count++
+		}
+		if actualValue, expectedValue := count, len(expected); actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		for it.Prev() {
+			count--
+			if actualValue, expectedValue := it.Value(), expected[count]; actualValue != expectedValue {
+				t.Errorf("Got %v expected %v", actualValue, expectedValue)
+			}
+			if actualValue, expectedValue := it.Index(), count; actualValue != expectedValue {
+				t.Errorf("Got %v expected %v", actualValue, expectedValue)
+			}
+		}
+		if actualValue, expectedValue := count, 0; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		if actualValue := it.Last(); actualValue != true || it.Value() != expected[3] || it.Index() != 3 {
+			t.Errorf("Got %v at %v expected %v at %v", it.Value(), it.Index(), expected[3], 3)
+		}
+		if actualValue := it.First(); actualValue != true || it.Value() != expected[0] || it.Index() != 0 {
+			t.Errorf("Got %v at %v expected %v at %v", it.Value(), it.Index(), expected[0], 0)
+		}
+		if actualValue := it.NextTo(func(index int, value interface{}) bool { return value.(int)%2 == 0 }); actualValue != true || it.Value() != 6 {
+			t.Errorf("Got %v expected %v", it.Value(), 6)
+		}
+	}
+
+	empty := set.SubSet(5, false, 5, false).Iterator()
+	if empty.Next() || empty.Prev() || empty.First() || empty.Last() {
+		t.Errorf("Shouldn't iterate on empty view")
+	}
+}
+
 func TestSetIntersection(t *testing.T) {
 	{
 		set := NewWithStringComparator()
-		another := NewWithIntComparator()
+		another := NewWith(func(a, b interface{}) int { return -utils.StringComparator(a, b) })
 		set.Add("a", "b", "c", "d")
-		another.Add(1, 2, 3, 4)
-		difference := set.Difference(another)
-		if actualValue, expectedValue := difference.Size(), 0; actualValue != expectedValue {
+		another.Add("c", "d", "e", "f")
+		intersection := set.Intersection(another)
+		if actualValue, expectedValue := intersection.Size(), 2; actualValue != expectedValue {
 			t.Errorf("Got %v expected %v", actualValue, expectedValue)
 		}
 	}
@@ -523,11 +756,11 @@ func TestSetIntersection(t *testing.T) {
 func TestSetUnion(t *testing.T) {
 	{
 		set := NewWithStringComparator()
-		another := NewWithIntComparator()
+		another := NewWith(func(a, b interface{}) int { return -utils.StringComparator(a, b) })
 		set.Add("a", "b", "c", "d")
-		another.Add(1, 2, 3, 4)
-		difference := set.Difference(another)
-		if actualValue, expectedValue := difference.Size(), 0; actualValue != expectedValue {
+		another.Add("c", "d", "e", "f")
+		union := set.Union(another)
+		if actualValue, expectedValue := union.Size(), 6; actualValue != expectedValue {
 			t.Errorf("Got %v expected %v", actualValue, expectedValue)
 		}
 	}
@@ -556,11 +789,11 @@ func TestSetUnion(t *testing.T) {
 func TestSetDifference(t *testing.T) {
 	{
 		set := NewWithStringComparator()
-		another := NewWithIntComparator()
+		another := NewWith(func(a, b interface{}) int { return -utils.StringComparator(a, b) })
 		set.Add("a", "b", "c", "d")
-		another.Add(1, 2, 3, 4)
+		another.Add("c", "d", "e", "f")
 		difference := set.Difference(another)
-		if actualValue, expectedValue := difference.Size(), 0; actualValue != expectedValue {
+		if actualValue, expectedValue := difference.Size(), 2; actualValue != expectedValue {
 			t.Errorf("Got %v expected %v", actualValue, expectedValue)
 		}
 	}
@@ -586,6 +819,216 @@ func TestSetDifference(t *testing.T) {
 	}
 }
 
+func TestSetSymmetricDifference(t *testing.T) {
+	set := NewWithStringComparator()
+	another := NewWithStringComparator()
+
+	symmetricDifference := set.SymmetricDifference(another)
+	if actualValue, expectedValue := symmetricDifference.Size(), 0; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	set.Add("a", "b", "c", "d")
+	another.Add("c", "d", "e", "f")
+
+	symmetricDifference = set.SymmetricDifference(another)
+
+	if actualValue, expectedValue := symmetricDifference.Size(), 4; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue := symmetricDifference.Contains("a", "b", "e", "f"); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+}
+
+func TestSetIsSubsetOf(t *testing.T) {
+	set := NewWithStringComparator()
+	another := NewWithStringComparator("a", "b", "c")
+
+	if actualValue := set.IsSubsetOf(another); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	set.Add("a", "c")
+	if actualValue := set.IsSubsetOf(another); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := another.IsSubsetOf(set); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	set.Add("d")
+	if actualValue := set.IsSubsetOf(another); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+}
+
+func TestSetIsSupersetOf(t *testing.T) {
+	set := NewWithStringComparator("a", "b", "c")
+	another := NewWithStringComparator()
+
+	if actualValue := set.IsSupersetOf(another); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	another.Add("a", "c")
+	if actualValue := set.IsSupersetOf(another); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := another.IsSupersetOf(set); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	another.Add("d")
+	if actualValue := set.IsSupersetOf(another); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+}
+
+func TestSetIsDisjoint(t *testing.T) {
+	set := NewWithStringComparator()
+	another := NewWithStringComparator()
+
+	if actualValue := set.IsDisjoint(another); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	set.Add("a", "b")
+	another.Add("c", "d", "e")
+	if actualValue := set.IsDisjoint(another); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := another.IsDisjoint(set); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	another.Add("b")
+	if actualValue := set.IsDisjoint(another); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	if actualValue := another.IsDisjoint(set); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+}
+
+func TestSetEquals(t *testing.T) {
+	set := NewWithStringComparator()
+	another := NewWithStringComparator()
+
+	if actualValue := set.Equals(another); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	set.Add("a", "b", "c")
+	another.Add("c", "b", "a")
+	if actualValue := set.Equals(another); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	another.Add("d")
+	if actualValue := set.Equals(another); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	set.Add("e")
+	if actualValue := set.Equals(another); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+}
+
+func TestSetAlgebraAcrossImplementations(t *testing.T) {
+	set := NewWithStringComparator("a", "b", "c", "d")
+	var another sets.Set = hashset.New("c", "d", "e", "f")
+
+	if actualValue := set.Union(another); actualValue.Size() != 6 || !actualValue.Contains("a", "b", "c", "d", "e", "f") {
+		t.Errorf("Got %v expected %v", actualValue.Values(), "[a b c d e f]")
+	}
+	if actualValue := set.Intersection(another); actualValue.Size() != 2 || !actualValue.Contains("c", "d") {
+		t.Errorf("Got %v expected %v", actualValue.Values(), "[c d]")
+	}
+	if actualValue := set.Difference(another); actualValue.Size() != 2 || !actualValue.Contains("a", "b") {
+		t.Errorf("Got %v expected %v", actualValue.Values(), "[a b]")
+	}
+	if actualValue := set.SymmetricDifference(another); actualValue.Size() != 4 || !actualValue.Contains("a", "b", "e", "f") {
+		t.Errorf("Got %v expected %v", actualValue.Values(), "[a b e f]")
+	}
+	if _, ok := set.Union(another).(*Set); !ok {
+		t.Errorf("Got %T expected %T", set.Union(another), set)
+	}
+	if actualValue := set.IsDisjoint(another); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	another = hashset.New("a", "b", "c", "d")
+	if actualValue := set.Equals(another) && set.IsSubsetOf(another) && set.IsSupersetOf(another); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+}
+
+func TestSetAlgebraOrderAndComparators(t *testing.T) {
+	set := NewWithIntComparator(5, 1, 3, 7)
+	another := NewWithIntComparator(2, 3, 6, 7, 8)
+
+	if actualValue, expectedValue := fmt.Sprintf("%v", set.Union(another).Values()), "[1 2 3 5 6 7 8]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", set.SymmetricDifference(another).Values()), "[1 2 5 6 8]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	// tree sets with different comparators fall back to element lookups
+	reversed := NewWith(func(a, b interface{}) int { return b.(int) - a.(int) }, 2, 3)
+	if actualValue, expectedValue := fmt.Sprintf("%v", NewWithIntComparator(1, 2).Union(reversed).Values()), "[1 2 3]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", reversed.Union(NewWithIntComparator(1, 2)).Values()), "[3 2 1]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", set.Intersection(reversed).Values()), "[3]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", set.Difference(reversed).Values()), "[1 5 7]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", set.SymmetricDifference(reversed).Values()), "[1 2 5 7]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue := set.IsDisjoint(reversed); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	if actualValue := NewWithIntComparator(3).IsSubsetOf(reversed) && reversed.IsSupersetOf(NewWithIntComparator(3)); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := reversed.Equals(NewWithIntComparator(3, 2)); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+
+	empty, different := NewWithIntComparator(), NewWithStringComparator()
+	if actualValue := empty.Equals(different) && empty.IsDisjoint(different) && different.IsSubsetOf(set); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+}
+
+func TestSetAlgebraComparatorsFromOneFactory(t *testing.T) {
+	// closures from one factory share their code pointer, but not their ordering
+	factory := func(descending bool) utils.Comparator {
+		return func(a, b interface{}) int {
+			if descending {
+				return utils.IntComparator(b, a)
+			}
+			return utils.IntComparator(a, b)
+		}
+	}
+	ascending := NewWith(factory(false), 1, 2, 3, 4)
+	descending := NewWith(factory(true), 1, 2, 3, 4)
+
+	if actualValue, expectedValue := fmt.Sprintf("%v", ascending.Intersection(descending).Values()), "[1 2 3 4]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", ascending.Difference(descending).Values()), "[]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", descending.Union(ascending).Values()), "[4 3 2 1]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue := ascending.Equals(descending) && ascending.IsSubsetOf(descending) && descending.IsSupersetOf(ascending); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := ascending.IsDisjoint(descending); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+}
+
 func benchmarkContains(b *testing.B, set *Set, size int) {
 	for i := 0; i < b.N; i++ {
 		for n := 0; n < size; n++ {
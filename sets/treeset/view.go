@@ -0,0 +1,123 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package treeset
+
+import (
+	"fmt"
+	"github.com/emirpasic/gods/containers"
+	rbt "github.com/emirpasic/gods/trees/redblacktree"
+	"strings"
+)
+
+// Assert Container implementation
+var _ containers.Container = (*View)(nil)
+
+// View is a live view of a range of elements of a tree set, optionally in descending order.
+// Nothing is copied, all operations are performed against the set's underlying red-black tree.
+type View struct {
+	set        *Set
+	bounds     rbt.Range
+	descending bool
+}
+
+// Contains checks weather items (one or more) are present in the view.
+// All items have to be present in the view for the method to return true.
+// Returns true if no arguments are passed at all, i.e. view is always superset of empty set.
+func (view *View) Contains(items ...interface{}) bool {
+	for _, item := range items {
+		if !view.bounds.Contains(item) || !view.set.Contains(item) {
+			return false
+		}
+	}
+	return true
+}
+
+// Remove removes the items (one or more) that are within the view's range from the underlying set.
+func (view *View) Remove(items ...interface{}) {
+	for _, item := range items {
+		if view.bounds.Contains(item) {
+			view.set.tree.Remove(item)
+		}
+	}
+}
+
+// Empty returns true if the view does not contain any elements.
+func (view *View) Empty() bool {
+	return view.first() == nil
+}
+
+// Size returns number of elements in the view.
+// Computed from the ranks of the view's bounds in the underlying tree, i.e. runs in O(log n) time.
+func (view *View) Size() int {
+	return view.bounds.Size()
+}
+
+// Values returns all elements of the view in the view's order.
+func (view *View) Values() []interface{} {
+	values := []interface{}{}
+	for it := view.Iterator(); it.Next(); {
+		values = append(values, it.Value())
+	}
+	return values
+}
+
+// Clear removes all elements of the view from the underlying set.
+func (view *View) Clear() {
+	for _, value := range view.Values() {
+		view.set.tree.Remove(value)
+	}
+}
+
+// First returns the first element in the view's order, i.e. the largest one if the view is descending.
+// Second return parameter is false if view is empty.
+func (view *View) First() (value interface{}, found bool) {
+	if node := view.first(); node != nil {
+		return node.Key, true
+	}
+	return nil, false
+}
+
+// Last returns the last element in the view's order, i.e. the smallest one if the view is descending.
+// Second return parameter is false if view is empty.
+func (view *View) Last() (value interface{}, found bool) {
+	if node := view.last(); node != nil {
+		return node.Key, true
+	}
+	return nil, false
+}
+
+// DescendingSet returns a view of the same range with its elements in reverse order.
+func (view *View) DescendingSet() *View {
+	descending := *view
+	descending.descending = !view.descending
+	return &descending
+}
+
+// String returns a string representation of container
+func (view *View) String() string {
+	str := "TreeSetView\n"
+	items := []string{}
+	for it := view.Iterator(); it.Next(); {
+		items = append(items, fmt.Sprintf("%v", it.Value()))
+	}
+	str += strings.Join(items, ", ")
+	return str
+}
+
+// first returns the first node in the view's order or nil if view is empty.
+func (view *View) first() *rbt.Node {
+	if view.descending {
+		return view.bounds.Highest()
+	}
+	return view.bounds.Lowest()
+}
+
+// last returns the last node in the view's order or nil if view is empty.
+func (view *View) last() *rbt.Node {
+	if view.descending {
+		return view.bounds.Lowest()
+	}
+	return view.bounds.Highest()
+}
@@ -7,6 +7,7 @@ package arraystack
 import (
 	"encoding/json"
 	"fmt"
+	"github.com/emirpasic/gods/containers"
 	"strings"
 	"testing"
 )
@@ -408,6 +409,61 @@ func benchmarkPop(b *testing.B, stack *Stack, size int) {
 	}
 }
 
+func TestStackEnumerable(t *testing.T) {
+	c := New()
+	c.Push(1)
+	c.Push(2)
+	c.Push(3)
+	var enumerable containers.EnumerableContainerWithIndex = c
+
+	visited := []interface{}{}
+	enumerable.Each(func(index int, value interface{}) {
+		if index != len(visited) {
+			t.Errorf("Got index %v expected %v", index, len(visited))
+		}
+		visited = append(visited, value)
+	})
+	if actualValue, expectedValue := fmt.Sprint(visited), "[3 2 1]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	mapped := enumerable.Map(func(index int, value interface{}) interface{} {
+		return value.(int) * 10
+	})
+	if _, ok := mapped.(*Stack); !ok {
+		t.Errorf("Got %T expected %T", mapped, c)
+	}
+	if actualValue, expectedValue := fmt.Sprint(mapped.Values()), "[30 20 10]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	selected := enumerable.Select(func(index int, value interface{}) bool {
+		return value.(int) > 1
+	})
+	if actualValue, expectedValue := fmt.Sprint(selected.Values()), "[3 2]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	if actualValue := enumerable.Any(func(index int, value interface{}) bool { return value.(int) == 2 }); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := enumerable.Any(func(index int, value interface{}) bool { return value.(int) == 4 }); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	if actualValue := enumerable.All(func(index int, value interface{}) bool { return value.(int) > 0 }); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := enumerable.All(func(index int, value interface{}) bool { return value.(int) > 1 }); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	if index, value := enumerable.Find(func(index int, value interface{}) bool { return value.(int) == 1 }); index != 2 || value != 1 {
+		t.Errorf("Got %v at %v expected %v at %v", value, index, 1, 2)
+	}
+	if index, value := enumerable.Find(func(index int, value interface{}) bool { return value.(int) == 4 }); index != -1 || value != nil {
+		t.Errorf("Got %v at %v expected %v at %v", value, index, nil, -1)
+	}
+}
+
 func BenchmarkArrayStackPop100(b *testing.B) {
 	b.StopTimer()
 	size := 100
@@ -0,0 +1,88 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package linkedliststack
+
+import "github.com/emirpasic/gods/containers"
+
+// Assert Enumerable implementation
+var _ containers.EnumerableWithIndex = (*Stack)(nil)
+
+// Each calls the given function once for each element, passing that element's index and value.
+func (stack *Stack) Each(f func(index int, value interface{})) {
+	iterator := stack.Iterator()
+	for iterator.Next() {
+		f(iterator.Index(), iterator.Value())
+	}
+}
+
+// Map invokes the given function once for each element and returns a
+// container containing the values returned by the given function.
+// Elements are visited in pop (LIFO) order and the returned stack pops the results in that same order.
+func (stack *Stack) Map(f func(index int, value interface{}) interface{}) containers.EnumerableContainerWithIndex {
+	values := make([]interface{}, 0, stack.Size())
+	iterator := stack.Iterator()
+	for iterator.Next() {
+		values = append(values, f(iterator.Index(), iterator.Value()))
+	}
+	return newFromPopOrder(values)
+}
+
+// Select returns a new container containing all elements for which the given function returns a true value.
+func (stack *Stack) Select(f func(index int, value interface{}) bool) containers.EnumerableContainerWithIndex {
+	values := make([]interface{}, 0)
+	iterator := stack.Iterator()
+	for iterator.Next() {
+		if f(iterator.Index(), iterator.Value()) {
+			values = append(values, iterator.Value())
+		}
+	}
+	return newFromPopOrder(values)
+}
+
+// Any passes each element of the container to the given function and
+// returns true if the function ever returns true for any element.
+func (stack *Stack) Any(f func(index int, value interface{}) bool) bool {
+	iterator := stack.Iterator()
+	for iterator.Next() {
+		if f(iterator.Index(), iterator.Value()) {
+			return true
+		}
+	}
+	return false
+}
+
+// All passes each element of the container to the given function and
+// returns true if the function returns true for all elements.
+func (stack *Stack) All(f func(index int, value interface{}) bool) bool {
+	iterator := stack.Iterator()
+	for iterator.Next() {
+		if !f(iterator.Index(), iterator.Value()) {
+			return false
+		}
+	}
+	return true
+}
+
+// Find passes each element of the container to the given function and returns
+// the first (index,value) for which the function is true or -1,nil otherwise
+// if no element matches the criteria.
+func (stack *Stack) Find(f func(index int, value interface{}) bool) (int, interface{}) {
+	iterator := stack.Iterator()
+	for iterator.Next() {
+		if f(iterator.Index(), iterator.Value()) {
+			return iterator.Index(), iterator.Value()
+		}
+	}
+	return -1, nil
+}
+
+// newFromPopOrder instantiates a new stack that pops the passed values in the given order.
+func newFromPopOrder(values []interface{}) *Stack {
+	stack := New()
+	for i := len(values) - 1; i >= 0; i-- {
+		stack.Push(values[i])
+	}
+	return stack
+}
@@ -0,0 +1,209 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package hashtable implements a table backed by hash tables.
+//
+// Every cell is indexed both by row and by column, so that rows and columns can be retrieved in time proportional to
+// their number of cells.
+//
+// Elements are unordered in the table.
+//
+// Structure is not thread safe.
+//
+// Reference: https://en.wikipedia.org/wiki/Sparse_matrix#Dictionary_of_keys_(DOK)
+package hashtable
+
+import (
+	"fmt"
+	"github.com/emirpasic/gods/maps"
+	"github.com/emirpasic/gods/maps/hashmap"
+	"github.com/emirpasic/gods/tables"
+	"strings"
+)
+
+// Assert Table implementation
+var _ tables.Table = (*Table)(nil)
+
+// Table holds the cells in go's native maps, once by row and once by column
+type Table struct {
+	rows    map[interface{}]map[interface{}]interface{}
+	columns map[interface{}]map[interface{}]interface{}
+	size    int
+}
+
+// New instantiates a hash table.
+func New() *Table {
+	return &Table{
+		rows:    make(map[interface{}]map[interface{}]interface{}),
+		columns: make(map[interface{}]map[interface{}]interface{}),
+	}
+}
+
+// Put inserts the value into the cell at the row and column, replacing the previous value if any.
+func (table *Table) Put(row interface{}, column interface{}, value interface{}) {
+	cells, found := table.rows[row]
+	if !found {
+		cells = make(map[interface{}]interface{})
+		table.rows[row] = cells
+	}
+	if _, found := cells[column]; !found {
+		table.size++
+	}
+	cells[column] = value
+	cells, found = table.columns[column]
+	if !found {
+		cells = make(map[interface{}]interface{})
+		table.columns[column] = cells
+	}
+	cells[row] = value
+}
+
+// Get searches the cell at the row and column and returns its value or nil if there is no such cell.
+// Second return parameter is true if the cell was found, otherwise false.
+func (table *Table) Get(row interface{}, column interface{}) (value interface{}, found bool) {
+	value, found = table.rows[row][column]
+	return
+}
+
+// Remove removes the cell at the row and column, if any.
+func (table *Table) Remove(row interface{}, column interface{}) {
+	if _, found := table.rows[row][column]; !found {
+		return
+	}
+	table.remove(table.rows, row, column)
+	table.remove(table.columns, column, row)
+	table.size--
+}
+
+// RemoveRow removes all cells of the row.
+func (table *Table) RemoveRow(row interface{}) {
+	for column := range table.rows[row] {
+		table.remove(table.columns, column, row)
+		table.size--
+	}
+	delete(table.rows, row)
+}
+
+// RemoveColumn removes all cells of the column.
+func (table *Table) RemoveColumn(column interface{}) {
+	for row := range table.columns[column] {
+		table.remove(table.rows, row, column)
+		table.size--
+	}
+	delete(table.columns, column)
+}
+
+// Contains returns true if there is a cell at the row and column.
+func (table *Table) Contains(row interface{}, column interface{}) bool {
+	_, found := table.rows[row][column]
+	return found
+}
+
+// Row returns a map from the column keys to the values of all cells of the row, which is empty if there are none.
+// The map is a copy, so modifying it does not modify the table.
+func (table *Table) Row(row interface{}) maps.Map {
+	return copyMap(table.rows[row])
+}
+
+// Column returns a map from the row keys to the values of all cells of the column, which is empty if there are none.
+// The map is a copy, so modifying it does not modify the table.
+func (table *Table) Column(column interface{}) maps.Map {
+	return copyMap(table.columns[column])
+}
+
+// RowKeys returns the keys of all rows that have at least one cell (random order).
+func (table *Table) RowKeys() []interface{} {
+	return keys(table.rows)
+}
+
+// ColumnKeys returns the keys of all columns that have at least one cell (random order).
+func (table *Table) ColumnKeys() []interface{} {
+	return keys(table.columns)
+}
+
+// Cells returns all cells of the table (random order).
+func (table *Table) Cells() []tables.Cell {
+	cells := make([]tables.Cell, 0, table.size)
+	for row, columns := range table.rows {
+		for column, value := range columns {
+			cells = append(cells, tables.Cell{Row: row, Column: column, Value: value})
+		}
+	}
+	return cells
+}
+
+// Transpose returns a new table in which the rows of this table are the columns and vice versa.
+func (table *Table) Transpose() tables.Table {
+	transposed := New()
+	for row, columns := range table.rows {
+		for column, value := range columns {
+			transposed.Put(column, row, value)
+		}
+	}
+	return transposed
+}
+
+// Empty returns true if table does not contain any cells
+func (table *Table) Empty() bool {
+	return table.size == 0
+}
+
+// Size returns number of cells in the table.
+func (table *Table) Size() int {
+	return table.size
+}
+
+// Clear removes all cells from the table.
+func (table *Table) Clear() {
+	table.rows = make(map[interface{}]map[interface{}]interface{})
+	table.columns = make(map[interface{}]map[interface{}]interface{})
+	table.size = 0
+}
+
+// Values returns the values of all cells (random order).
+func (table *Table) Values() []interface{} {
+	values := make([]interface{}, 0, table.size)
+	for _, columns := range table.rows {
+		for _, value := range columns {
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
+// String returns a string representation of container
+func (table *Table) String() string {
+	str := "HashTable\n"
+	items := []string{}
+	for row, columns := range table.rows {
+		items = append(items, fmt.Sprintf("%v:%v", row, columns))
+	}
+	str += strings.Join(items, ", ")
+	return str
+}
+
+// remove removes the cell at the outer and inner key from one of the indexes, and the outer key once it has no cells
+func (table *Table) remove(index map[interface{}]map[interface{}]interface{}, outer interface{}, inner interface{}) {
+	cells := index[outer]
+	delete(cells, inner)
+	if len(cells) == 0 {
+		delete(index, outer)
+	}
+}
+
+func copyMap(cells map[interface{}]interface{}) *hashmap.Map {
+	m := hashmap.New()
+	for key, value := range cells {
+		m.Put(key, value)
+	}
+	return m
+}
+
+func keys(index map[interface{}]map[interface{}]interface{}) []interface{} {
+	keys := make([]interface{}, 0, len(index))
+	for key := range index {
+		keys = append(keys, key)
+	}
+	return keys
+}
@@ -0,0 +1,226 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package hashtable
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"testing"
+)
+
+func TestTablePut(t *testing.T) {
+	table := New()
+	table.Put(1, "a", 1.5)
+	table.Put(2, "a", 2.5)
+	table.Put(1, "b", 1.0)
+
+	if actualValue, expectedValue := table.Size(), 3; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := sortedStrings(table.Row(1).Values()), "[1 1.5]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := sortedStrings(table.Column("a").Values()), "[1.5 2.5]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	// overwriting keeps both indexes in sync
+	table.Put(1, "a", 0.5)
+	if actualValue, _ := table.Column("a").Get(1); actualValue != 0.5 {
+		t.Errorf("Got %v expected %v", actualValue, 0.5)
+	}
+	if actualValue, expectedValue := table.Size(), 3; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestTableIterator(t *testing.T) {
+	table := New()
+	it := table.Iterator()
+	for it.Next() {
+		t.Errorf("Shouldn't iterate on empty table")
+	}
+
+	table.Put("r1", "c1", 11)
+	table.Put("r1", "c2", 12)
+	table.Put("r2", "c1", 21)
+
+	it = table.Iterator()
+	cells := []interface{}{}
+	for it.Next() {
+		cells = append(cells, fmt.Sprintf("%v:%v:%v", it.Row(), it.Column(), it.Value()))
+	}
+	if actualValue, expectedValue := sortedStrings(cells), "[r1:c1:11 r1:c2:12 r2:c1:21]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	if actualValue, expectedValue := it.First(), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	it.Begin()
+	if actualValue, expectedValue := it.NextTo(func(row interface{}, column interface{}, value interface{}) bool {
+		return value == 21
+	}), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v:%v", it.Row(), it.Column()), "r2:c1"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestTableSerialization(t *testing.T) {
+	table := New()
+	table.Put("r1", "c1", "a")
+	table.Put("r2", "c2", "b")
+
+	_, err := json.Marshal([]interface{}{"a", "b", "c", table})
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+
+	err = json.Unmarshal([]byte(`{"x":{"y":1,"z":2}}`), &table)
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := sortedStrings(table.ColumnKeys()), "[y z]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestTableString(t *testing.T) {
+	table := New()
+	table.Put("r1", "c1", 11)
+	if !strings.HasPrefix(table.String(), "HashTable") {
+		t.Errorf("String should start with container name")
+	}
+	if actualValue, expectedValue := table.String(), "HashTable\nr1:map[c1:11]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func sortedStrings(values []interface{}) string {
+	strings := make([]string, len(values))
+	for i, value := range values {
+		strings[i] = fmt.Sprintf("%v", value)
+	}
+	sort.Strings(strings)
+	return fmt.Sprintf("%v", strings)
+}
+
+func benchmarkGet(b *testing.B, table *Table, size int) {
+	for i := 0; i < b.N; i++ {
+		for n := 0; n < size; n++ {
+			table.Get(n%100, n/100)
+		}
+	}
+}
+
+func benchmarkPut(b *testing.B, table *Table, size int) {
+	for i := 0; i < b.N; i++ {
+		for n := 0; n < size; n++ {
+			table.Put(n%100, n/100, struct{}{})
+		}
+	}
+}
+
+func benchmarkColumn(b *testing.B, table *Table, size int) {
+	for i := 0; i < b.N; i++ {
+		for n := 0; n < size/100+1; n++ {
+			table.Column(n)
+		}
+	}
+}
+
+func benchmarkRemove(b *testing.B, table *Table, size int) {
+	for i := 0; i < b.N; i++ {
+		for n := 0; n < size; n++ {
+			table.Remove(n%100, n/100)
+		}
+	}
+}
+
+func BenchmarkHashTableGet100(b *testing.B) {
+	b.StopTimer()
+	size := 100
+	table := New()
+	for n := 0; n < size; n++ {
+		table.Put(n%100, n/100, struct{}{})
+	}
+	b.StartTimer()
+	benchmarkGet(b, table, size)
+}
+
+func BenchmarkHashTableGet10000(b *testing.B) {
+	b.StopTimer()
+	size := 10000
+	table := New()
+	for n := 0; n < size; n++ {
+		table.Put(n%100, n/100, struct{}{})
+	}
+	b.StartTimer()
+	benchmarkGet(b, table, size)
+}
+
+func BenchmarkHashTablePut100(b *testing.B) {
+	b.StopTimer()
+	size := 100
+	table := New()
+	b.StartTimer()
+	benchmarkPut(b, table, size)
+}
+
+func BenchmarkHashTablePut10000(b *testing.B) {
+	b.StopTimer()
+	size := 10000
+	table := New()
+	b.StartTimer()
+	benchmarkPut(b, table, size)
+}
+
+func BenchmarkHashTableColumn100(b *testing.B) {
+	b.StopTimer()
+	size := 100
+	table := New()
+	for n := 0; n < size; n++ {
+		table.Put(n%100, n/100, struct{}{})
+	}
+	b.StartTimer()
+	benchmarkColumn(b, table, size)
+}
+
+func BenchmarkHashTableColumn10000(b *testing.B) {
+	b.StopTimer()
+	size := 10000
+	table := New()
+	for n := 0; n < size; n++ {
+		table.Put(n%100, n/100, struct{}{})
+	}
+	b.StartTimer()
+	benchmarkColumn(b, table, size)
+}
+
+func BenchmarkHashTableRemove100(b *testing.B) {
+	b.StopTimer()
+	size := 100
+	table := New()
+	for n := 0; n < size; n++ {
+		table.Put(n%100, n/100, struct{}{})
+	}
+	b.StartTimer()
+	benchmarkRemove(b, table, size)
+}
+
+func BenchmarkHashTableRemove10000(b *testing.B) {
+	b.StopTimer()
+	size := 10000
+	table := New()
+	for n := 0; n < size; n++ {
+		table.Put(n%100, n/100, struct{}{})
+	}
+	b.StartTimer()
+	benchmarkRemove(b, table, size)
+}
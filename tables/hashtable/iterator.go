@@ -0,0 +1,75 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package hashtable
+
+import "github.com/emirpasic/gods/tables"
+
+// Iterator holding the iterator's state
+type Iterator struct {
+	cells []tables.Cell
+	index int
+}
+
+// Iterator returns a stateful iterator over the cells of the table (random order).
+// The cells are taken when the iterator is created, so modifying the table does not affect the iterator.
+func (table *Table) Iterator() Iterator {
+	return Iterator{cells: table.Cells(), index: -1}
+}
+
+// Next moves the iterator to the next cell and returns true if there was a next cell in the table.
+// If Next() returns true, then next cell's row, column and value can be retrieved by Row(), Column() and Value().
+// If Next() was called for the first time, then it will point the iterator to the first cell if it exists.
+// Modifies the state of the iterator.
+func (iterator *Iterator) Next() bool {
+	if iterator.index < len(iterator.cells) {
+		iterator.index++
+	}
+	return iterator.index < len(iterator.cells)
+}
+
+// Value returns the current cell's value.
+// Does not modify the state of the iterator.
+func (iterator *Iterator) Value() interface{} {
+	return iterator.cells[iterator.index].Value
+}
+
+// Row returns the current cell's row key.
+// Does not modify the state of the iterator.
+func (iterator *Iterator) Row() interface{} {
+	return iterator.cells[iterator.index].Row
+}
+
+// Column returns the current cell's column key.
+// Does not modify the state of the iterator.
+func (iterator *Iterator) Column() interface{} {
+	return iterator.cells[iterator.index].Column
+}
+
+// Begin resets the iterator to its initial state (one-before-first)
+// Call Next() to fetch the first cell if any.
+func (iterator *Iterator) Begin() {
+	iterator.index = -1
+}
+
+// First moves the iterator to the first cell and returns true if there was a first cell in the table.
+// If First() returns true, then first cell's row, column and value can be retrieved by Row(), Column() and Value().
+// Modifies the state of the iterator
+func (iterator *Iterator) First() bool {
+	iterator.Begin()
+	return iterator.Next()
+}
+
+// NextTo moves the iterator to the next cell from current position that satisfies the condition given by the
+// passed function, and returns true if there was a next cell in the table.
+// If NextTo() returns true, then next cell's row, column and value can be retrieved by Row(), Column() and Value().
+// Modifies the state of the iterator.
+func (iterator *Iterator) NextTo(f func(row interface{}, column interface{}, value interface{}) bool) bool {
+	for iterator.Next() {
+		if f(iterator.Row(), iterator.Column(), iterator.Value()) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,54 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package hashtable
+
+import (
+	"encoding/json"
+	"github.com/emirpasic/gods/containers"
+	"github.com/emirpasic/gods/utils"
+)
+
+// Assert Serialization implementation
+var _ containers.JSONSerializer = (*Table)(nil)
+var _ containers.JSONDeserializer = (*Table)(nil)
+
+// ToJSON outputs the JSON representation of the table, i.e. an object mapping every row key to an object that maps
+// the column keys of the row to the values of its cells.
+func (table *Table) ToJSON() ([]byte, error) {
+	elements := make(map[string]map[string]interface{})
+	for row, columns := range table.rows {
+		cells := make(map[string]interface{})
+		for column, value := range columns {
+			cells[utils.ToString(column)] = value
+		}
+		elements[utils.ToString(row)] = cells
+	}
+	return json.Marshal(&elements)
+}
+
+// FromJSON populates the table from the input JSON representation.
+func (table *Table) FromJSON(data []byte) error {
+	elements := make(map[string]map[string]interface{})
+	err := json.Unmarshal(data, &elements)
+	if err == nil {
+		table.Clear()
+		for row, columns := range elements {
+			for column, value := range columns {
+				table.Put(row, column, value)
+			}
+		}
+	}
+	return err
+}
+
+// UnmarshalJSON @implements json.Unmarshaler
+func (table *Table) UnmarshalJSON(bytes []byte) error {
+	return table.FromJSON(bytes)
+}
+
+// MarshalJSON @implements json.Marshaler
+func (table *Table) MarshalJSON() ([]byte, error) {
+	return table.ToJSON()
+}
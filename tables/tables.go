@@ -0,0 +1,50 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package tables provides an abstract Table interface.
+//
+// A table is a two-dimensional map that associates a value with a pair of keys, a row key and a column key. Every
+// such association is a cell of the table. Tables are indexed in both dimensions, so that all cells of a row as well
+// as all cells of a column can be retrieved without scanning the whole table, which makes them suitable for sparse
+// matrices and pivot tables.
+//
+// Size returns the number of cells and Values returns the values of all cells.
+//
+// Reference: https://en.wikipedia.org/wiki/Sparse_matrix#Dictionary_of_keys_(DOK)
+package tables
+
+import (
+	"github.com/emirpasic/gods/containers"
+	"github.com/emirpasic/gods/maps"
+)
+
+// Table interface that all tables implement
+type Table interface {
+	Put(row interface{}, column interface{}, value interface{})
+	Get(row interface{}, column interface{}) (value interface{}, found bool)
+	Remove(row interface{}, column interface{})
+	RemoveRow(row interface{})
+	RemoveColumn(column interface{})
+	Contains(row interface{}, column interface{}) bool
+	Row(row interface{}) maps.Map
+	Column(column interface{}) maps.Map
+	RowKeys() []interface{}
+	ColumnKeys() []interface{}
+	Cells() []Cell
+	Transpose() Table
+
+	containers.Container
+	// Empty() bool
+	// Size() int
+	// Clear()
+	// Values() []interface{}
+	// String() string
+}
+
+// Cell is a value of a table together with its row and column keys.
+type Cell struct {
+	Row    interface{}
+	Column interface{}
+	Value  interface{}
+}
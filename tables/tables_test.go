@@ -0,0 +1,309 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tables_test
+
+import (
+	"fmt"
+	"github.com/emirpasic/gods/containers"
+	"github.com/emirpasic/gods/tables"
+	"github.com/emirpasic/gods/tables/hashtable"
+	"github.com/emirpasic/gods/tables/treetable"
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+// implementations returns a fresh empty instance of every table, all of them with string keys
+func implementations() map[string]func() tables.Table {
+	return map[string]func() tables.Table{
+		"HashTable": func() tables.Table { return hashtable.New() },
+		"TreeTable": func() tables.Table { return treetable.NewWithStringComparators() },
+	}
+}
+
+func TestTablePutAndGet(t *testing.T) {
+	for name, newTable := range implementations() {
+		table := newTable()
+		table.Put("r1", "c1", 11)
+		table.Put("r1", "c2", 12)
+		table.Put("r2", "c1", 21)
+		table.Put("r1", "c1", 110) // overwrite
+		table.Put("r3", "c3", nil)
+
+		tests := [][]interface{}{
+			{"r1", "c1", 110, true},
+			{"r1", "c2", 12, true},
+			{"r2", "c1", 21, true},
+			{"r2", "c2", nil, false},
+			{"r3", "c3", nil, true},
+			{"r4", "c1", nil, false},
+		}
+		for _, test := range tests {
+			actualValue, actualFound := table.Get(test[0], test[1])
+			if actualValue != test[2] || actualFound != test[3] {
+				t.Errorf("[%s] Got %v,%v expected %v,%v", name, actualValue, actualFound, test[2], test[3])
+			}
+			if actualValue := table.Contains(test[0], test[1]); actualValue != test[3] {
+				t.Errorf("[%s] Got %v expected %v", name, actualValue, test[3])
+			}
+		}
+		if actualValue, expectedValue := table.Size(), 4; actualValue != expectedValue {
+			t.Errorf("[%s] Got %v expected %v", name, actualValue, expectedValue)
+		}
+		if actualValue, expectedValue := sortedStrings(table.RowKeys()), "[r1 r2 r3]"; actualValue != expectedValue {
+			t.Errorf("[%s] Got %v expected %v", name, actualValue, expectedValue)
+		}
+		if actualValue, expectedValue := sortedStrings(table.ColumnKeys()), "[c1 c2 c3]"; actualValue != expectedValue {
+			t.Errorf("[%s] Got %v expected %v", name, actualValue, expectedValue)
+		}
+		if actualValue, expectedValue := sortedStrings(table.Values()), "[110 12 21 <nil>]"; actualValue != expectedValue {
+			t.Errorf("[%s] Got %v expected %v", name, actualValue, expectedValue)
+		}
+		if actualValue, expectedValue := len(table.Cells()), 4; actualValue != expectedValue {
+			t.Errorf("[%s] Got %v expected %v", name, actualValue, expectedValue)
+		}
+	}
+}
+
+func TestTableRowAndColumn(t *testing.T) {
+	for name, newTable := range implementations() {
+		table := newTable()
+		table.Put("r1", "c1", 11)
+		table.Put("r1", "c2", 12)
+		table.Put("r2", "c1", 21)
+
+		row := table.Row("r1")
+		if actualValue, expectedValue := sortedStrings(row.Keys()), "[c1 c2]"; actualValue != expectedValue {
+			t.Errorf("[%s] Got %v expected %v", name, actualValue, expectedValue)
+		}
+		if actualValue, _ := row.Get("c2"); actualValue != 12 {
+			t.Errorf("[%s] Got %v expected %v", name, actualValue, 12)
+		}
+		column := table.Column("c1")
+		if actualValue, expectedValue := sortedStrings(column.Keys()), "[r1 r2]"; actualValue != expectedValue {
+			t.Errorf("[%s] Got %v expected %v", name, actualValue, expectedValue)
+		}
+		if actualValue, _ := column.Get("r2"); actualValue != 21 {
+			t.Errorf("[%s] Got %v expected %v", name, actualValue, 21)
+		}
+		if actualValue, expectedValue := table.Row("r9").Empty(), true; actualValue != expectedValue {
+			t.Errorf("[%s] Got %v expected %v", name, actualValue, expectedValue)
+		}
+		if actualValue, expectedValue := table.Column("c9").Empty(), true; actualValue != expectedValue {
+			t.Errorf("[%s] Got %v expected %v", name, actualValue, expectedValue)
+		}
+
+		// returned maps are a copy
+		row.Put("c3", 13)
+		column.Remove("r1")
+		if actualValue, expectedValue := table.Size(), 3; actualValue != expectedValue {
+			t.Errorf("[%s] Got %v expected %v", name, actualValue, expectedValue)
+		}
+		if actualValue, expectedValue := table.Contains("r1", "c1"), true; actualValue != expectedValue {
+			t.Errorf("[%s] Got %v expected %v", name, actualValue, expectedValue)
+		}
+	}
+}
+
+func TestTableRemove(t *testing.T) {
+	for name, newTable := range implementations() {
+		table := newTable()
+		table.Put("r1", "c1", 11)
+		table.Put("r1", "c2", 12)
+		table.Put("r2", "c1", 21)
+		table.Put("r2", "c2", 22)
+		table.Put("r3", "c3", 33)
+
+		table.Remove("r1", "c1")
+		table.Remove("r1", "c1")
+		table.Remove("r9", "c1")
+		if actualValue, expectedValue := table.Size(), 4; actualValue != expectedValue {
+			t.Errorf("[%s] Got %v expected %v", name, actualValue, expectedValue)
+		}
+		if actualValue, expectedValue := sortedStrings(table.Column("c1").Keys()), "[r2]"; actualValue != expectedValue {
+			t.Errorf("[%s] Got %v expected %v", name, actualValue, expectedValue)
+		}
+
+		// rows and columns without cells are removed as well
+		table.Remove("r3", "c3")
+		if actualValue, expectedValue := sortedStrings(table.RowKeys()), "[r1 r2]"; actualValue != expectedValue {
+			t.Errorf("[%s] Got %v expected %v", name, actualValue, expectedValue)
+		}
+		if actualValue, expectedValue := sortedStrings(table.ColumnKeys()), "[c1 c2]"; actualValue != expectedValue {
+			t.Errorf("[%s] Got %v expected %v", name, actualValue, expectedValue)
+		}
+
+		table.RemoveColumn("c2")
+		if actualValue, expectedValue := sortedStrings(table.RowKeys()), "[r2]"; actualValue != expectedValue {
+			t.Errorf("[%s] Got %v expected %v", name, actualValue, expectedValue)
+		}
+		if actualValue, expectedValue := table.Size(), 1; actualValue != expectedValue {
+			t.Errorf("[%s] Got %v expected %v", name, actualValue, expectedValue)
+		}
+		table.RemoveColumn("c9")
+		table.RemoveRow("r9")
+		table.RemoveRow("r2")
+		if actualValue, expectedValue := table.Empty(), true; actualValue != expectedValue {
+			t.Errorf("[%s] Got %v expected %v", name, actualValue, expectedValue)
+		}
+		if actualValue, expectedValue := len(table.RowKeys())+len(table.ColumnKeys()), 0; actualValue != expectedValue {
+			t.Errorf("[%s] Got %v expected %v", name, actualValue, expectedValue)
+		}
+
+		table.Put("r1", "c1", 11)
+		table.Clear()
+		if actualValue, expectedValue := table.Size()+len(table.ColumnKeys()), 0; actualValue != expectedValue {
+			t.Errorf("[%s] Got %v expected %v", name, actualValue, expectedValue)
+		}
+	}
+}
+
+func TestTableTranspose(t *testing.T) {
+	for name, newTable := range implementations() {
+		table := newTable()
+		table.Put("r1", "c1", 11)
+		table.Put("r1", "c2", 12)
+		table.Put("r2", "c1", 21)
+
+		transposed := table.Transpose()
+		if actualValue, expectedValue := transposed.Size(), 3; actualValue != expectedValue {
+			t.Errorf("[%s] Got %v expected %v", name, actualValue, expectedValue)
+		}
+		if actualValue, expectedValue := sortedStrings(transposed.RowKeys()), "[c1 c2]"; actualValue != expectedValue {
+			t.Errorf("[%s] Got %v expected %v", name, actualValue, expectedValue)
+		}
+		if actualValue, _ := transposed.Get("c2", "r1"); actualValue != 12 {
+			t.Errorf("[%s] Got %v expected %v", name, actualValue, 12)
+		}
+
+		// the original is not modified
+		transposed.Clear()
+		if actualValue, expectedValue := table.Size(), 3; actualValue != expectedValue {
+			t.Errorf("[%s] Got %v expected %v", name, actualValue, expectedValue)
+		}
+	}
+}
+
+func TestTableRandomOperations(t *testing.T) {
+	for name, newTable := range implementations() {
+		table := newTable()
+		expected := make(map[[2]string]int)
+		random := rand.New(rand.NewSource(1))
+		for i := 0; i < 5000; i++ {
+			row, column := fmt.Sprintf("r%d", random.Intn(10)), fmt.Sprintf("c%d", random.Intn(10))
+			switch random.Intn(10) {
+			case 0:
+				table.RemoveRow(row)
+				for key := range expected {
+					if key[0] == row {
+						delete(expected, key)
+					}
+				}
+			case 1:
+				table.RemoveColumn(column)
+				for key := range expected {
+					if key[1] == column {
+						delete(expected, key)
+					}
+				}
+			case 2, 3, 4:
+				table.Remove(row, column)
+				delete(expected, [2]string{row, column})
+			default:
+				table.Put(row, column, i)
+				expected[[2]string{row, column}] = i
+			}
+		}
+
+		if actualValue, expectedValue := table.Size(), len(expected); actualValue != expectedValue {
+			t.Errorf("[%s] Got %v expected %v", name, actualValue, expectedValue)
+		}
+		for key, value := range expected {
+			if actualValue, _ := table.Get(key[0], key[1]); actualValue != value {
+				t.Errorf("[%s] Got %v expected %v", name, actualValue, value)
+			}
+		}
+		size := 0
+		for _, column := range table.ColumnKeys() {
+			cells := table.Column(column)
+			if cells.Empty() {
+				t.Errorf("[%s] Column %v should have cells", name, column)
+			}
+			for _, row := range cells.Keys() {
+				if value, _ := cells.Get(row); value != expected[[2]string{row.(string), column.(string)}] {
+					t.Errorf("[%s] Got %v expected %v", name, value, expected[[2]string{row.(string), column.(string)}])
+				}
+			}
+			size += cells.Size()
+		}
+		if actualValue, expectedValue := size, len(expected); actualValue != expectedValue {
+			t.Errorf("[%s] Got %v expected %v", name, actualValue, expectedValue)
+		}
+	}
+}
+
+func TestTableSerialization(t *testing.T) {
+	for name, newTable := range implementations() {
+		original := newTable()
+		original.Put("r1", "c1", "a")
+		original.Put("r1", "c2", 1.0)
+		original.Put("r2", "c1", nil)
+
+		serialized, err := original.(containers.JSONSerializer).ToJSON()
+		if err != nil {
+			t.Errorf("[%s] Got error %v", name, err)
+		}
+		if actualValue, expectedValue := string(serialized), `{"r1":{"c1":"a","c2":1},"r2":{"c1":null}}`; actualValue != expectedValue {
+			t.Errorf("[%s] Got %v expected %v", name, actualValue, expectedValue)
+		}
+
+		deserialized := newTable()
+		err = deserialized.(containers.JSONDeserializer).FromJSON(serialized)
+		if err != nil {
+			t.Errorf("[%s] Got error %v", name, err)
+		}
+		if actualValue, expectedValue := deserialized.Size(), 3; actualValue != expectedValue {
+			t.Errorf("[%s] Got %v expected %v", name, actualValue, expectedValue)
+		}
+		if actualValue, actualFound := deserialized.Get("r2", "c1"); actualValue != nil || !actualFound {
+			t.Errorf("[%s] Got %v,%v expected %v,%v", name, actualValue, actualFound, nil, true)
+		}
+
+		for _, invalid := range []string{`[1,2]`, `{"r1":1}`} {
+			err = deserialized.(containers.JSONDeserializer).FromJSON([]byte(invalid))
+			if err == nil {
+				t.Errorf("[%s] Expected an error", name)
+			}
+		}
+	}
+}
+
+func TestTableSerializationNonStringKeys(t *testing.T) {
+	for name, table := range map[string]tables.Table{
+		"HashTable": hashtable.New(),
+		"TreeTable": treetable.NewWithIntComparators(),
+	} {
+		table.Put(1, 2, "a")
+		table.Put(3, 4, "b")
+
+		serialized, err := table.(containers.JSONSerializer).ToJSON()
+		if err != nil {
+			t.Errorf("[%s] Got error %v", name, err)
+		}
+		if actualValue, expectedValue := string(serialized), `{"1":{"2":"a"},"3":{"4":"b"}}`; actualValue != expectedValue {
+			t.Errorf("[%s] Got %v expected %v", name, actualValue, expectedValue)
+		}
+	}
+}
+
+// sortedStrings formats the elements in sorted order, so that tables with different orderings can be compared
+func sortedStrings(values []interface{}) string {
+	strings := make([]string, len(values))
+	for i, value := range values {
+		strings[i] = fmt.Sprintf("%v", value)
+	}
+	sort.Strings(strings)
+	return fmt.Sprintf("%v", strings)
+}
@@ -0,0 +1,126 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package treetable
+
+import "github.com/emirpasic/gods/maps/treemap"
+
+// Iterator holding the iterator's state
+type Iterator struct {
+	rows    treemap.Iterator
+	columns treemap.Iterator
+	valid   bool // columns iterates over the cells of the current row
+}
+
+// Iterator returns a stateful iterator over the cells of the table, ordered by row and then by column.
+func (table *Table) Iterator() Iterator {
+	return Iterator{rows: table.rows.Iterator()}
+}
+
+// Next moves the iterator to the next cell and returns true if there was a next cell in the table.
+// If Next() returns true, then next cell's row, column and value can be retrieved by Row(), Column() and Value().
+// If Next() was called for the first time, then it will point the iterator to the first cell if it exists.
+// Modifies the state of the iterator.
+func (iterator *Iterator) Next() bool {
+	if iterator.valid && iterator.columns.Next() {
+		return true
+	}
+	if iterator.rows.Next() {
+		iterator.columns = iterator.rows.Value().(*treemap.Map).Iterator()
+		iterator.valid = true
+		return iterator.columns.Next()
+	}
+	iterator.valid = false
+	return false
+}
+
+// Prev moves the iterator to the previous cell and returns true if there was a previous cell in the table.
+// If Prev() returns true, then previous cell's row, column and value can be retrieved by Row(), Column() and Value().
+// Modifies the state of the iterator.
+func (iterator *Iterator) Prev() bool {
+	if iterator.valid && iterator.columns.Prev() {
+		return true
+	}
+	if iterator.rows.Prev() {
+		iterator.columns = iterator.rows.Value().(*treemap.Map).Iterator()
+		iterator.valid = true
+		return iterator.columns.Last()
+	}
+	iterator.valid = false
+	return false
+}
+
+// Value returns the current cell's value.
+// Does not modify the state of the iterator.
+func (iterator *Iterator) Value() interface{} {
+	return iterator.columns.Value()
+}
+
+// Row returns the current cell's row key.
+// Does not modify the state of the iterator.
+func (iterator *Iterator) Row() interface{} {
+	return iterator.rows.Key()
+}
+
+// Column returns the current cell's column key.
+// Does not modify the state of the iterator.
+func (iterator *Iterator) Column() interface{} {
+	return iterator.columns.Key()
+}
+
+// Begin resets the iterator to its initial state (one-before-first)
+// Call Next() to fetch the first cell if any.
+func (iterator *Iterator) Begin() {
+	iterator.rows.Begin()
+	iterator.valid = false
+}
+
+// End moves the iterator past the last cell (one-past-the-end).
+// Call Prev() to fetch the last cell if any.
+func (iterator *Iterator) End() {
+	iterator.rows.End()
+	iterator.valid = false
+}
+
+// First moves the iterator to the first cell and returns true if there was a first cell in the table.
+// If First() returns true, then first cell's row, column and value can be retrieved by Row(), Column() and Value().
+// Modifies the state of the iterator
+func (iterator *Iterator) First() bool {
+	iterator.Begin()
+	return iterator.Next()
+}
+
+// Last moves the iterator to the last cell and returns true if there was a last cell in the table.
+// If Last() returns true, then last cell's row, column and value can be retrieved by Row(), Column() and Value().
+// Modifies the state of the iterator.
+func (iterator *Iterator) Last() bool {
+	iterator.End()
+	return iterator.Prev()
+}
+
+// NextTo moves the iterator to the next cell from current position that satisfies the condition given by the
+// passed function, and returns true if there was a next cell in the table.
+// If NextTo() returns true, then next cell's row, column and value can be retrieved by Row(), Column() and Value().
+// Modifies the state of the iterator.
+func (iterator *Iterator) NextTo(f func(row interface{}, column interface{}, value interface{}) bool) bool {
+	for iterator.Next() {
+		if f(iterator.Row(), iterator.Column(), iterator.Value()) {
+			return true
+		}
+	}
+	return false
+}
+
+// PrevTo moves the iterator to the previous cell from current position that satisfies the condition given by the
+// passed function, and returns true if there was a previous cell in the table.
+// If PrevTo() returns true, then previous cell's row, column and value can be retrieved by Row(), Column() and Value().
+// Modifies the state of the iterator.
+func (iterator *Iterator) PrevTo(f func(row interface{}, column interface{}, value interface{}) bool) bool {
+	for iterator.Prev() {
+		if f(iterator.Row(), iterator.Column(), iterator.Value()) {
+			return true
+		}
+	}
+	return false
+}
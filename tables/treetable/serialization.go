@@ -0,0 +1,59 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package treetable
+
+import (
+	"encoding/json"
+	"github.com/emirpasic/gods/containers"
+	"github.com/emirpasic/gods/maps/linkedhashmap"
+	"github.com/emirpasic/gods/maps/treemap"
+	"github.com/emirpasic/gods/utils"
+)
+
+// Assert Serialization implementation
+var _ containers.JSONSerializer = (*Table)(nil)
+var _ containers.JSONDeserializer = (*Table)(nil)
+
+// ToJSON outputs the JSON representation of the table, i.e. an object mapping every row key to an object that maps
+// the column keys of the row to the values of its cells.
+func (table *Table) ToJSON() ([]byte, error) {
+	elements := linkedhashmap.New()
+	rows := table.rows.Iterator()
+	for rows.Next() {
+		cells := linkedhashmap.New()
+		columns := rows.Value().(*treemap.Map).Iterator()
+		for columns.Next() {
+			cells.Put(utils.ToString(columns.Key()), columns.Value())
+		}
+		elements.Put(utils.ToString(rows.Key()), cells)
+	}
+	return elements.ToJSON()
+}
+
+// FromJSON populates the table from the input JSON representation.
+// Keys are strings, so the comparators of the table should be able to compare strings.
+func (table *Table) FromJSON(data []byte) error {
+	elements := make(map[string]map[string]interface{})
+	err := json.Unmarshal(data, &elements)
+	if err == nil {
+		table.Clear()
+		for row, columns := range elements {
+			for column, value := range columns {
+				table.Put(row, column, value)
+			}
+		}
+	}
+	return err
+}
+
+// UnmarshalJSON @implements json.Unmarshaler
+func (table *Table) UnmarshalJSON(bytes []byte) error {
+	return table.FromJSON(bytes)
+}
+
+// MarshalJSON @implements json.Marshaler
+func (table *Table) MarshalJSON() ([]byte, error) {
+	return table.ToJSON()
+}
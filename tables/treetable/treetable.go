@@ -0,0 +1,246 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package treetable implements a table backed by red-black trees.
+//
+// Every cell is indexed both by row and by column, so that rows and columns can be retrieved in time proportional to
+// their number of cells.
+//
+// Rows are ordered by the row comparator and columns by the column comparator. Cells are ordered by row first and
+// then by column.
+//
+// Structure is not thread safe.
+//
+// Reference: https://en.wikipedia.org/wiki/Sparse_matrix#Dictionary_of_keys_(DOK)
+package treetable
+
+import (
+	"fmt"
+	"github.com/emirpasic/gods/maps"
+	"github.com/emirpasic/gods/maps/treemap"
+	"github.com/emirpasic/gods/tables"
+	"github.com/emirpasic/gods/utils"
+	"strings"
+)
+
+// Assert Table implementation
+var _ tables.Table = (*Table)(nil)
+
+// Table holds the cells in tree maps of tree maps, once by row and once by column
+type Table struct {
+	rows             *treemap.Map // row -> column -> value
+	columns          *treemap.Map // column -> row -> value
+	rowComparator    utils.Comparator
+	columnComparator utils.Comparator
+	size             int
+}
+
+// NewWith instantiates a tree table with the custom comparators of the row and column keys.
+func NewWith(rowComparator utils.Comparator, columnComparator utils.Comparator) *Table {
+	return &Table{
+		rows:             treemap.NewWith(rowComparator),
+		columns:          treemap.NewWith(columnComparator),
+		rowComparator:    rowComparator,
+		columnComparator: columnComparator,
+	}
+}
+
+// NewWithIntComparators instantiates a tree table with the IntComparator for both row and column keys, i.e. keys are
+// of type int.
+func NewWithIntComparators() *Table {
+	return NewWith(utils.IntComparator, utils.IntComparator)
+}
+
+// NewWithStringComparators instantiates a tree table with the StringComparator for both row and column keys, i.e.
+// keys are of type string.
+func NewWithStringComparators() *Table {
+	return NewWith(utils.StringComparator, utils.StringComparator)
+}
+
+// Put inserts the value into the cell at the row and column, replacing the previous value if any.
+// Keys should adhere to the comparators' type assertion, otherwise method panics.
+func (table *Table) Put(row interface{}, column interface{}, value interface{}) {
+	cells, found := table.cells(table.rows, row)
+	if !found {
+		cells = treemap.NewWith(table.columnComparator)
+		table.rows.Put(row, cells)
+	}
+	if _, found := cells.Get(column); !found {
+		table.size++
+	}
+	cells.Put(column, value)
+	cells, found = table.cells(table.columns, column)
+	if !found {
+		cells = treemap.NewWith(table.rowComparator)
+		table.columns.Put(column, cells)
+	}
+	cells.Put(row, value)
+}
+
+// Get searches the cell at the row and column and returns its value or nil if there is no such cell.
+// Second return parameter is true if the cell was found, otherwise false.
+// Keys should adhere to the comparators' type assertion, otherwise method panics.
+func (table *Table) Get(row interface{}, column interface{}) (value interface{}, found bool) {
+	if cells, found := table.cells(table.rows, row); found {
+		return cells.Get(column)
+	}
+	return nil, false
+}
+
+// Remove removes the cell at the row and column, if any.
+// Keys should adhere to the comparators' type assertion, otherwise method panics.
+func (table *Table) Remove(row interface{}, column interface{}) {
+	if !table.Contains(row, column) {
+		return
+	}
+	table.remove(table.rows, row, column)
+	table.remove(table.columns, column, row)
+	table.size--
+}
+
+// RemoveRow removes all cells of the row.
+// Key should adhere to the row comparator's type assertion, otherwise method panics.
+func (table *Table) RemoveRow(row interface{}) {
+	cells, found := table.cells(table.rows, row)
+	if !found {
+		return
+	}
+	for _, column := range cells.Keys() {
+		table.remove(table.columns, column, row)
+		table.size--
+	}
+	table.rows.Remove(row)
+}
+
+// RemoveColumn removes all cells of the column.
+// Key should adhere to the column comparator's type assertion, otherwise method panics.
+func (table *Table) RemoveColumn(column interface{}) {
+	cells, found := table.cells(table.columns, column)
+	if !found {
+		return
+	}
+	for _, row := range cells.Keys() {
+		table.remove(table.rows, row, column)
+		table.size--
+	}
+	table.columns.Remove(column)
+}
+
+// Contains returns true if there is a cell at the row and column.
+// Keys should adhere to the comparators' type assertion, otherwise method panics.
+func (table *Table) Contains(row interface{}, column interface{}) bool {
+	_, found := table.Get(row, column)
+	return found
+}
+
+// Row returns a tree map from the column keys to the values of all cells of the row, which is empty if there are none.
+// The map is a copy ordered by the column comparator, so modifying it does not modify the table.
+func (table *Table) Row(row interface{}) maps.Map {
+	return table.copy(table.rows, row, table.columnComparator)
+}
+
+// Column returns a tree map from the row keys to the values of all cells of the column, which is empty if there are
+// none. The map is a copy ordered by the row comparator, so modifying it does not modify the table.
+func (table *Table) Column(column interface{}) maps.Map {
+	return table.copy(table.columns, column, table.rowComparator)
+}
+
+// RowKeys returns the keys of all rows that have at least one cell, in order.
+func (table *Table) RowKeys() []interface{} {
+	return table.rows.Keys()
+}
+
+// ColumnKeys returns the keys of all columns that have at least one cell, in order.
+func (table *Table) ColumnKeys() []interface{} {
+	return table.columns.Keys()
+}
+
+// Cells returns all cells of the table, ordered by row and then by column.
+func (table *Table) Cells() []tables.Cell {
+	cells := make([]tables.Cell, 0, table.size)
+	it := table.Iterator()
+	for it.Next() {
+		cells = append(cells, tables.Cell{Row: it.Row(), Column: it.Column(), Value: it.Value()})
+	}
+	return cells
+}
+
+// Transpose returns a new table in which the rows of this table are the columns and vice versa.
+// The row and column comparators are swapped accordingly.
+func (table *Table) Transpose() tables.Table {
+	transposed := NewWith(table.columnComparator, table.rowComparator)
+	it := table.Iterator()
+	for it.Next() {
+		transposed.Put(it.Column(), it.Row(), it.Value())
+	}
+	return transposed
+}
+
+// Empty returns true if table does not contain any cells
+func (table *Table) Empty() bool {
+	return table.size == 0
+}
+
+// Size returns number of cells in the table.
+func (table *Table) Size() int {
+	return table.size
+}
+
+// Clear removes all cells from the table.
+func (table *Table) Clear() {
+	table.rows.Clear()
+	table.columns.Clear()
+	table.size = 0
+}
+
+// Values returns the values of all cells, ordered by row and then by column.
+func (table *Table) Values() []interface{} {
+	values := make([]interface{}, 0, table.size)
+	it := table.Iterator()
+	for it.Next() {
+		values = append(values, it.Value())
+	}
+	return values
+}
+
+// String returns a string representation of container
+func (table *Table) String() string {
+	str := "TreeTable\n"
+	items := []string{}
+	it := table.rows.Iterator()
+	for it.Next() {
+		items = append(items, fmt.Sprintf("%v:%v", it.Key(), strings.TrimPrefix(it.Value().(*treemap.Map).String(), "TreeMap\n")))
+	}
+	str += strings.Join(items, ", ")
+	return str
+}
+
+// cells returns the cells of the outer key in one of the indexes
+func (table *Table) cells(index *treemap.Map, outer interface{}) (*treemap.Map, bool) {
+	if cells, found := index.Get(outer); found {
+		return cells.(*treemap.Map), true
+	}
+	return nil, false
+}
+
+// remove removes the cell at the outer and inner key from one of the indexes, and the outer key once it has no cells
+func (table *Table) remove(index *treemap.Map, outer interface{}, inner interface{}) {
+	cells, _ := table.cells(index, outer)
+	cells.Remove(inner)
+	if cells.Empty() {
+		index.Remove(outer)
+	}
+}
+
+// copy returns a copy of the cells of the outer key in one of the indexes
+func (table *Table) copy(index *treemap.Map, outer interface{}, comparator utils.Comparator) *treemap.Map {
+	m := treemap.NewWith(comparator)
+	if cells, found := table.cells(index, outer); found {
+		it := cells.Iterator()
+		for it.Next() {
+			m.Put(it.Key(), it.Value())
+		}
+	}
+	return m
+}
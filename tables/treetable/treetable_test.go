@@ -0,0 +1,270 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package treetable
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/emirpasic/gods/utils"
+	"strings"
+	"testing"
+)
+
+func TestTablePut(t *testing.T) {
+	table := NewWith(utils.IntComparator, utils.StringComparator)
+	table.Put(2, "b", 22)
+	table.Put(1, "b", 12)
+	table.Put(2, "a", 21)
+	table.Put(3, "c", 33)
+
+	if actualValue, expectedValue := fmt.Sprintf("%v", table.RowKeys()), "[1 2 3]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", table.ColumnKeys()), "[a b c]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", table.Values()), "[12 21 22 33]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", table.Cells()), "[{1 b 12} {2 a 21} {2 b 22} {3 c 33}]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", table.Row(2).Keys()), "[a b]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", table.Column("b").Keys()), "[1 2]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestTableTranspose(t *testing.T) {
+	table := NewWith(utils.IntComparator, utils.StringComparator)
+	table.Put(2, "b", 22)
+	table.Put(1, "b", 12)
+	table.Put(2, "a", 21)
+
+	// comparators are swapped with the keys
+	transposed := table.Transpose()
+	if actualValue, expectedValue := fmt.Sprintf("%v", transposed.Cells()), "[{a 2 21} {b 1 12} {b 2 22}]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	transposed.Put("c", 0, 0)
+	if actualValue, expectedValue := fmt.Sprintf("%v", transposed.ColumnKeys()), "[0 1 2]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestTableIterator(t *testing.T) {
+	table := NewWithStringComparators()
+	it := table.Iterator()
+	for it.Next() {
+		t.Errorf("Shouldn't iterate on empty table")
+	}
+	for it.Prev() {
+		t.Errorf("Shouldn't iterate on empty table")
+	}
+
+	table.Put("r2", "c1", 21)
+	table.Put("r1", "c2", 12)
+	table.Put("r1", "c1", 11)
+	table.Put("r3", "c3", 33)
+
+	it = table.Iterator()
+	cells := []interface{}{}
+	for it.Next() {
+		cells = append(cells, fmt.Sprintf("%v:%v:%v", it.Row(), it.Column(), it.Value()))
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", cells), "[r1:c1:11 r1:c2:12 r2:c1:21 r3:c3:33]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	cells = []interface{}{}
+	for it.Prev() {
+		cells = append(cells, fmt.Sprintf("%v:%v:%v", it.Row(), it.Column(), it.Value()))
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", cells), "[r3:c3:33 r2:c1:21 r1:c2:12 r1:c1:11]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	// changing direction in the middle of a row
+	it.First()
+	it.Next()
+	it.Prev()
+	if actualValue, expectedValue := fmt.Sprintf("%v:%v", it.Row(), it.Column()), "r1:c1"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	if actualValue, expectedValue := it.Last(), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := it.Value(), 33; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := it.PrevTo(func(row interface{}, column interface{}, value interface{}) bool {
+		return column == "c2"
+	}), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := it.Value(), 12; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := it.NextTo(func(row interface{}, column interface{}, value interface{}) bool {
+		return row == "r3"
+	}), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := it.Value(), 33; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := it.Next(), false; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestTableSerialization(t *testing.T) {
+	table := NewWithStringComparators()
+	table.Put("r2", "c1", "a")
+	table.Put("r1", "c2", "b")
+
+	_, err := json.Marshal([]interface{}{"a", "b", "c", table})
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+
+	err = json.Unmarshal([]byte(`{"x":{"z":1,"y":2}}`), &table)
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", table.Cells()), "[{x y 2} {x z 1}]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestTableString(t *testing.T) {
+	table := NewWithStringComparators()
+	table.Put("r2", "c1", 21)
+	table.Put("r1", "c2", 12)
+	table.Put("r1", "c1", 11)
+	if !strings.HasPrefix(table.String(), "TreeTable") {
+		t.Errorf("String should start with container name")
+	}
+	if actualValue, expectedValue := table.String(), "TreeTable\nr1:map[c1:11 c2:12], r2:map[c1:21]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func benchmarkGet(b *testing.B, table *Table, size int) {
+	for i := 0; i < b.N; i++ {
+		for n := 0; n < size; n++ {
+			table.Get(n%100, n/100)
+		}
+	}
+}
+
+func benchmarkPut(b *testing.B, table *Table, size int) {
+	for i := 0; i < b.N; i++ {
+		for n := 0; n < size; n++ {
+			table.Put(n%100, n/100, struct{}{})
+		}
+	}
+}
+
+func benchmarkColumn(b *testing.B, table *Table, size int) {
+	for i := 0; i < b.N; i++ {
+		for n := 0; n < size/100+1; n++ {
+			table.Column(n)
+		}
+	}
+}
+
+func benchmarkRemove(b *testing.B, table *Table, size int) {
+	for i := 0; i < b.N; i++ {
+		for n := 0; n < size; n++ {
+			table.Remove(n%100, n/100)
+		}
+	}
+}
+
+func BenchmarkTreeTableGet100(b *testing.B) {
+	b.StopTimer()
+	size := 100
+	table := NewWithIntComparators()
+	for n := 0; n < size; n++ {
+		table.Put(n%100, n/100, struct{}{})
+	}
+	b.StartTimer()
+	benchmarkGet(b, table, size)
+}
+
+func BenchmarkTreeTableGet10000(b *testing.B) {
+	b.StopTimer()
+	size := 10000
+	table := NewWithIntComparators()
+	for n := 0; n < size; n++ {
+		table.Put(n%100, n/100, struct{}{})
+	}
+	b.StartTimer()
+	benchmarkGet(b, table, size)
+}
+
+func BenchmarkTreeTablePut100(b *testing.B) {
+	b.StopTimer()
+	size := 100
+	table := NewWithIntComparators()
+	b.StartTimer()
+	benchmarkPut(b, table, size)
+}
+
+func BenchmarkTreeTablePut10000(b *testing.B) {
+	b.StopTimer()
+	size := 10000
+	table := NewWithIntComparators()
+	b.StartTimer()
+	benchmarkPut(b, table, size)
+}
+
+func BenchmarkTreeTableColumn100(b *testing.B) {
+	b.StopTimer()
+	size := 100
+	table := NewWithIntComparators()
+	for n := 0; n < size; n++ {
+		table.Put(n%100, n/100, struct{}{})
+	}
+	b.StartTimer()
+	benchmarkColumn(b, table, size)
+}
+
+func BenchmarkTreeTableColumn10000(b *testing.B) {
+	b.StopTimer()
+	size := 10000
+	table := NewWithIntComparators()
+	for n := 0; n < size; n++ {
+		table.Put(n%100, n/100, struct{}{})
+	}
+	b.StartTimer()
+	benchmarkColumn(b, table, size)
+}
+
+func BenchmarkTreeTableRemove100(b *testing.B) {
+	b.StopTimer()
+	size := 100
+	table := NewWithIntComparators()
+	for n := 0; n < size; n++ {
+		table.Put(n%100, n/100, struct{}{})
+	}
+	b.StartTimer()
+	benchmarkRemove(b, table, size)
+}
+
+func BenchmarkTreeTableRemove10000(b *testing.B) {
+	b.StopTimer()
+	size := 10000
+	table := NewWithIntComparators()
+	for n := 0; n < size; n++ {
+		table.Put(n%100, n/100, struct{}{})
+	}
+	b.StartTimer()
+	benchmarkRemove(b, table, size)
+}
@@ -32,6 +32,7 @@ type Node struct {
 	Parent   *Node    // Parent node
 	Children [2]*Node // Children nodes
 	b        int8
+	size     int // Number of nodes in the subtree rooted at this node
 }
 
 // NewWith instantiates an AVL tree with the custom comparator.
@@ -101,19 +102,12 @@ func (t *Tree) Size() int {
 }
 
 // Size returns the number of elements stored in the subtree.
-// Computed dynamically on each call, i.e. the subtree is traversed to count the number of the nodes.
+// Subtree sizes are maintained on every insertion, removal and rotation, i.e. this is a constant time operation.
 func (n *Node) Size() int {
 	if n == nil {
 		return 0
 	}
-	size := 1
-	if n.Children[0] != nil {
-		size += n.Children[0].Size()
-	}
-	if n.Children[1] != nil {
-		size += n.Children[1].Size()
-	}
-	return size
+	return n.size
 }
 
 // Keys returns all keys in-order
@@ -206,6 +200,52 @@ func (t *Tree) Ceiling(key interface{}) (floor *Node, found bool) {
 	return nil, false
 }
 
+// Select returns the node with the given index in the in-order traversal of the tree, i.e. the node with the
+// index-th smallest key (zero-based), or nil if index is out of bounds.
+// Runs in O(log n) time.
+func (t *Tree) Select(index int) *Node {
+	if index < 0 || index >= t.size {
+		return nil
+	}
+	n := t.Root
+	for n != nil {
+		leftSize := n.Children[0].Size()
+		switch {
+		case index < leftSize:
+			n = n.Children[0]
+		case index > leftSize:
+			index -= leftSize + 1
+			n = n.Children[1]
+		default:
+			return n
+		}
+	}
+	return nil
+}
+
+// Rank returns the number of keys in the tree that are strictly smaller than the given key.
+// If the key is in the tree, then its rank is its (zero-based) index in the in-order traversal of the tree.
+// Runs in O(log n) time.
+//
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (t *Tree) Rank(key interface{}) int {
+	rank := 0
+	n := t.Root
+	for n != nil {
+		c := t.Comparator(key, n.Key)
+		switch {
+		case c < 0:
+			n = n.Children[0]
+		case c > 0:
+			rank += n.Children[0].Size() + 1
+			n = n.Children[1]
+		default:
+			return rank + n.Children[0].Size()
+		}
+	}
+	return rank
+}
+
 // Clear removes all nodes from the tree.
 func (t *Tree) Clear() {
 	t.Root = nil
@@ -229,7 +269,7 @@ func (t *Tree) put(key interface{}, value interface{}, p *Node, qp **Node) bool
 	q := *qp
 	if q == nil {
 		t.size++
-		*qp = &Node{Key: key, Value: value, Parent: p}
+		*qp = &Node{Key: key, Value: value, Parent: p, size: 1}
 		return true
 	}
 
@@ -248,6 +288,7 @@ func (t *Tree) put(key interface{}, value interface{}, p *Node, qp **Node) bool
 	a := (c + 1) / 2
 	var fix bool
 	fix = t.put(key, value, q, &q.Children[a])
+	q.resize()
 	if fix {
 		return putFix(int8(c), qp)
 	}
@@ -271,6 +312,7 @@ func (t *Tree) remove(key interface{}, qp **Node) bool {
 			return true
 		}
 		fix := removeMin(&q.Children[1], &q.Key, &q.Value)
+		q.resize()
 		if fix {
 			return removeFix(-1, qp)
 		}
@@ -284,6 +326,7 @@ func (t *Tree) remove(key interface{}, qp **Node) bool {
 	}
 	a := (c + 1) / 2
 	fix := t.remove(key, &q.Children[a])
+	q.resize()
 	if fix {
 		return removeFix(int8(-c), qp)
 	}
@@ -302,6 +345,7 @@ func removeMin(qp **Node, minKey *interface{}, minVal *interface{}) bool {
 		return true
 	}
 	fix := removeMin(&q.Children[0], minKey, minVal)
+	q.resize()
 	if fix {
 		return removeFix(1, qp)
 	}
@@ -397,9 +441,16 @@ func rotate(c int8, s *Node) *Node {
 	r.Children[a^1] = s
 	r.Parent = s.Parent
 	s.Parent = r
+	s.resize()
+	r.resize()
 	return r
 }
 
+// resize recomputes the size of the subtree rooted at n from the sizes of its children.
+func (n *Node) resize() {
+	n.size = n.Children[0].Size() + n.Children[1].Size() + 1
+}
+
 func (t *Tree) bottom(d int) *Node {
 	n := t.Root
 	if n == nil {
@@ -7,6 +7,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"github.com/emirpasic/gods/utils"
+	"math/rand"
 	"strings"
 	"testing"
 )
@@ -230,6 +231,88 @@ func TestAVLTreeCeilingAndFloor(t *testing.T) {
 	}
 }
 
+func TestAVLTreeSelectAndRank(t *testing.T) {
+	tree := NewWithIntComparator()
+
+	if actualValue := tree.Select(0); actualValue != nil {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+	if actualValue := tree.Rank(1); actualValue != 0 {
+		t.Errorf("Got %v expected %v", actualValue, 0)
+	}
+
+	tree.Put(5, "e")
+	tree.Put(6, "f")
+	tree.Put(7, "g")
+	tree.Put(3, "c")
+	tree.Put(4, "d")
+	tree.Put(1, "x")
+	tree.Put(2, "b")
+	tree.Put(1, "a") //overwrite
+
+	for index, key := range []int{1, 2, 3, 4, 5, 6, 7} {
+		if actualValue := tree.Select(index); actualValue == nil || actualValue.Key != key {
+			t.Errorf("Got %v expected %v", actualValue, key)
+		}
+		if actualValue := tree.Rank(key); actualValue != index {
+			t.Errorf("Got %v expected %v", actualValue, index)
+		}
+	}
+	if actualValue := tree.Select(-1); actualValue != nil {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+	if actualValue := tree.Select(7); actualValue != nil {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+	if actualValue := tree.Rank(0); actualValue != 0 {
+		t.Errorf("Got %v expected %v", actualValue, 0)
+	}
+	if actualValue := tree.Rank(8); actualValue != 7 {
+		t.Errorf("Got %v expected %v", actualValue, 7)
+	}
+	if actualValue := tree.Root.Size(); actualValue != 7 {
+		t.Errorf("Got %v expected %v", actualValue, 7)
+	}
+}
+
+func TestAVLTreeSubtreeSizes(t *testing.T) {
+	tree := NewWithIntComparator()
+	random := rand.New(rand.NewSource(1))
+
+	var count func(node *Node) int
+	count = func(node *Node) int {
+		if node == nil {
+			return 0
+		}
+		size := 1 + count(node.Children[0]) + count(node.Children[1])
+		if node.Size() != size {
+			t.Errorf("Got %v expected %v for subtree of %v", node.Size(), size, node.Key)
+		}
+		return size
+	}
+
+	for i := 0; i < 2000; i++ {
+		key := random.Intn(200)
+		if random.Intn(3) == 0 {
+			tree.Remove(key)
+		} else {
+			tree.Put(key, i)
+		}
+		if actualValue, expectedValue := count(tree.Root), tree.Size(); actualValue != expectedValue {
+			t.Fatalf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+
+	for index, key := range tree.Keys() {
+		if actualValue := tree.Select(index); actualValue.Key != key {
+			t.Errorf("Got %v expected %v", actualValue.Key, key)
+		}
+		if actualValue := tree.Rank(key); actualValue != index {
+			t.Errorf("Got %v expected %v", actualValue, index)
+		}
+	}
+}
+
 func TestAVLTreeIteratorNextOnEmpty(t *testing.T) {
 	tree := NewWithIntComparator()
 	it := tree.Iterator()
@@ -6,6 +6,9 @@ package binaryheap
 
 import (
 	"encoding/json"
+	"fmt"
+	"github.com/emirpasic/gods/containers"
+	"github.com/emirpasic/gods/utils"
 	"math/rand"
 	"strings"
 	"testing"
@@ -433,6 +436,119 @@ func benchmarkPop(b *testing.B, heap *Heap, size int) {
 	}
 }
 
+func TestBinaryHeapEnumerable(t *testing.T) {
+	c := NewWithIntComparator()
+	c.Push(3, 1, 2)
+	var enumerable containers.EnumerableContainerWithIndex = c
+
+	visited := []interface{}{}
+	enumerable.Each(func(index int, value interface{}) {
+		if index != len(visited) {
+			t.Errorf("Got index %v expected %v", index, len(visited))
+		}
+		visited = append(visited, value)
+	})
+	if actualValue, expectedValue := fmt.Sprint(visited), "[1 2 3]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	mapped := enumerable.Map(func(index int, value interface{}) interface{} {
+		return value.(int) * 10
+	})
+	if _, ok := mapped.(*Heap); !ok {
+		t.Errorf("Got %T expected %T", mapped, c)
+	}
+	if actualValue, expectedValue := fmt.Sprint(mapped.Values()), "[10 20 30]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	selected := enumerable.Select(func(index int, value interface{}) bool {
+		return value.(int) > 1
+	})
+	if actualValue, expectedValue := fmt.Sprint(selected.Values()), "[2 3]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	if actualValue := enumerable.Any(func(index int, value interface{}) bool { return value.(int) == 2 }); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := enumerable.Any(func(index int, value interface{}) bool { return value.(int) == 4 }); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	if actualValue := enumerable.All(func(index int, value interface{}) bool { return value.(int) > 0 }); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := enumerable.All(func(index int, value interface{}) bool { return value.(int) > 1 }); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	if index, value := enumerable.Find(func(index int, value interface{}) bool { return value.(int) == 1 }); index != 0 || value != 1 {
+		t.Errorf("Got %v at %v expected %v at %v", value, index, 1, 0)
+	}
+	if index, value := enumerable.Find(func(index int, value interface{}) bool { return value.(int) == 4 }); index != -1 || value != nil {
+		t.Errorf("Got %v at %v expected %v at %v", value, index, nil, -1)
+	}
+}
+
+func TestBinaryHeapEnumerablePopOrder(t *testing.T) {
+	// values span several levels, so that the level order of Values differs from the pop order
+	c := NewWithIntComparator()
+	c.Push(1, 5, 2, 6, 7, 3, 4)
+	if actualValue, expectedValue := fmt.Sprint(c.Values()), "[1 2 5 3 4 6 7]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	visited := []interface{}{}
+	c.Each(func(index int, value interface{}) {
+		visited = append(visited, value)
+	})
+	if actualValue, expectedValue := fmt.Sprint(visited), "[1 2 3 4 5 6 7]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	indexes := []int{}
+	c.Select(func(index int, value interface{}) bool {
+		indexes = append(indexes, index)
+		return true
+	})
+	if actualValue, expectedValue := fmt.Sprint(indexes), "[0 1 2 3 4 5 6]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if index, value := c.Find(func(index int, value interface{}) bool { return value.(int) > 2 }); index != 2 || value != 3 {
+		t.Errorf("Got %v at %v expected %v at %v", value, index, 3, 2)
+	}
+	if actualValue := c.All(func(index int, value interface{}) bool { return index+1 == value.(int) }); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue, expectedValue := c.Size(), 7; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestBinaryHeapEnumerableStopsEarly(t *testing.T) {
+	comparisons := 0
+	c := NewWith(func(a, b interface{}) int {
+		comparisons++
+		return utils.IntComparator(a, b)
+	})
+	for i := 0; i < 1000; i++ {
+		c.Push(i)
+	}
+	comparisons = 0
+	if index, value := c.Find(func(index int, value interface{}) bool { return true }); index != 0 || value != 0 {
+		t.Errorf("Got %v at %v expected %v at %v", value, index, 0, 0)
+	}
+	if actualValue := c.Any(func(index int, value interface{}) bool { return index == 1 }); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := c.All(func(index int, value interface{}) bool { return index == 0 }); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	// a single pop takes O(log n) comparisons, while popping all elements would take thousands
+	if comparisons > 200 {
+		t.Errorf("Got %v comparisons expected at most %v", comparisons, 200)
+	}
+}
+
 func BenchmarkBinaryHeapPop100(b *testing.B) {
 	b.StopTimer()
 	size := 100
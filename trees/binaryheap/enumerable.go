@@ -0,0 +1,109 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package binaryheap
+
+import (
+	"github.com/emirpasic/gods/containers"
+	"github.com/emirpasic/gods/lists/arraylist"
+)
+
+// Assert Enumerable implementation
+var _ containers.EnumerableWithIndex = (*Heap)(nil)
+
+// Each calls the given function once for each element, passing that element's index and value.
+// Elements are visited in pop order, the index being the number of elements popped before it.
+func (heap *Heap) Each(f func(index int, value interface{})) {
+	heap.popInOrder(func(index int, value interface{}) bool {
+		f(index, value)
+		return true
+	})
+}
+
+// Map invokes the given function once for each element and returns a
+// container containing the values returned by the given function.
+// Elements are visited in pop order and the returned heap orders the results with the same comparator.
+func (heap *Heap) Map(f func(index int, value interface{}) interface{}) containers.EnumerableContainerWithIndex {
+	values := make([]interface{}, 0, heap.Size())
+	heap.popInOrder(func(index int, value interface{}) bool {
+		values = append(values, f(index, value))
+		return true
+	})
+	return heap.newFromValues(values)
+}
+
+// Select returns a new container containing all elements for which the given function returns a true value.
+// Elements are visited in pop order.
+func (heap *Heap) Select(f func(index int, value interface{}) bool) containers.EnumerableContainerWithIndex {
+	values := make([]interface{}, 0)
+	heap.popInOrder(func(index int, value interface{}) bool {
+		if f(index, value) {
+			values = append(values, value)
+		}
+		return true
+	})
+	return heap.newFromValues(values)
+}
+
+// Any passes each element of the container to the given function and
+// returns true if the function ever returns true for any element.
+// Elements are visited in pop order.
+func (heap *Heap) Any(f func(index int, value interface{}) bool) bool {
+	found := false
+	heap.popInOrder(func(index int, value interface{}) bool {
+		found = f(index, value)
+		return !found
+	})
+	return found
+}
+
+// All passes each element of the container to the given function and
+// returns true if the function returns true for all elements.
+// Elements are visited in pop order.
+func (heap *Heap) All(f func(index int, value interface{}) bool) bool {
+	all := true
+	heap.popInOrder(func(index int, value interface{}) bool {
+		all = f(index, value)
+		return all
+	})
+	return all
+}
+
+// Find passes each element of the container to the given function and returns
+// the first (index,value) for which the function is true or -1,nil otherwise
+// if no element matches the criteria.
+// Elements are visited in pop order.
+func (heap *Heap) Find(f func(index int, value interface{}) bool) (foundIndex int, foundValue interface{}) {
+	foundIndex = -1
+	heap.popInOrder(func(index int, value interface{}) bool {
+		if f(index, value) {
+			foundIndex, foundValue = index, value
+			return false
+		}
+		return true
+	})
+	return foundIndex, foundValue
+}
+
+// popInOrder passes the elements to the given function in the order in which they would be popped, until the function
+// returns false. Elements are popped one at a time from a copy of the heap, which leaves the heap untouched and
+// spares the remaining O(log n) pops when the function stops early.
+func (heap *Heap) popInOrder(f func(index int, value interface{}) bool) {
+	copied := &Heap{list: arraylist.New(heap.list.Values()...), Comparator: heap.Comparator}
+	for index := 0; ; index++ {
+		value, ok := copied.Pop()
+		if !ok || !f(index, value) {
+			return
+		}
+	}
+}
+
+// newFromValues instantiates a new heap with the same comparator holding the passed values.
+func (heap *Heap) newFromValues(values []interface{}) *Heap {
+	newHeap := NewWith(heap.Comparator)
+	if len(values) > 0 {
+		newHeap.Push(values...)
+	}
+	return newHeap
+}
@@ -0,0 +1,619 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package intervaltree implements an interval tree backed by a red-black tree that doubles as a priority search tree.
+//
+// Intervals are closed, i.e. both endpoints belong to the interval, and are ordered by their low and then by their
+// high endpoint. On top of that order, the intervals are kept in a heap on their high endpoints: every node holds the
+// interval with the highest high endpoint among those of its subtree that are not held further up. Overlapping,
+// Stabbing and Containing queries all come down to finding the intervals that start no later and end no earlier than
+// a given pair of endpoints, which the heap answers in O(log n + k) time, where k is the number of reported intervals.
+// Insertions and removals take O(log n) time.
+//
+// Structure is not thread safe.
+//
+// References: https://en.wikipedia.org/wiki/Interval_tree, https://en.wikipedia.org/wiki/Priority_search_tree
+package intervaltree
+
+import (
+	"fmt"
+	"github.com/emirpasic/gods/trees"
+	"github.com/emirpasic/gods/utils"
+	"strings"
+)
+
+// Assert Tree implementation
+var _ trees.Tree = (*Tree)(nil)
+
+type color bool
+
+const (
+	black, red color = true, false
+)
+
+// Tree holds intervals in a red-black tree whose nodes also form a heap on the intervals' high endpoints
+type Tree struct {
+	root       *node
+	size       int
+	Comparator utils.Comparator // Endpoint comparator
+}
+
+// node is a single interval within the tree
+type node struct {
+	interval Interval
+	value    interface{}
+	color    color
+	left     *node
+	right    *node
+	parent   *node
+	top      *node // node of the interval with the highest high endpoint within the subtree not held by an ancestor
+	own      bool  // whether the node also holds its own interval, which may not move below it, in addition to top
+}
+
+// Interval is a closed interval between two endpoints
+type Interval struct {
+	Low  interface{} `json:"low"`
+	High interface{} `json:"high"`
+}
+
+// Entry is an interval together with the value stored for it
+type Entry struct {
+	Interval Interval
+	Value    interface{}
+}
+
+// NewWith instantiates an interval tree with the custom endpoint comparator.
+func NewWith(comparator utils.Comparator) *Tree {
+	return &Tree{Comparator: comparator}
+}
+
+// NewWithIntComparator instantiates an interval tree with the IntComparator, i.e. endpoints are of type int.
+func NewWithIntComparator() *Tree {
+	return NewWith(utils.IntComparator)
+}
+
+// NewWithStringComparator instantiates an interval tree with the StringComparator, i.e. endpoints are of type string.
+func NewWithStringComparator() *Tree {
+	return NewWith(utils.StringComparator)
+}
+
+// Put inserts the interval between low and high (both inclusive) with the given value into the tree.
+// If the same interval is already in the tree, then its value is replaced.
+// Intervals whose low endpoint is larger than their high endpoint are empty and are not inserted.
+// Endpoints should adhere to the comparator's type assertion, otherwise method panics.
+func (tree *Tree) Put(low interface{}, high interface{}, value interface{}) {
+	if tree.Comparator(low, high) > 0 {
+		return
+	}
+	interval := Interval{Low: low, High: high}
+	var inserted *node
+	if tree.root == nil {
+		tree.root = &node{interval: interval, value: value, color: red}
+		inserted = tree.root
+	} else {
+		current := tree.root
+		for inserted == nil {
+			compare := tree.compare(interval, current.interval)
+			switch {
+			case compare == 0:
+				current.interval = interval
+				current.value = value
+				return
+			case compare < 0:
+				if current.left == nil {
+					current.left = &node{interval: interval, value: value, color: red, parent: current}
+					inserted = current.left
+				} else {
+					current = current.left
+				}
+			case compare > 0:
+				if current.right == nil {
+					current.right = &node{interval: interval, value: value, color: red, parent: current}
+					inserted = current.right
+				} else {
+					current = current.right
+				}
+			}
+		}
+	}
+	tree.push(tree.root, inserted)
+	tree.insertCase1(inserted)
+	tree.size++
+}
+
+// Get searches the interval in the tree and returns its value or nil if interval is not found in tree.
+// Second return parameter is true if interval was found, otherwise false.
+// Endpoints should adhere to the comparator's type assertion, otherwise method panics.
+func (tree *Tree) Get(low interface{}, high interface{}) (value interface{}, found bool) {
+	n := tree.lookup(Interval{Low: low, High: high})
+	if n != nil {
+		return n.value, true
+	}
+	return nil, false
+}
+
+// Remove removes the interval from the tree.
+// Endpoints should adhere to the comparator's type assertion, otherwise method panics.
+func (tree *Tree) Remove(low interface{}, high interface{}) {
+	n := tree.lookup(Interval{Low: low, High: high})
+	if n == nil {
+		return
+	}
+	tree.release(n)
+	if n.left != nil && n.right != nil {
+		pred := n.left.maximumNode()
+		tree.release(pred)
+		n.interval = pred.interval
+		n.value = pred.value
+		tree.push(tree.root, n)
+		n = pred
+	}
+	child := n.left
+	if n.right != nil {
+		child = n.right
+	}
+	// The node holds no interval of its own anymore, but it may still hold one of its child's subtree on top,
+	// which is handed down once the node is replaced by the child
+	if n.color == black {
+		n.color = nodeColor(child)
+		tree.deleteCase1(n)
+	}
+	top := n.top
+	n.top = nil
+	tree.replaceNode(n, child)
+	if top != nil {
+		tree.push(child, top)
+	}
+	if n.parent == nil && child != nil {
+		child.color = black
+	}
+	tree.size--
+}
+
+// Empty returns true if tree does not contain any intervals.
+func (tree *Tree) Empty() bool {
+	return tree.size == 0
+}
+
+// Size returns number of intervals in the tree.
+func (tree *Tree) Size() int {
+	return tree.size
+}
+
+// Intervals returns all intervals in-order.
+func (tree *Tree) Intervals() []Interval {
+	intervals := make([]Interval, 0, tree.size)
+	for it := tree.Iterator(); it.Next(); {
+		intervals = append(intervals, it.Interval())
+	}
+	return intervals
+}
+
+// Values returns all values in-order based on the interval.
+func (tree *Tree) Values() []interface{} {
+	values := make([]interface{}, 0, tree.size)
+	for it := tree.Iterator(); it.Next(); {
+		values = append(values, it.Value())
+	}
+	return values
+}
+
+// Clear removes all intervals from the tree.
+func (tree *Tree) Clear() {
+	tree.root = nil
+	tree.size = 0
+}
+
+// Overlapping returns all entries whose intervals overlap the interval between low and high (both inclusive),
+// i.e. share at least one point with it, in no particular order.
+// Runs in O(log n + k) time, where k is the number of returned entries.
+// Endpoints should adhere to the comparator's type assertion, otherwise method panics.
+func (tree *Tree) Overlapping(low interface{}, high interface{}) []Entry {
+	entries := []Entry{}
+	tree.search(tree.root, high, low, &entries)
+	return entries
+}
+
+// Stabbing returns all entries whose intervals contain the given point, in no particular order.
+// Runs in O(log n + k) time, where k is the number of returned entries.
+// Point should adhere to the comparator's type assertion, otherwise method panics.
+func (tree *Tree) Stabbing(point interface{}) []Entry {
+	entries := []Entry{}
+	tree.search(tree.root, point, point, &entries)
+	return entries
+}
+
+// Containing returns all entries whose intervals contain the whole interval between low and high (both inclusive),
+// in no particular order.
+// Runs in O(log n + k) time, where k is the number of returned entries.
+// Endpoints should adhere to the comparator's type assertion, otherwise method panics.
+func (tree *Tree) Containing(low interface{}, high interface{}) []Entry {
+	entries := []Entry{}
+	tree.search(tree.root, low, high, &entries)
+	return entries
+}
+
+// String returns a string representation of container
+func (tree *Tree) String() string {
+	str := "IntervalTree\n"
+	items := []string{}
+	for it := tree.Iterator(); it.Next(); {
+		items = append(items, it.Interval().String())
+	}
+	str += strings.Join(items, ", ")
+	return str
+}
+
+// String returns a string representation of the interval
+func (interval Interval) String() string {
+	return fmt.Sprintf("[%v, %v]", interval.Low, interval.High)
+}
+
+// search collects the intervals of the subtree that start at or before maxLow and end at or after minHigh.
+// Every visited node either reports the interval on its top, lies on the path to maxLow, or is a child of such a
+// node, which bounds the number of visited nodes by O(log n + k).
+func (tree *Tree) search(n *node, maxLow interface{}, minHigh interface{}, entries *[]Entry) {
+	// Intervals held within the subtree do not end after the one held on its top
+	for n != nil && n.top != nil && tree.Comparator(n.top.interval.High, minHigh) >= 0 {
+		if tree.Comparator(n.top.interval.Low, maxLow) <= 0 {
+			*entries = append(*entries, Entry{Interval: n.top.interval, Value: n.top.value})
+		}
+		if n.own && tree.Comparator(n.interval.High, minHigh) >= 0 && tree.Comparator(n.interval.Low, maxLow) <= 0 {
+			*entries = append(*entries, Entry{Interval: n.interval, Value: n.value})
+		}
+		tree.search(n.left, maxLow, minHigh, entries)
+		// This and all intervals in the right subtree start after maxLow
+		if tree.Comparator(n.interval.Low, maxLow) > 0 {
+			return
+		}
+		n = n.right
+	}
+}
+
+// push places the interval of node x into the heap of the subtree rooted at n, which has to contain x.
+// Intervals ending later stay on top and the others move down towards their own nodes.
+func (tree *Tree) push(n *node, x *node) {
+	for {
+		if n.top == nil {
+			n.top = x
+			return
+		}
+		if tree.higher(x, n.top) {
+			x, n.top = n.top, x
+		}
+		if x == n {
+			n.own = true
+			return
+		}
+		if tree.compare(x.interval, n.interval) < 0 {
+			n = n.left
+		} else {
+			n = n.right
+		}
+	}
+}
+
+// pull refills the empty top of the node with its own interval or the top of one of its children, whichever ends
+// last, and continues with the child whose top was taken.
+func (tree *Tree) pull(n *node) {
+	for {
+		var best, from *node
+		if n.own {
+			best = n
+		}
+		if n.left != nil && n.left.top != nil && (best == nil || tree.higher(n.left.top, best)) {
+			best, from = n.left.top, n.left
+		}
+		if n.right != nil && n.right.top != nil && (best == nil || tree.higher(n.right.top, best)) {
+			best, from = n.right.top, n.right
+		}
+		n.top = best
+		if from == nil {
+			n.own = false
+			return
+		}
+		from.top = nil
+		n = from
+	}
+}
+
+// release takes the interval of node x out of the heap.
+func (tree *Tree) release(x *node) {
+	n := tree.root
+	for {
+		if n.top == x {
+			n.top = nil
+			tree.pull(n)
+			return
+		}
+		if n == x {
+			n.own = false
+			return
+		}
+		if tree.compare(x.interval, n.interval) < 0 {
+			n = n.left
+		} else {
+			n = n.right
+		}
+	}
+}
+
+// unhold empties the heap slots of the nodes and returns the nodes whose intervals were held there.
+func unhold(nodes ...*node) []*node {
+	held := make([]*node, 0, 2*len(nodes))
+	for _, n := range nodes {
+		if n.top != nil {
+			held = append(held, n.top)
+			n.top = nil
+		}
+		if n.own {
+			held = append(held, n)
+			n.own = false
+		}
+	}
+	return held
+}
+
+// higher returns true if the interval of node a ends after the interval of node b.
+func (tree *Tree) higher(a *node, b *node) bool {
+	return tree.Comparator(a.interval.High, b.interval.High) > 0
+}
+
+// compare orders intervals by their low and then by their high endpoint.
+func (tree *Tree) compare(a Interval, b Interval) int {
+	if compare := tree.Comparator(a.Low, b.Low); compare != 0 {
+		return compare
+	}
+	return tree.Comparator(a.High, b.High)
+}
+
+func (tree *Tree) lookup(interval Interval) *node {
+	n := tree.root
+	for n != nil {
+		compare := tree.compare(interval, n.interval)
+		switch {
+		case compare == 0:
+			return n
+		case compare < 0:
+			n = n.left
+		case compare > 0:
+			n = n.right
+		}
+	}
+	return nil
+}
+
+func (n *node) grandparent() *node {
+	if n != nil && n.parent != nil {
+		return n.parent.parent
+	}
+	return nil
+}
+
+func (n *node) uncle() *node {
+	if n == nil || n.parent == nil || n.parent.parent == nil {
+		return nil
+	}
+	return n.parent.sibling()
+}
+
+func (n *node) sibling() *node {
+	if n == nil || n.parent == nil {
+		return nil
+	}
+	if n == n.parent.left {
+		return n.parent.right
+	}
+	return n.parent.left
+}
+
+// rotateLeft rotates the node down to the left.
+// Only the two rotated nodes change their subtrees, so only their heap slots are rebuilt.
+func (tree *Tree) rotateLeft(n *node) {
+	right := n.right
+	held := unhold(n, right)
+	tree.replaceNode(n, right)
+	n.right = right.left
+	if right.left != nil {
+		right.left.parent = n
+	}
+	right.left = n
+	n.parent = right
+	tree.pull(n)
+	tree.pull(right)
+	for _, x := range held {
+		tree.push(right, x)
+	}
+}
+
+// rotateRight rotates the node down to the right.
+// Only the two rotated nodes change their subtrees, so only their heap slots are rebuilt.
+func (tree *Tree) rotateRight(n *node) {
+	left := n.left
+	held := unhold(n, left)
+	tree.replaceNode(n, left)
+	n.left = left.right
+	if left.right != nil {
+		left.right.parent = n
+	}
+	left.right = n
+	n.parent = left
+	tree.pull(n)
+	tree.pull(left)
+	for _, x := range held {
+		tree.push(left, x)
+	}
+}
+
+func (tree *Tree) replaceNode(old *node, new *node) {
+	if old.parent == nil {
+		tree.root = new
+	} else {
+		if old == old.parent.left {
+			old.parent.left = new
+		} else {
+			old.parent.right = new
+		}
+	}
+	if new != nil {
+		new.parent = old.parent
+	}
+}
+
+func (tree *Tree) insertCase1(n *node) {
+	if n.parent == nil {
+		n.color = black
+	} else {
+		tree.insertCase2(n)
+	}
+}
+
+func (tree *Tree) insertCase2(n *node) {
+	if nodeColor(n.parent) == black {
+		return
+	}
+	tree.insertCase3(n)
+}
+
+func (tree *Tree) insertCase3(n *node) {
+	uncle := n.uncle()
+	if nodeColor(uncle) == red {
+		n.parent.color = black
+		uncle.color = black
+		n.grandparent().color = red
+		tree.insertCase1(n.grandparent())
+	} else {
+		tree.insertCase4(n)
+	}
+}
+
+func (tree *Tree) insertCase4(n *node) {
+	grandparent := n.grandparent()
+	if n == n.parent.right && n.parent == grandparent.left {
+		tree.rotateLeft(n.parent)
+		n = n.left
+	} else if n == n.parent.left && n.parent == grandparent.right {
+		tree.rotateRight(n.parent)
+		n = n.right
+	}
+	tree.insertCase5(n)
+}
+
+func (tree *Tree) insertCase5(n *node) {
+	n.parent.color = black
+	grandparent := n.grandparent()
+	grandparent.color = red
+	if n == n.parent.left && n.parent == grandparent.left {
+		tree.rotateRight(grandparent)
+	} else if n == n.parent.right && n.parent == grandparent.right {
+		tree.rotateLeft(grandparent)
+	}
+}
+
+func (n *node) minimumNode() *node {
+	if n == nil {
+		return nil
+	}
+	for n.left != nil {
+		n = n.left
+	}
+	return n
+}
+
+func (n *node) maximumNode() *node {
+	if n == nil {
+		return nil
+	}
+	for n.right != nil {
+		n = n.right
+	}
+	return n
+}
+
+func (tree *Tree) deleteCase1(n *node) {
+	if n.parent == nil {
+		return
+	}
+	tree.deleteCase2(n)
+}
+
+func (tree *Tree) deleteCase2(n *node) {
+	sibling := n.sibling()
+	if nodeColor(sibling) == red {
+		n.parent.color = red
+		sibling.color = black
+		if n == n.parent.left {
+			tree.rotateLeft(n.parent)
+		} else {
+			tree.rotateRight(n.parent)
+		}
+	}
+	tree.deleteCase3(n)
+}
+
+func (tree *Tree) deleteCase3(n *node) {
+	sibling := n.sibling()
+	if nodeColor(n.parent) == black &&
+		nodeColor(sibling) == black &&
+		nodeColor(sibling.left) == black &&
+		nodeColor(sibling.right) == black {
+		sibling.color = red
+		tree.deleteCase1(n.parent)
+	} else {
+		tree.deleteCase4(n)
+	}
+}
+
+func (tree *Tree) deleteCase4(n *node) {
+	sibling := n.sibling()
+	if nodeColor(n.parent) == red &&
+		nodeColor(sibling) == black &&
+		nodeColor(sibling.left) == black &&
+		nodeColor(sibling.right) == black {
+		sibling.color = red
+		n.parent.color = black
+	} else {
+		tree.deleteCase5(n)
+	}
+}
+
+func (tree *Tree) deleteCase5(n *node) {
+	sibling := n.sibling()
+	if n == n.parent.left &&
+		nodeColor(sibling) == black &&
+		nodeColor(sibling.left) == red &&
+		nodeColor(sibling.right) == black {
+		sibling.color = red
+		sibling.left.color = black
+		tree.rotateRight(sibling)
+	} else if n == n.parent.right &&
+		nodeColor(sibling) == black &&
+		nodeColor(sibling.right) == red &&
+		nodeColor(sibling.left) == black {
+		sibling.color = red
+		sibling.right.color = black
+		tree.rotateLeft(sibling)
+	}
+	tree.deleteCase6(n)
+}
+
+func (tree *Tree) deleteCase6(n *node) {
+	sibling := n.sibling()
+	sibling.color = nodeColor(n.parent)
+	n.parent.color = black
+	if n == n.parent.left && nodeColor(sibling.right) == red {
+		sibling.right.color = black
+		tree.rotateLeft(n.parent)
+	} else if nodeColor(sibling.left) == red {
+		sibling.left.color = black
+		tree.rotateRight(n.parent)
+	}
+}
+
+func nodeColor(n *node) color {
+	if n == nil {
+		return black
+	}
+	return n.color
+}
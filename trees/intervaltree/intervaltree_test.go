@@ -0,0 +1,397 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package intervaltree
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"sort"
+	"strings"
+	"testing"
+)
+
+func TestIntervalTreePut(t *testing.T) {
+	tree := NewWithIntComparator()
+	tree.Put(5, 10, "a")
+	tree.Put(1, 3, "b")
+	tree.Put(5, 7, "c")
+	tree.Put(1, 3, "d") //overwrite
+	tree.Put(4, 2, "e") //empty interval
+
+	if actualValue := tree.Size(); actualValue != 3 {
+		t.Errorf("Got %v expected %v", actualValue, 3)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", tree.Intervals()), "[[1, 3] [5, 7] [5, 10]]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", tree.Values()), "[d c a]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	tests := [][]interface{}{
+		{1, 3, "d", true},
+		{5, 7, "c", true},
+		{5, 10, "a", true},
+		{4, 2, nil, false},
+		{1, 4, nil, false},
+	}
+	for _, test := range tests {
+		if actualValue, found := tree.Get(test[0], test[1]); actualValue != test[2] || found != test[3] {
+			t.Errorf("Got %v expected %v", actualValue, test[2])
+		}
+	}
+}
+
+func TestIntervalTreeRemove(t *testing.T) {
+	tree := NewWithIntComparator()
+	tree.Put(5, 10, "a")
+	tree.Put(1, 3, "b")
+	tree.Put(5, 7, "c")
+
+	tree.Remove(5, 7)
+	tree.Remove(5, 8)
+	if actualValue, expectedValue := fmt.Sprintf("%v", tree.Intervals()), "[[1, 3] [5, 10]]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue := tree.Stabbing(6); len(actualValue) != 1 || actualValue[0].Value != "a" {
+		t.Errorf("Got %v expected %v", actualValue, "[{[5, 10] a}]")
+	}
+
+	tree.Remove(5, 10)
+	tree.Remove(1, 3)
+	if actualValue := tree.Empty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := tree.Stabbing(6); len(actualValue) != 0 {
+		t.Errorf("Got %v expected %v", actualValue, "[]")
+	}
+}
+
+func TestIntervalTreeQueries(t *testing.T) {
+	tree := NewWithIntComparator()
+	tree.Put(15, 20, "a")
+	tree.Put(10, 30, "b")
+	tree.Put(17, 19, "c")
+	tree.Put(5, 20, "d")
+	tree.Put(12, 15, "e")
+	tree.Put(30, 40, "f")
+
+	// queries report entries in no particular order
+	values := func(entries []Entry) string {
+		items := []string{}
+		for _, entry := range entries {
+			items = append(items, entry.Value.(string))
+		}
+		sort.Strings(items)
+		return strings.Join(items, "")
+	}
+
+	overlapping := [][]interface{}{
+		{0, 4, ""},
+		{0, 5, "d"},
+		{14, 16, "abde"},
+		{21, 29, "b"},
+		{30, 30, "bf"},
+		{41, 50, ""},
+		{0, 100, "abcdef"},
+	}
+	for _, test := range overlapping {
+		if actualValue := values(tree.Overlapping(test[0], test[1])); actualValue != test[2] {
+			t.Errorf("Got %v expected %v for [%v, %v]", actualValue, test[2], test[0], test[1])
+		}
+	}
+
+	stabbing := [][]interface{}{
+		{4, ""},
+		{5, "d"},
+		{15, "abde"},
+		{18, "abcd"},
+		{25, "b"},
+		{40, "f"},
+	}
+	for _, test := range stabbing {
+		if actualValue := values(tree.Stabbing(test[0])); actualValue != test[1] {
+			t.Errorf("Got %v expected %v for %v", actualValue, test[1], test[0])
+		}
+	}
+
+	containing := [][]interface{}{
+		{16, 18, "abd"},
+		{17, 19, "abcd"},
+		{12, 25, "b"},
+		{25, 35, ""},
+		{0, 100, ""},
+	}
+	for _, test := range containing {
+		if actualValue := values(tree.Containing(test[0], test[1])); actualValue != test[2] {
+			t.Errorf("Got %v expected %v for [%v, %v]", actualValue, test[2], test[0], test[1])
+		}
+	}
+}
+
+func TestIntervalTreeQueriesRandom(t *testing.T) {
+	tree := NewWithIntComparator()
+	random := rand.New(rand.NewSource(1))
+	intervals := map[Interval]bool{}
+
+	for i := 0; i < 1000; i++ {
+		low := random.Intn(100)
+		interval := Interval{Low: low, High: low + random.Intn(20)}
+		if random.Intn(3) == 0 {
+			tree.Remove(interval.Low, interval.High)
+			delete(intervals, interval)
+		} else {
+			tree.Put(interval.Low, interval.High, i)
+			intervals[interval] = true
+		}
+		assertValidTree(t, tree)
+
+		low = random.Intn(120)
+		high := low + random.Intn(10)
+		expectedOverlapping, expectedContaining := map[Interval]bool{}, map[Interval]bool{}
+		for interval := range intervals {
+			if interval.Low.(int) <= high && low <= interval.High.(int) {
+				expectedOverlapping[interval] = true
+			}
+			if interval.Low.(int) <= low && high <= interval.High.(int) {
+				expectedContaining[interval] = true
+			}
+		}
+		assertEntries(t, tree.Overlapping(low, high), expectedOverlapping)
+		assertEntries(t, tree.Containing(low, high), expectedContaining)
+	}
+}
+
+func assertEntries(t *testing.T, entries []Entry, expected map[Interval]bool) {
+	actual := map[Interval]bool{}
+	for _, entry := range entries {
+		actual[entry.Interval] = true
+	}
+	if len(actual) != len(entries) || len(actual) != len(expected) {
+		t.Fatalf("Got %v expected %v", entries, expected)
+	}
+	for interval := range expected {
+		if !actual[interval] {
+			t.Fatalf("Got %v expected %v", entries, expected)
+		}
+	}
+}
+
+// assertValidTree checks the red-black properties and that every interval is held exactly once in the heap, at or
+// above its own node and below no interval ending before it.
+func assertValidTree(t *testing.T, tree *Tree) {
+	held := map[*node]int{}
+	var walk func(n *node) (int, *node)
+	walk = func(n *node) (blackHeight int, top *node) {
+		if n == nil {
+			return 1, nil
+		}
+		if n.color == red && (nodeColor(n.left) == red || nodeColor(n.right) == red) {
+			t.Fatalf("Red node %v has a red child", n.interval)
+		}
+		if n.top != nil {
+			held[n.top]++
+			if !within(n, n.top) {
+				t.Fatalf("Interval %v is held outside of its path at %v", n.top.interval, n.interval)
+			}
+		}
+		if n.own {
+			held[n]++
+		}
+		if n.top == nil && n.own {
+			t.Fatalf("Node %v holds its own interval without a top", n.interval)
+		}
+		leftHeight, leftTop := walk(n.left)
+		rightHeight, rightTop := walk(n.right)
+		if leftHeight != rightHeight {
+			t.Fatalf("Unbalanced black height at %v", n.interval)
+		}
+		for _, below := range []*node{leftTop, rightTop} {
+			if below != nil && (n.top == nil || tree.higher(below, n.top)) {
+				t.Fatalf("Interval %v is held below %v", below.interval, n.top)
+			}
+		}
+		if n.own && tree.higher(n, n.top) {
+			t.Fatalf("Interval %v is held below %v", n.interval, n.top.interval)
+		}
+		if n.color == black {
+			leftHeight++
+		}
+		return leftHeight, n.top
+	}
+	walk(tree.root)
+	if nodeColor(tree.root) != black {
+		t.Fatalf("Root is not black")
+	}
+	if actualValue, expectedValue := len(held), tree.Size(); actualValue != expectedValue {
+		t.Fatalf("Got %v held intervals expected %v", actualValue, expectedValue)
+	}
+	for x, count := range held {
+		if count != 1 {
+			t.Fatalf("Interval %v is held %v times", x.interval, count)
+		}
+	}
+}
+
+// within returns true if node x is in the subtree rooted at n
+func within(n *node, x *node) bool {
+	for ; x != nil; x = x.parent {
+		if x == n {
+			return true
+		}
+	}
+	return false
+}
+
+func TestIntervalTreeIterator(t *testing.T) {
+	tree := NewWithIntComparator()
+	tree.Put(5, 10, "a")
+	tree.Put(1, 3, "b")
+	tree.Put(5, 7, "c")
+
+	expected := []Interval{{1, 3}, {5, 7}, {5, 10}}
+	it := tree.Iterator()
+	count := 0
+	for it.Next() {
+		if actualValue, expectedValue := it.Interval(), expected[count]; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		if actualValue, expectedValue := it.Key(), expected[count]; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		count++
+	}
+	if actualValue, expectedValue := count, 3; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	for it.Prev() {
+		count--
+		if actualValue, expectedValue := it.Interval(), expected[count]; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+	if actualValue, expectedValue := count, 0; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if it.Last(); it.Value() != "a" {
+		t.Errorf("Got %v expected %v", it.Value(), "a")
+	}
+	if it.First(); it.Value() != "b" {
+		t.Errorf("Got %v expected %v", it.Value(), "b")
+	}
+	if found := it.NextTo(func(key interface{}, value interface{}) bool { return key.(Interval).High == 10 }); !found || it.Value() != "a" {
+		t.Errorf("Got %v expected %v", it.Value(), "a")
+	}
+	if found := it.PrevTo(func(key interface{}, value interface{}) bool { return value == "b" }); !found || it.Interval() != expected[0] {
+		t.Errorf("Got %v expected %v", it.Interval(), expected[0])
+	}
+}
+
+func TestIntervalTreeSerialization(t *testing.T) {
+	tree := NewWithStringComparator()
+	tree.Put("c", "d", "3")
+	tree.Put("a", "z", "1")
+	tree.Put("b", "b", "2")
+
+	var err error
+	assert := func() {
+		if actualValue, expectedValue := tree.Size(), 3; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		if actualValue, expectedValue := fmt.Sprintf("%v", tree.Intervals()), "[[a, z] [b, b] [c, d]]"; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		if actualValue, expectedValue := fmt.Sprintf("%v", tree.Values()), "[1 2 3]"; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		if err != nil {
+			t.Errorf("Got error %v", err)
+		}
+	}
+
+	assert()
+
+	bytes, err := tree.ToJSON()
+	assert()
+
+	err = tree.FromJSON(bytes)
+	assert()
+
+	bytes, err = json.Marshal([]interface{}{"a", "b", "c", tree})
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+
+	err = json.Unmarshal([]byte(`[{"low":"a","high":"b","value":1}]`), &tree)
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, found := tree.Get("a", "b"); actualValue != 1.0 || !found {
+		t.Errorf("Got %v expected %v", actualValue, 1)
+	}
+}
+
+func TestIntervalTreeString(t *testing.T) {
+	c := NewWithIntComparator()
+	c.Put(1, 2, "a")
+	if !strings.HasPrefix(c.String(), "IntervalTree") {
+		t.Errorf("String should start with container name")
+	}
+}
+
+func benchmarkStabbing(b *testing.B, tree *Tree, size int) {
+	for i := 0; i < b.N; i++ {
+		for n := 0; n < size; n++ {
+			tree.Stabbing(n)
+		}
+	}
+}
+
+func benchmarkPut(b *testing.B, tree *Tree, size int) {
+	for i := 0; i < b.N; i++ {
+		for n := 0; n < size; n++ {
+			tree.Put(n, n+10, struct{}{})
+		}
+	}
+}
+
+func BenchmarkIntervalTreeStabbing1000(b *testing.B) {
+	b.StopTimer()
+	size := 1000
+	tree := NewWithIntComparator()
+	for n := 0; n < size; n++ {
+		tree.Put(n, n+10, struct{}{})
+	}
+	b.StartTimer()
+	benchmarkStabbing(b, tree, size)
+}
+
+func BenchmarkIntervalTreeStabbing100000(b *testing.B) {
+	b.StopTimer()
+	size := 100000
+	tree := NewWithIntComparator()
+	for n := 0; n < size; n++ {
+		tree.Put(n, n+10, struct{}{})
+	}
+	b.StartTimer()
+	benchmarkStabbing(b, tree, size)
+}
+
+func BenchmarkIntervalTreePut1000(b *testing.B) {
+	b.StopTimer()
+	size := 1000
+	tree := NewWithIntComparator()
+	b.StartTimer()
+	benchmarkPut(b, tree, size)
+}
+
+func BenchmarkIntervalTreePut100000(b *testing.B) {
+	b.StopTimer()
+	size := 100000
+	tree := NewWithIntComparator()
+	b.StartTimer()
+	benchmarkPut(b, tree, size)
+}
@@ -0,0 +1,54 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package intervaltree
+
+import (
+	"encoding/json"
+	"github.com/emirpasic/gods/containers"
+)
+
+// Assert Serialization implementation
+var _ containers.JSONSerializer = (*Tree)(nil)
+var _ containers.JSONDeserializer = (*Tree)(nil)
+
+// element is the JSON representation of a single interval and its value
+type element struct {
+	Low   interface{} `json:"low"`
+	High  interface{} `json:"high"`
+	Value interface{} `json:"value"`
+}
+
+// ToJSON outputs the JSON representation of the tree as an array of intervals with their values, in-order.
+func (tree *Tree) ToJSON() ([]byte, error) {
+	elements := make([]element, 0, tree.Size())
+	for it := tree.Iterator(); it.Next(); {
+		interval := it.Interval()
+		elements = append(elements, element{Low: interval.Low, High: interval.High, Value: it.Value()})
+	}
+	return json.Marshal(&elements)
+}
+
+// FromJSON populates the tree from the input JSON representation.
+func (tree *Tree) FromJSON(data []byte) error {
+	elements := []element{}
+	err := json.Unmarshal(data, &elements)
+	if err == nil {
+		tree.Clear()
+		for _, element := range elements {
+			tree.Put(element.Low, element.High, element.Value)
+		}
+	}
+	return err
+}
+
+// UnmarshalJSON @implements json.Unmarshaler
+func (tree *Tree) UnmarshalJSON(bytes []byte) error {
+	return tree.FromJSON(bytes)
+}
+
+// MarshalJSON @implements json.Marshaler
+func (tree *Tree) MarshalJSON() ([]byte, error) {
+	return tree.ToJSON()
+}
@@ -0,0 +1,120 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package redblacktree
+
+import "github.com/emirpasic/gods/utils"
+
+// Aggregator describes an aggregate (sum, min, max, count, etc.) that is maintained for every subtree of the tree.
+//
+// Aggregates must form a monoid, i.e. Combine has to be associative and Identity has to be its neutral element.
+// Combine is always called with its arguments in key order, so it does not have to be commutative.
+type Aggregator struct {
+	// Identity is the aggregate of an empty subtree.
+	Identity interface{}
+	// Element returns the aggregate of a single key-value pair.
+	Element func(key interface{}, value interface{}) interface{}
+	// Combine merges the aggregates of two adjacent key ranges, the first one holding the smaller keys.
+	Combine func(a interface{}, b interface{}) interface{}
+}
+
+// NewWithAggregator instantiates a red-black tree with the custom comparator that maintains the aggregate
+// described by aggregator for every subtree, so that aggregates over key ranges can be queried in O(log n).
+func NewWithAggregator(comparator utils.Comparator, aggregator *Aggregator) *Tree {
+	return &Tree{Comparator: comparator, aggregator: aggregator}
+}
+
+// Aggregate returns the aggregate of all elements in the tree.
+// Returns nil if the tree has no aggregator.
+func (tree *Tree) Aggregate() interface{} {
+	if tree.aggregator == nil {
+		return nil
+	}
+	return tree.aggregateOf(tree.Root)
+}
+
+// Aggregate returns the aggregate of all elements stored in the subtree.
+// Returns nil if the tree has no aggregator.
+func (node *Node) Aggregate() interface{} {
+	if node == nil {
+		return nil
+	}
+	return node.aggregate
+}
+
+// RangeAggregate returns the aggregate of all elements whose keys are between from and to, both inclusive.
+// Returns the aggregator's identity if there are no such elements and nil if the tree has no aggregator.
+// Runs in O(log n) time.
+//
+// Keys should adhere to the comparator's type assertion, otherwise method panics.
+func (tree *Tree) RangeAggregate(from interface{}, to interface{}) interface{} {
+	if tree.aggregator == nil {
+		return nil
+	}
+	combine := tree.aggregator.Combine
+
+	// Find the topmost node within the range, all other nodes within the range are in its subtree
+	split := tree.Root
+	for split != nil {
+		if tree.Comparator(split.Key, from) < 0 {
+			split = split.Right
+		} else if tree.Comparator(split.Key, to) > 0 {
+			split = split.Left
+		} else {
+			break
+		}
+	}
+	if split == nil {
+		return tree.aggregator.Identity
+	}
+
+	// Keys larger than or equal to from in the left subtree, collected from right to left
+	left := tree.aggregator.Identity
+	for node := split.Left; node != nil; {
+		if tree.Comparator(node.Key, from) >= 0 {
+			left = combine(tree.aggregator.Element(node.Key, node.Value), combine(tree.aggregateOf(node.Right), left))
+			node = node.Left
+		} else {
+			node = node.Right
+		}
+	}
+
+	// Keys smaller than or equal to to in the right subtree, collected from left to right
+	right := tree.aggregator.Identity
+	for node := split.Right; node != nil; {
+		if tree.Comparator(node.Key, to) <= 0 {
+			right = combine(combine(right, tree.aggregateOf(node.Left)), tree.aggregator.Element(node.Key, node.Value))
+			node = node.Right
+		} else {
+			node = node.Left
+		}
+	}
+
+	return combine(combine(left, tree.aggregator.Element(split.Key, split.Value)), right)
+}
+
+// aggregateOf returns the aggregate of the subtree rooted at node, or the identity if node is nil.
+func (tree *Tree) aggregateOf(node *Node) interface{} {
+	if node == nil {
+		return tree.aggregator.Identity
+	}
+	return node.aggregate
+}
+
+// aggregateNode computes the aggregate of the subtree rooted at node from the aggregates of its children.
+func (tree *Tree) aggregateNode(node *Node) interface{} {
+	combine := tree.aggregator.Combine
+	element := tree.aggregator.Element(node.Key, node.Value)
+	return combine(combine(tree.aggregateOf(node.Left), element), tree.aggregateOf(node.Right))
+}
+
+// reaggregate recomputes the aggregates of node and all of its ancestors, if the tree has an aggregator.
+func (tree *Tree) reaggregate(node *Node) {
+	if tree.aggregator == nil {
+		return
+	}
+	for ; node != nil; node = node.Parent {
+		node.aggregate = tree.aggregateNode(node)
+	}
+}
@@ -0,0 +1,100 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package redblacktree
+
+// Range is a live range of keys of the tree, whose lower and upper bounds are each optional and either inclusive or
+// exclusive. It backs the range views of the tree based containers, e.g. TreeMap and TreeSet.
+type Range struct {
+	tree          *Tree
+	from          interface{}
+	fromInclusive bool
+	hasFrom       bool
+	to            interface{}
+	toInclusive   bool
+	hasTo         bool
+}
+
+// Range returns the unbounded range of all keys of the tree.
+func (tree *Tree) Range() Range {
+	return Range{tree: tree}
+}
+
+// From returns a copy of the range whose keys are larger than (or equal to, if inclusive is set) the given key.
+func (r Range) From(key interface{}, inclusive bool) Range {
+	r.from, r.fromInclusive, r.hasFrom = key, inclusive, true
+	return r
+}
+
+// To returns a copy of the range whose keys are smaller than (or equal to, if inclusive is set) the given key.
+func (r Range) To(key interface{}, inclusive bool) Range {
+	r.to, r.toInclusive, r.hasTo = key, inclusive, true
+	return r
+}
+
+// Contains returns true if the key is within both bounds of the range.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (r Range) Contains(key interface{}) bool {
+	return !r.tooLow(key) && !r.tooHigh(key)
+}
+
+// Lowest returns the node with the smallest key within the range or nil if the range is empty.
+func (r Range) Lowest() *Node {
+	var node *Node
+	switch {
+	case !r.hasFrom:
+		node = r.tree.Left()
+	case r.fromInclusive:
+		node, _ = r.tree.Ceiling(r.from)
+	default:
+		node, _ = r.tree.Higher(r.from)
+	}
+	if node == nil || r.tooHigh(node.Key) {
+		return nil
+	}
+	return node
+}
+
+// Highest returns the node with the largest key within the range or nil if the range is empty.
+func (r Range) Highest() *Node {
+	var node *Node
+	switch {
+	case !r.hasTo:
+		node = r.tree.Right()
+	case r.toInclusive:
+		node, _ = r.tree.Floor(r.to)
+	default:
+		node, _ = r.tree.Lower(r.to)
+	}
+	if node == nil || r.tooLow(node.Key) {
+		return nil
+	}
+	return node
+}
+
+// Size returns the number of keys within the range.
+// Computed from the ranks of the range's lowest and highest keys, i.e. runs in O(log n) time.
+func (r Range) Size() int {
+	lowest, highest := r.Lowest(), r.Highest()
+	if lowest == nil || highest == nil {
+		return 0
+	}
+	return r.tree.Rank(highest.Key) - r.tree.Rank(lowest.Key) + 1
+}
+
+func (r Range) tooLow(key interface{}) bool {
+	if !r.hasFrom {
+		return false
+	}
+	compare := r.tree.Comparator(key, r.from)
+	return compare < 0 || (compare == 0 && !r.fromInclusive)
+}
+
+func (r Range) tooHigh(key interface{}) bool {
+	if !r.hasTo {
+		return false
+	}
+	compare := r.tree.Comparator(key, r.to)
+	return compare > 0 || (compare == 0 && !r.toInclusive)
+}
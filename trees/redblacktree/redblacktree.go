@@ -31,16 +31,19 @@ type Tree struct {
 	Root       *Node
 	size       int
 	Comparator utils.Comparator
+	aggregator *Aggregator
 }
 
 // Node is a single element within the tree
 type Node struct {
-	Key    interface{}
-	Value  interface{}
-	color  color
-	Left   *Node
-	Right  *Node
-	Parent *Node
+	Key       interface{}
+	Value     interface{}
+	color     color
+	Left      *Node
+	Right     *Node
+	Parent    *Node
+	size      int         // number of nodes in the subtree rooted at this node
+	aggregate interface{} // aggregate of the subtree rooted at this node, if tree has an aggregator
 }
 
 // NewWith instantiates a red-black tree with the custom comparator.
@@ -65,7 +68,7 @@ func (tree *Tree) Put(key interface{}, value interface{}) {
 	if tree.Root == nil {
 		// Assert key is of comparator's type for initial tree
 		tree.Comparator(key, key)
-		tree.Root = &Node{Key: key, Value: value, color: red}
+		tree.Root = &Node{Key: key, Value: value, color: red, size: 1}
 		insertedNode = tree.Root
 	} else {
 		node := tree.Root
@@ -76,10 +79,11 @@ func (tree *Tree) Put(key interface{}, value interface{}) {
 			case compare == 0:
 				node.Key = key
 				node.Value = value
+				tree.reaggregate(node)
 				return
 			case compare < 0:
 				if node.Left == nil {
-					node.Left = &Node{Key: key, Value: value, color: red}
+					node.Left = &Node{Key: key, Value: value, color: red, size: 1}
 					insertedNode = node.Left
 					loop = false
 				} else {
@@ -87,7 +91,7 @@ func (tree *Tree) Put(key interface{}, value interface{}) {
 				}
 			case compare > 0:
 				if node.Right == nil {
-					node.Right = &Node{Key: key, Value: value, color: red}
+					node.Right = &Node{Key: key, Value: value, color: red, size: 1}
 					insertedNode = node.Right
 					loop = false
 				} else {
@@ -96,7 +100,11 @@ func (tree *Tree) Put(key interface{}, value interface{}) {
 			}
 		}
 		insertedNode.Parent = node
+		for ; node != nil; node = node.Parent {
+			node.size++
+		}
 	}
+	tree.reaggregate(insertedNode)
 	tree.insertCase1(insertedNode)
 	tree.size++
 }
@@ -138,6 +146,16 @@ func (tree *Tree) Remove(key interface{}) {
 		} else {
 			child = node.Right
 		}
+		// Subtree sizes and aggregates are updated as if the node was already removed,
+		// so that rebalancing keeps them consistent
+		node.size--
+		for parent := node.Parent; parent != nil; parent = parent.Parent {
+			parent.size--
+		}
+		if tree.aggregator != nil {
+			node.aggregate = tree.aggregateOf(child)
+			tree.reaggregate(node.Parent)
+		}
 		if node.color == black {
 			node.color = nodeColor(child)
 			tree.deleteCase1(node)
@@ -161,19 +179,12 @@ func (tree *Tree) Size() int {
 }
 
 // Size returns the number of elements stored in the subtree.
-// Computed dynamically on each call, i.e. the subtree is traversed to count the number of the nodes.
+// Subtree sizes are maintained on every insertion, removal and rotation, i.e. this is a constant time operation.
 func (node *Node) Size() int {
 	if node == nil {
 		return 0
 	}
-	size := 1
-	if node.Left != nil {
-		size += node.Left.Size()
-	}
-	if node.Right != nil {
-		size += node.Right.Size()
-	}
-	return size
+	return node.size
 }
 
 // Keys returns all keys in-order
@@ -276,6 +287,106 @@ func (tree *Tree) Ceiling(key interface{}) (ceiling *Node, found bool) {
 	return nil, false
 }
 
+// Lower finds lower node of the input key, return the lower node or nil if no lower is found.
+// Second return parameter is true if lower was found, otherwise false.
+//
+// Lower node is defined as the largest node that is strictly smaller than the given node.
+// A lower node may not be found, either because the tree is empty, or because
+// all nodes in the tree are larger than or equal to the given node.
+//
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (tree *Tree) Lower(key interface{}) (lower *Node, found bool) {
+	found = false
+	node := tree.Root
+	for node != nil {
+		compare := tree.Comparator(key, node.Key)
+		switch {
+		case compare <= 0:
+			node = node.Left
+		case compare > 0:
+			lower, found = node, true
+			node = node.Right
+		}
+	}
+	if found {
+		return lower, true
+	}
+	return nil, false
+}
+
+// Higher finds higher node of the input key, return the higher node or nil if no higher is found.
+// Second return parameter is true if higher was found, otherwise false.
+//
+// Higher node is defined as the smallest node that is strictly larger than the given node.
+// A higher node may not be found, either because the tree is empty, or because
+// all nodes in the tree are smaller than or equal to the given node.
+//
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (tree *Tree) Higher(key interface{}) (higher *Node, found bool) {
+	found = false
+	node := tree.Root
+	for node != nil {
+		compare := tree.Comparator(key, node.Key)
+		switch {
+		case compare < 0:
+			higher, found = node, true
+			node = node.Left
+		case compare >= 0:
+			node = node.Right
+		}
+	}
+	if found {
+		return higher, true
+	}
+	return nil, false
+}
+
+// Select returns the node with the given index in the in-order traversal of the tree, i.e. the node with the
+// index-th smallest key (zero-based), or nil if index is out of bounds.
+// Runs in O(log n) time.
+func (tree *Tree) Select(index int) *Node {
+	if index < 0 || index >= tree.size {
+		return nil
+	}
+	node := tree.Root
+	for node != nil {
+		leftSize := node.Left.Size()
+		switch {
+		case index < leftSize:
+			node = node.Left
+		case index > leftSize:
+			index -= leftSize + 1
+			node = node.Right
+		default:
+			return node
+		}
+	}
+	return nil
+}
+
+// Rank returns the number of keys in the tree that are strictly smaller than the given key.
+// If the key is in the tree, then its rank is its (zero-based) index in the in-order traversal of the tree.
+// Runs in O(log n) time.
+//
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (tree *Tree) Rank(key interface{}) int {
+	rank := 0
+	node := tree.Root
+	for node != nil {
+		compare := tree.Comparator(key, node.Key)
+		switch {
+		case compare < 0:
+			node = node.Left
+		case compare > 0:
+			rank += node.Left.Size() + 1
+			node = node.Right
+		default:
+			return rank + node.Left.Size()
+		}
+	}
+	return rank
+}
+
 // Clear removes all nodes from the tree.
 func (tree *Tree) Clear() {
 	tree.Root = nil
@@ -372,6 +483,12 @@ func (tree *Tree) rotateLeft(node *Node) {
 	}
 	right.Left = node
 	node.Parent = right
+	right.size = node.size
+	node.size = node.Left.Size() + node.Right.Size() + 1
+	if tree.aggregator != nil {
+		right.aggregate = node.aggregate
+		node.aggregate = tree.aggregateNode(node)
+	}
 }
 
 func (tree *Tree) rotateRight(node *Node) {
@@ -383,6 +500,12 @@ func (tree *Tree) rotateRight(node *Node) {
 	}
 	left.Right = node
 	node.Parent = left
+	left.size = node.size
+	node.size = node.Left.Size() + node.Right.Size() + 1
+	if tree.aggregator != nil {
+		left.aggregate = node.aggregate
+		node.aggregate = tree.aggregateNode(node)
+	}
 }
 
 func (tree *Tree) replaceNode(old *Node, new *Node) {
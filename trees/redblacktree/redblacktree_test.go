@@ -8,6 +8,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"github.com/emirpasic/gods/utils"
+	"math/rand"
 	"strings"
 	"testing"
 )
@@ -233,6 +234,209 @@ func TestRedBlackTreeCeilingAndFloor(t *testing.T) {
 	}
 }
 
+func TestRedBlackTreeLowerAndHigher(t *testing.T) {
+	tree := NewWith(utils.IntComparator)
+
+	if node, found := tree.Lower(0); node != nil || found {
+		t.Errorf("Got %v expected %v", node, "<nil>")
+	}
+	if node, found := tree.Higher(0); node != nil || found {
+		t.Errorf("Got %v expected %v", node, "<nil>")
+	}
+
+	tree.Put(5, "e")
+	tree.Put(6, "f")
+	tree.Put(7, "g")
+	tree.Put(3, "c")
+	tree.Put(4, "d")
+	tree.Put(1, "x")
+	tree.Put(2, "b")
+
+	if node, found := tree.Lower(4); node.Key != 3 || !found {
+		t.Errorf("Got %v expected %v", node.Key, 3)
+	}
+	if node, found := tree.Lower(8); node.Key != 7 || !found {
+		t.Errorf("Got %v expected %v", node.Key, 7)
+	}
+	if node, found := tree.Lower(1); node != nil || found {
+		t.Errorf("Got %v expected %v", node, "<nil>")
+	}
+
+	if node, found := tree.Higher(4); node.Key != 5 || !found {
+		t.Errorf("Got %v expected %v", node.Key, 5)
+	}
+	if node, found := tree.Higher(0); node.Key != 1 || !found {
+		t.Errorf("Got %v expected %v", node.Key, 1)
+	}
+	if node, found := tree.Higher(7); node != nil || found {
+		t.Errorf("Got %v expected %v", node, "<nil>")
+	}
+}
+
+func TestRedBlackTreeSelectAndRank(t *testing.T) {
+	tree := NewWithIntComparator()
+
+	if actualValue := tree.Select(0); actualValue != nil {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+	if actualValue := tree.Rank(1); actualValue != 0 {
+		t.Errorf("Got %v expected %v", actualValue, 0)
+	}
+
+	tree.Put(5, "e")
+	tree.Put(6, "f")
+	tree.Put(7, "g")
+	tree.Put(3, "c")
+	tree.Put(4, "d")
+	tree.Put(1, "x")
+	tree.Put(2, "b")
+	tree.Put(1, "a") //overwrite
+
+	for index, key := range []int{1, 2, 3, 4, 5, 6, 7} {
+		if actualValue := tree.Select(index); actualValue == nil || actualValue.Key != key {
+			t.Errorf("Got %v expected %v", actualValue, key)
+		}
+		if actualValue := tree.Rank(key); actualValue != index {
+			t.Errorf("Got %v expected %v", actualValue, index)
+		}
+	}
+	if actualValue := tree.Select(-1); actualValue != nil {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+	if actualValue := tree.Select(7); actualValue != nil {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+	if actualValue := tree.Rank(0); actualValue != 0 {
+		t.Errorf("Got %v expected %v", actualValue, 0)
+	}
+	if actualValue := tree.Rank(8); actualValue != 7 {
+		t.Errorf("Got %v expected %v", actualValue, 7)
+	}
+	if actualValue := tree.Root.Size(); actualValue != 7 {
+		t.Errorf("Got %v expected %v", actualValue, 7)
+	}
+}
+
+func TestRedBlackTreeSubtreeSizes(t *testing.T) {
+	tree := NewWithIntComparator()
+	random := rand.New(rand.NewSource(1))
+
+	var count func(node *Node) int
+	count = func(node *Node) int {
+		if node == nil {
+			return 0
+		}
+		size := 1 + count(node.Left) + count(node.Right)
+		if node.Size() != size {
+			t.Errorf("Got %v expected %v for subtree of %v", node.Size(), size, node.Key)
+		}
+		return size
+	}
+
+	for i := 0; i < 2000; i++ {
+		key := random.Intn(200)
+		if random.Intn(3) == 0 {
+			tree.Remove(key)
+		} else {
+			tree.Put(key, i)
+		}
+		if actualValue, expectedValue := count(tree.Root), tree.Size(); actualValue != expectedValue {
+			t.Fatalf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+
+	for index, key := range tree.Keys() {
+		if actualValue := tree.Select(index); actualValue.Key != key {
+			t.Errorf("Got %v expected %v", actualValue.Key, key)
+		}
+		if actualValue := tree.Rank(key); actualValue != index {
+			t.Errorf("Got %v expected %v", actualValue, index)
+		}
+	}
+}
+
+func TestRedBlackTreeRangeAggregate(t *testing.T) {
+	sum := &Aggregator{
+		Identity: 0,
+		Element:  func(key interface{}, value interface{}) interface{} { return value.(int) },
+		Combine:  func(a interface{}, b interface{}) interface{} { return a.(int) + b.(int) },
+	}
+	tree := NewWithAggregator(utils.IntComparator, sum)
+
+	if actualValue := tree.Aggregate(); actualValue != 0 {
+		t.Errorf("Got %v expected %v", actualValue, 0)
+	}
+	if actualValue := tree.RangeAggregate(1, 10); actualValue != 0 {
+		t.Errorf("Got %v expected %v", actualValue, 0)
+	}
+
+	for i := 1; i <= 10; i++ {
+		tree.Put(i, i*10)
+	}
+
+	tests := [][]interface{}{
+		{1, 10, 550},
+		{3, 5, 120},
+		{0, 2, 30},
+		{10, 20, 100},
+		{11, 20, 0},
+		{5, 5, 50},
+		{6, 5, 0},
+	}
+	for _, test := range tests {
+		if actualValue := tree.RangeAggregate(test[0], test[1]); actualValue != test[2] {
+			t.Errorf("Got %v expected %v for [%v, %v]", actualValue, test[2], test[0], test[1])
+		}
+	}
+
+	tree.Put(5, 0) // overwrite
+	tree.Remove(3)
+	if actualValue := tree.RangeAggregate(3, 5); actualValue != 40 {
+		t.Errorf("Got %v expected %v", actualValue, 40)
+	}
+	if actualValue := tree.Aggregate(); actualValue != 470 {
+		t.Errorf("Got %v expected %v", actualValue, 470)
+	}
+	if actualValue := tree.Root.Aggregate(); actualValue != 470 {
+		t.Errorf("Got %v expected %v", actualValue, 470)
+	}
+
+	if actualValue := NewWithIntComparator().RangeAggregate(1, 2); actualValue != nil {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+}
+
+func TestRedBlackTreeRangeAggregateRandom(t *testing.T) {
+	// Concatenation is not commutative, so this also checks that elements are combined in key order
+	concatenation := &Aggregator{
+		Identity: "",
+		Element:  func(key interface{}, value interface{}) interface{} { return value.(string) },
+		Combine:  func(a interface{}, b interface{}) interface{} { return a.(string) + b.(string) },
+	}
+	tree := NewWithAggregator(utils.IntComparator, concatenation)
+	random := rand.New(rand.NewSource(1))
+
+	for i := 0; i < 1000; i++ {
+		key := random.Intn(100)
+		if random.Intn(3) == 0 {
+			tree.Remove(key)
+		} else {
+			tree.Put(key, fmt.Sprintf("%v,", key))
+		}
+
+		from, to := random.Intn(110)-5, random.Intn(110)-5
+		expectedValue := ""
+		for it := tree.Iterator(); it.Next(); {
+			if it.Key().(int) >= from && it.Key().(int) <= to {
+				expectedValue += it.Value().(string)
+			}
+		}
+		if actualValue := tree.RangeAggregate(from, to); actualValue != expectedValue {
+			t.Fatalf("Got %v expected %v for [%v, %v]", actualValue, expectedValue, from, to)
+		}
+	}
+}
+
 func TestRedBlackTreeIteratorNextOnEmpty(t *testing.T) {
 	tree := NewWithIntComparator()
 	it := tree.Iterator()
@@ -656,6 +860,69 @@ func TestRedBlackTreeIteratorPrevTo(t *testing.T) {
 	}
 }
 
+func TestRedBlackTreeRange(t *testing.T) {
+	tree := NewWithIntComparator()
+	if actualValue := tree.Range().Size(); actualValue != 0 {
+		t.Errorf("Got %v expected %v", actualValue, 0)
+	}
+	if actualValue := tree.Range().Lowest(); actualValue != nil {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+
+	for _, key := range []int{5, 1, 9, 3, 7, 2, 8, 4, 6} {
+		tree.Put(key, key)
+	}
+	tests := []struct {
+		r                         Range
+		lowest, highest, size     int
+		containsLow, containsHigh bool
+	}{
+		{tree.Range(), 1, 9, 9, true, true},
+		{tree.Range().From(3, true).To(7, true), 3, 7, 5, true, true},
+		{tree.Range().From(3, false).To(7, false), 4, 6, 3, false, false},
+		{tree.Range().From(0, true), 1, 9, 9, true, true},
+		{tree.Range().To(4, false), 1, 3, 3, true, false},
+	}
+	for _, test := range tests {
+		if actualValue := test.r.Lowest(); actualValue == nil || actualValue.Key != test.lowest {
+			t.Errorf("Got %v expected %v", actualValue, test.lowest)
+		}
+		if actualValue := test.r.Highest(); actualValue == nil || actualValue.Key != test.highest {
+			t.Errorf("Got %v expected %v", actualValue, test.highest)
+		}
+		if actualValue := test.r.Size(); actualValue != test.size {
+			t.Errorf("Got %v expected %v", actualValue, test.size)
+		}
+	}
+	if actualValue := tree.Range().From(3, false).Contains(3); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	if actualValue := tree.Range().From(3, true).Contains(3); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+
+	// empty ranges
+	for _, r := range []Range{tree.Range().From(7, true).To(3, true), tree.Range().From(4, false).To(5, false), tree.Range().From(10, true)} {
+		if actualValue := r.Size(); actualValue != 0 {
+			t.Errorf("Got %v expected %v", actualValue, 0)
+		}
+		if actualValue := r.Lowest(); actualValue != nil {
+			t.Errorf("Got %v expected %v", actualValue, nil)
+		}
+	}
+
+	// sizes are maintained through removals and rebalancing
+	tree.Remove(5)
+	tree.Remove(1)
+	tree.Remove(8)
+	if actualValue := tree.Range().From(2, true).To(7, true).Size(); actualValue != 5 {
+		t.Errorf("Got %v expected %v", actualValue, 5)
+	}
+	if actualValue := tree.Range().Size(); actualValue != tree.Size() {
+		t.Errorf("Got %v expected %v", actualValue, tree.Size())
+	}
+}
+
 func TestRedBlackTreeSerialization(t *testing.T) {
 	tree := NewWithStringComparator()
 	tree.Put("c", "3")